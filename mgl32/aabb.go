@@ -0,0 +1,157 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// AABB is an axis-aligned bounding box described by its minimum and maximum
+// corners. A box where any element of Min is bigger than the corresponding
+// element of Max is considered empty. The zero AABB is not empty, but the box
+// of the single point at the origin; start from EmptyAABB to grow a box with
+// Union.
+type AABB struct {
+	Min, Max Vec3
+}
+
+// EmptyAABB returns the empty box with Min at +Inf and Max at -Inf, which
+// contains and intersects nothing, and which is the identity of Union.
+func EmptyAABB() AABB {
+	return AABB{Vec3{InfPos, InfPos, InfPos}, Vec3{InfNeg, InfNeg, InfNeg}}
+}
+
+// AABBFromPoints returns the smallest AABB containing all of the given points.
+// If no points are given, the result is EmptyAABB().
+func AABBFromPoints(points ...Vec3) AABB {
+	if len(points) == 0 {
+		return EmptyAABB()
+	}
+
+	box := AABB{points[0], points[0]}
+	for _, p := range points[1:] {
+		for i := range p {
+			SetMin(&box.Min[i], &p[i])
+			SetMax(&box.Max[i], &p[i])
+		}
+	}
+
+	return box
+}
+
+// Empty returns whether the box is empty, that is, whether its minimum is
+// bigger than its maximum along any axis.
+func (box AABB) Empty() bool {
+	return box.Min[0] > box.Max[0] || box.Min[1] > box.Max[1] || box.Min[2] > box.Max[2]
+}
+
+// Center returns the point halfway between the corners of the box.
+func (box AABB) Center() Vec3 {
+	return box.Min.Add(box.Max).Mul(0.5)
+}
+
+// Extents returns the half extents of the box, the distances from its center
+// to its faces along each axis.
+func (box AABB) Extents() Vec3 {
+	return box.Max.Sub(box.Min).Mul(0.5)
+}
+
+// Union returns the smallest box containing both boxes. If either box is
+// empty, the result is the other one.
+func (box AABB) Union(other AABB) AABB {
+	if other.Empty() {
+		return box
+	}
+	if box.Empty() {
+		return other
+	}
+	for i := 0; i < 3; i++ {
+		SetMin(&box.Min[i], &other.Min[i])
+		SetMax(&box.Max[i], &other.Max[i])
+	}
+	return box
+}
+
+// Contains returns whether the point p is inside the box or on its boundary.
+func (box AABB) Contains(p Vec3) bool {
+	return p[0] >= box.Min[0] && p[0] <= box.Max[0] &&
+		p[1] >= box.Min[1] && p[1] <= box.Max[1] &&
+		p[2] >= box.Min[2] && p[2] <= box.Max[2]
+}
+
+// Intersects returns whether the boxes overlap or touch. Empty boxes don't
+// intersect anything.
+func (box AABB) Intersects(other AABB) bool {
+	return !box.Empty() && !other.Empty() &&
+		box.Min[0] <= other.Max[0] && other.Min[0] <= box.Max[0] &&
+		box.Min[1] <= other.Max[1] && other.Min[1] <= box.Max[1] &&
+		box.Min[2] <= other.Max[2] && other.Min[2] <= box.Max[2]
+}
+
+// TransformedBy returns the smallest AABB containing the box transformed by
+// the affine matrix m. Rather than transforming the eight corners, it maps the
+// center and takes the absolute value of the linear part of m to find the new
+// extents (Arvo, "Transforming Axis-Aligned Bounding Boxes", 1990). An empty
+// box stays unchanged.
+func (box AABB) TransformedBy(m Mat4) AABB {
+	if box.Empty() {
+		return box
+	}
+
+	c, e := box.Center(), box.Extents()
+	center := m.Mul4x1(c.Vec4(1)).Vec3()
+	var half Vec3
+	for i := 0; i < 3; i++ {
+		half[i] = Abs(m.At(i, 0))*e[0] + Abs(m.At(i, 1))*e[1] + Abs(m.At(i, 2))*e[2]
+	}
+	return AABB{center.Sub(half), center.Add(half)}
+}
+
+// IntersectsTriangle tests whether the box intersects (or touches) the
+// triangle (a,b,c), using the separating axis test of Akenine-Möller's "Fast
+// 3D Triangle-Box Overlap Testing": the box, the triangle's plane and the
+// cross products of the edges of both are tried as separating axes.
+func (box AABB) IntersectsTriangle(a, b, c Vec3) bool {
+	center, half := box.Center(), box.Extents()
+
+	// Move the box to the origin
+	v := [3]Vec3{a.Sub(center), b.Sub(center), c.Sub(center)}
+	e := [3]Vec3{v[1].Sub(v[0]), v[2].Sub(v[1]), v[0].Sub(v[2])}
+
+	// The nine cross products of the box axes with the edges
+	for _, edge := range e {
+		for i := 0; i < 3; i++ {
+			var axisDir Vec3
+			axisDir[i] = 1
+			if triangleSeparated(axisDir.Cross(edge), v, half) {
+				return false
+			}
+		}
+	}
+
+	// The box axes, i.e. the triangle's AABB against the box
+	for i := 0; i < 3; i++ {
+		min, max := v[0][i], v[0][i]
+		for _, p := range v[1:] {
+			SetMin(&min, &p[i])
+			SetMax(&max, &p[i])
+		}
+		if min > half[i] || max < -half[i] {
+			return false
+		}
+	}
+
+	// The triangle's normal
+	return !triangleSeparated(e[0].Cross(e[1]), v, half)
+}
+
+// triangleSeparated reports whether axis separates the triangle v from the
+// box of the given half extents centered at the origin.
+func triangleSeparated(axis Vec3, v [3]Vec3, half Vec3) bool {
+	p0, p1, p2 := v[0].Dot(axis), v[1].Dot(axis), v[2].Dot(axis)
+	r := half[0]*Abs(axis[0]) + half[1]*Abs(axis[1]) + half[2]*Abs(axis[2])
+	min, max := p0, p0
+	SetMin(&min, &p1)
+	SetMin(&min, &p2)
+	SetMax(&max, &p1)
+	SetMax(&max, &p2)
+	return min > r || max < -r
+}
@@ -0,0 +1,185 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestAABBFromPoints(t *testing.T) {
+	t.Parallel()
+
+	box := AABBFromPoints(Vec3{1, -2, 3}, Vec3{-1, 5, 0}, Vec3{0, 0, 4})
+	if correct := (AABB{Vec3{-1, -2, 0}, Vec3{1, 5, 4}}); box != correct {
+		t.Errorf("AABBFromPoints = %v, expected %v", box, correct)
+	}
+
+	if box := AABBFromPoints(); box != EmptyAABB() {
+		t.Errorf("AABBFromPoints() = %v, expected the empty AABB", box)
+	}
+}
+
+func TestEmptyAABB(t *testing.T) {
+	t.Parallel()
+
+	empty := EmptyAABB()
+	box := AABB{Vec3{1, 2, 3}, Vec3{4, 5, 6}}
+	if !empty.Empty() || empty.Contains(Vec3{}) || empty.Intersects(empty) || empty.Intersects(box) {
+		t.Errorf("EmptyAABB() = %v isn't empty", empty)
+	}
+	if got := empty.Union(box); got != box {
+		t.Errorf("EmptyAABB().Union(%v) = %v", box, got)
+	}
+
+	// Folding boxes into an empty one doesn't add the origin.
+	acc := EmptyAABB()
+	for _, p := range []Vec3{{1, 2, 3}, {4, 5, 6}} {
+		acc = acc.Union(AABB{p, p})
+	}
+	if acc != box {
+		t.Errorf("Union of points from EmptyAABB() = %v, expected %v", acc, box)
+	}
+	if got := TileAABB(Mat4{}, -1, -1, 1, 1, 1, 10); !got.Empty() {
+		t.Errorf("TileAABB of a singular projection = %v, expected an empty box", got)
+	}
+}
+
+func TestAABBIntersectsTriangle(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+
+	tests := []struct {
+		Description string
+		Tri         [3]Vec3
+		Hit         bool
+	}{
+		{"Inside", [3]Vec3{{-0.5, 0, 0}, {0.5, 0, 0}, {0, 0.5, 0}}, true},
+		{"Containing the box", [3]Vec3{{-10, -10, 0}, {10, -10, 0}, {0, 10, 0}}, true},
+		{"Crossing a face", [3]Vec3{{0, 0, 0.5}, {0, 0, 3}, {0.5, 0.5, 3}}, true},
+		{"Touching a face", [3]Vec3{{-3, -3, 1}, {3, -3, 1}, {0, 3, 1}}, true},
+		{"Beside a face", [3]Vec3{{2, 0, 0}, {3, 0, 0}, {2, 1, 0}}, false},
+		// Overlaps the box on every coordinate axis, but its plane
+		// x+y+z = 3.5 passes beyond the corner (1,1,1).
+		{"Beyond a corner", [3]Vec3{{3.5, 0, 0}, {0, 3.5, 0}, {0, 0, 3.5}}, false},
+		// Overlaps the box on every axis and its plane crosses the box, but
+		// an edge cross product separates it.
+		{"Beside an edge", [3]Vec3{{1.6, 0.6, -3}, {0.6, 1.6, 3}, {2.5, 2.5, 0}}, false},
+	}
+
+	for _, c := range tests {
+		if hit := box.IntersectsTriangle(c.Tri[0], c.Tri[1], c.Tri[2]); hit != c.Hit {
+			t.Errorf("%s: IntersectsTriangle = %v, expected %v", c.Description, hit, c.Hit)
+		}
+	}
+}
+
+func TestAABBUnion(t *testing.T) {
+	t.Parallel()
+
+	a := AABB{Vec3{0, 0, 0}, Vec3{1, 1, 1}}
+	b := AABB{Vec3{-1, 0.5, 2}, Vec3{0.5, 3, 4}}
+	empty := AABB{Vec3{1, 1, 1}, Vec3{-1, -1, -1}}
+
+	tests := []struct {
+		Description string
+		A, B, Union AABB
+	}{
+		{"Disjoint", a, b, AABB{Vec3{-1, 0, 0}, Vec3{1, 3, 4}}},
+		{"Containing", a, AABB{Vec3{0.25, 0.25, 0.25}, Vec3{0.5, 0.5, 0.5}}, a},
+		{"Empty second", a, empty, a},
+		{"Empty first", empty, b, b},
+	}
+
+	for _, c := range tests {
+		if got := c.A.Union(c.B); got != c.Union {
+			t.Errorf("%s: %v.Union(%v) = %v, want %v", c.Description, c.A, c.B, got, c.Union)
+		}
+	}
+}
+
+func TestAABBContainsIntersects(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -2, -3}, Vec3{1, 2, 3}}
+	if c, e := box.Center(), box.Extents(); c != (Vec3{}) || e != (Vec3{1, 2, 3}) {
+		t.Errorf("Center, Extents = %v, %v, want %v, %v", c, e, Vec3{}, Vec3{1, 2, 3})
+	}
+
+	points := []struct {
+		P        Vec3
+		Contains bool
+	}{
+		{Vec3{0, 0, 0}, true},
+		{Vec3{1, -2, 3}, true},
+		{Vec3{0, 2.5, 0}, false},
+		{Vec3{0, 0, -3.1}, false},
+	}
+	for _, c := range points {
+		if got := box.Contains(c.P); got != c.Contains {
+			t.Errorf("Contains(%v) = %v, want %v", c.P, got, c.Contains)
+		}
+	}
+
+	boxes := []struct {
+		Description string
+		Other       AABB
+		Intersects  bool
+	}{
+		{"Overlapping", AABB{Vec3{0, 0, 0}, Vec3{5, 5, 5}}, true},
+		{"Inside", AABB{Vec3{-0.5, -0.5, -0.5}, Vec3{0.5, 0.5, 0.5}}, true},
+		{"Touching", AABB{Vec3{1, 0, 0}, Vec3{2, 1, 1}}, true},
+		{"Separated on one axis", AABB{Vec3{0, 0, 3.5}, Vec3{1, 1, 4}}, false},
+		{"Empty", AABB{Vec3{0.5, 0, 0}, Vec3{-0.5, 0, 0}}, false},
+	}
+	for _, c := range boxes {
+		if got := box.Intersects(c.Other); got != c.Intersects {
+			t.Errorf("%s: Intersects(%v) = %v, want %v", c.Description, c.Other, got, c.Intersects)
+		}
+		if got := c.Other.Intersects(box); got != c.Intersects {
+			t.Errorf("%s: reversed Intersects = %v, want %v", c.Description, got, c.Intersects)
+		}
+	}
+}
+
+func TestAABBTransformedBy(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -2, 0}, Vec3{1, 2, 1}}
+	tests := []struct {
+		Description string
+		M           Mat4
+	}{
+		{"Translation", Translate3D(1, 2, 3)},
+		{"Scale", Scale3D(2, -1, 0.5)},
+		{"Rotation", HomogRotate3D(0.7, Vec3{1, 2, 3}.Normalize())},
+		{"Everything", Translate3D(-4, 0, 2).Mul4(HomogRotate3DY(1.2)).Mul4(Scale3D(3, 1, 2))},
+	}
+
+	for _, c := range tests {
+		// The tight box is the bounds of the transformed corners.
+		var corners []Vec3
+		for i := 0; i < 8; i++ {
+			p := box.Min
+			for j := 0; j < 3; j++ {
+				if i&(1<<uint(j)) != 0 {
+					p[j] = box.Max[j]
+				}
+			}
+			corners = append(corners, TransformCoordinate(p, c.M))
+		}
+		want := AABBFromPoints(corners...)
+
+		got := box.TransformedBy(c.M)
+		if !got.Min.ApproxEqualThreshold(want.Min, 1e-5) || !got.Max.ApproxEqualThreshold(want.Max, 1e-5) {
+			t.Errorf("%s: TransformedBy = %v, want %v", c.Description, got, want)
+		}
+	}
+
+	empty := AABB{Vec3{1, 1, 1}, Vec3{}}
+	if got := empty.TransformedBy(Translate3D(1, 2, 3)); got != empty {
+		t.Errorf("TransformedBy of an empty box = %v, want it unchanged", got)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// The attitude and heading reference system (AHRS) filters here estimate the
+// orientation of an inertial measurement unit from its gyroscope,
+// accelerometer and, optionally, magnetometer samples. The orientation rotates
+// vectors from the sensor frame to the earth frame, whose z axis points up: at
+// rest, an accelerometer measures Orientation.Conjugate().Rotate(Vec3{0, 0, 1})
+// times g. Gyroscope samples are in radians per second; accelerometer and
+// magnetometer samples can be in any unit since only their directions are used,
+// and are ignored when zero.
+//
+// Both filters integrate the gyroscope and correct its drift towards the
+// orientation the other sensors indicate. Without a magnetometer, the heading
+// (the rotation about the earth's z axis) isn't corrected.
+
+// MadgwickFilter is Madgwick's gradient descent orientation filter ("An
+// efficient orientation filter for inertial and inertial/magnetic sensor
+// arrays", 2010).
+type MadgwickFilter struct {
+	Orientation Quat
+	// Beta is the gain of the correction, the rate in radians per second at
+	// which gyroscope errors are removed. Madgwick suggests
+	// sqrt(3/4) times the gyroscope's mean error, around 0.1 in practice;
+	// larger values converge faster but let through more accelerometer noise.
+	Beta float32
+}
+
+// NewMadgwickFilter returns a Madgwick filter with the given gain, starting at
+// the identity orientation.
+func NewMadgwickFilter(beta float32) *MadgwickFilter {
+	return &MadgwickFilter{Orientation: QuatIdent(), Beta: beta}
+}
+
+// UpdateIMU advances the filter by dt seconds with the given gyroscope and
+// accelerometer samples.
+func (f *MadgwickFilter) UpdateIMU(gyro, accel Vec3, dt float32) {
+	f.Update(gyro, accel, Vec3{}, dt)
+}
+
+// Update advances the filter by dt seconds with the given gyroscope,
+// accelerometer and magnetometer samples.
+func (f *MadgwickFilter) Update(gyro, accel, mag Vec3, dt float32) {
+	q := f.Orientation
+	qDot := q.Mul(Quat{0, gyro}).Scale(0.5)
+
+	if accel.LenSqr() > 0 {
+		w, x, y, z := q.W, q.V[0], q.V[1], q.V[2]
+		a := accel.Normalize()
+
+		// The gradient J^T f of the error between the measured gravity and
+		// gravity rotated into the sensor frame.
+		fg := Vec3{
+			2*(x*z-w*y) - a[0],
+			2*(w*x+y*z) - a[1],
+			2*(0.5-x*x-y*y) - a[2],
+		}
+		grad := Vec4{
+			-2*y*fg[0] + 2*x*fg[1],
+			2*z*fg[0] + 2*w*fg[1] - 4*x*fg[2],
+			-2*w*fg[0] + 2*z*fg[1] - 4*y*fg[2],
+			2*x*fg[0] + 2*y*fg[1],
+		}
+
+		if mag.LenSqr() > 0 {
+			m := mag.Normalize()
+			// The earth's field in the earth frame, assuming no declination.
+			h := q.Rotate(m)
+			bx, bz := Vec2{h[0], h[1]}.Len(), h[2]
+
+			fb := Vec3{
+				2*bx*(0.5-y*y-z*z) + 2*bz*(x*z-w*y) - m[0],
+				2*bx*(x*y-w*z) + 2*bz*(w*x+y*z) - m[1],
+				2*bx*(w*y+x*z) + 2*bz*(0.5-x*x-y*y) - m[2],
+			}
+			grad = grad.Add(Vec4{
+				-2*bz*y*fb[0] + (-2*bx*z+2*bz*x)*fb[1] + 2*bx*y*fb[2],
+				2*bz*z*fb[0] + (2*bx*y+2*bz*w)*fb[1] + (2*bx*z-4*bz*x)*fb[2],
+				(-4*bx*y-2*bz*w)*fb[0] + (2*bx*x+2*bz*z)*fb[1] + (2*bx*w-4*bz*y)*fb[2],
+				(-4*bx*z+2*bz*x)*fb[0] + (-2*bx*w+2*bz*y)*fb[1] + 2*bx*x*fb[2],
+			})
+		}
+
+		if l := grad.Len(); l > 0 {
+			grad = grad.Mul(f.Beta / l)
+			qDot = qDot.Sub(Quat{grad[0], Vec3{grad[1], grad[2], grad[3]}})
+		}
+	}
+
+	f.Orientation = q.Add(qDot.Scale(dt)).Normalize()
+}
+
+// MahonyFilter is the explicit complementary filter of Mahony et al.
+// ("Nonlinear Complementary Filters on the Special Orthogonal Group", 2008), a
+// PI controller driving the gyroscope's rate with the error between the measured
+// and the estimated directions of gravity and of the magnetic field.
+type MahonyFilter struct {
+	Orientation Quat
+	// Kp is the proportional gain, the rate in radians per second per radian
+	// of error at which the orientation is corrected, and Ki the integral gain,
+	// which estimates and removes the gyroscope's bias. Typical values are
+	// Kp = 1 and Ki = 0 (no bias estimation) to 0.1.
+	Kp, Ki float32
+
+	integral Vec3
+}
+
+// NewMahonyFilter returns a Mahony filter with the given gains, starting at the
+// identity orientation.
+func NewMahonyFilter(kp, ki float32) *MahonyFilter {
+	return &MahonyFilter{Orientation: QuatIdent(), Kp: kp, Ki: ki}
+}
+
+// GyroBias returns the filter's estimate of the bias of the gyroscope, in
+// radians per second, which is subtracted from the samples. Only filters with a
+// non-zero Ki estimate it.
+func (f *MahonyFilter) GyroBias() Vec3 {
+	return f.integral.Mul(-1)
+}
+
+// UpdateIMU advances the filter by dt seconds with the given gyroscope and
+// accelerometer samples.
+func (f *MahonyFilter) UpdateIMU(gyro, accel Vec3, dt float32) {
+	f.Update(gyro, accel, Vec3{}, dt)
+}
+
+// Update advances the filter by dt seconds with the given gyroscope,
+// accelerometer and magnetometer samples.
+func (f *MahonyFilter) Update(gyro, accel, mag Vec3, dt float32) {
+	q := f.Orientation
+	toSensor := q.Conjugate()
+
+	if accel.LenSqr() > 0 {
+		// The error is the rotation between the measured and the estimated
+		// directions, as sin(angle) times their common perpendicular.
+		e := accel.Normalize().Cross(toSensor.Rotate(Vec3{0, 0, 1}))
+
+		if mag.LenSqr() > 0 {
+			m := mag.Normalize()
+			h := q.Rotate(m)
+			b := Vec3{Vec2{h[0], h[1]}.Len(), 0, h[2]}
+			e = e.Add(m.Cross(toSensor.Rotate(b)))
+		}
+
+		if f.Ki > 0 {
+			f.integral = f.integral.Add(e.Mul(f.Ki * dt))
+		} else {
+			f.integral = Vec3{}
+		}
+		gyro = gyro.Add(e.Mul(f.Kp)).Add(f.integral)
+	}
+
+	qDot := q.Mul(Quat{0, gyro}).Scale(0.5)
+	f.Orientation = q.Add(qDot.Scale(dt)).Normalize()
+}
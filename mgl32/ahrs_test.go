@@ -0,0 +1,123 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+type ahrsFilter interface {
+	Update(gyro, accel, mag Vec3, dt float32)
+	UpdateIMU(gyro, accel Vec3, dt float32)
+}
+
+func ahrsFilters(initial Quat) []struct {
+	name        string
+	filter      ahrsFilter
+	orientation func() Quat
+} {
+	madgwick, mahony := NewMadgwickFilter(0.5), NewMahonyFilter(2, 0)
+	madgwick.Orientation, mahony.Orientation = initial, initial
+	return []struct {
+		name        string
+		filter      ahrsFilter
+		orientation func() Quat
+	}{
+		{"Madgwick", madgwick, func() Quat { return madgwick.Orientation }},
+		{"Mahony", mahony, func() Quat { return mahony.Orientation }},
+	}
+}
+
+// The earth's magnetic field, pointing north (+x) and down.
+var testEarthField = Vec3{0.5, 0, -0.8}
+
+func TestAHRSConvergence(t *testing.T) {
+	t.Parallel()
+
+	truth := QuatRotate(1, Vec3{0.3, -0.5, 1}.Normalize())
+	toSensor := truth.Conjugate()
+	accel := toSensor.Rotate(Vec3{0, 0, 9.81})
+	mag := toSensor.Rotate(testEarthField)
+
+	// Madgwick's normalized gradient steps make the estimate chatter around
+	// the truth by about Beta*dt.
+	for _, c := range ahrsFilters(QuatIdent()) {
+		for i := 0; i < 2000; i++ {
+			c.filter.Update(Vec3{}, accel, mag, 0.01)
+		}
+		if q := c.orientation(); q.BoxMinus(truth).Len() > 0.02 {
+			t.Errorf("%s converged to %v, want %v", c.name, q, truth)
+		}
+	}
+
+	// Without a magnetometer, the tilt converges but not the heading.
+	for _, c := range ahrsFilters(QuatIdent()) {
+		for i := 0; i < 2000; i++ {
+			c.filter.UpdateIMU(Vec3{}, accel, 0.01)
+		}
+		up := c.orientation().Conjugate().Rotate(Vec3{0, 0, 1})
+		if want := accel.Normalize(); !up.ApproxEqualThreshold(want, 1e-2) {
+			t.Errorf("%s estimates up as %v in the sensor frame, want %v", c.name, up, want)
+		}
+	}
+}
+
+func TestAHRSGyroIntegration(t *testing.T) {
+	t.Parallel()
+
+	// With no other sensors, the gyroscope is integrated in the sensor frame.
+	gyro := Vec3{0.2, -0.4, 0.5}
+	want := QuatFromRotationVector(gyro)
+	for _, c := range ahrsFilters(QuatIdent()) {
+		for i := 0; i < 1000; i++ {
+			c.filter.Update(gyro, Vec3{}, Vec3{}, 0.001)
+		}
+		if q := c.orientation(); q.BoxMinus(want).Len() > 1e-3 {
+			t.Errorf("%s integrated the gyroscope to %v, want %v", c.name, q, want)
+		}
+	}
+}
+
+func TestAHRSTracking(t *testing.T) {
+	t.Parallel()
+
+	// Spinning about the vertical at a constant rate, the estimate follows.
+	omega := Vec3{0, 0, 1}
+	truth := QuatRotate(0.4, Vec3{1, 0, 0})
+	filters := ahrsFilters(truth)
+
+	q := truth
+	for i := 0; i < 500; i++ {
+		q = ExtrapolateQuat(q, omega, 0.01)
+		toSensor := q.Conjugate()
+		gyro := toSensor.Rotate(omega)
+		for _, c := range filters {
+			c.filter.Update(gyro, toSensor.Rotate(Vec3{0, 0, 1}), toSensor.Rotate(testEarthField), 0.01)
+		}
+	}
+	for _, c := range filters {
+		if o := c.orientation(); o.BoxMinus(q).Len() > 0.02 {
+			t.Errorf("%s tracked to %v, want %v", c.name, o, q)
+		}
+	}
+}
+
+func TestMahonyGyroBias(t *testing.T) {
+	t.Parallel()
+
+	bias := Vec3{0.02, -0.01, 0.03}
+	f := NewMahonyFilter(2, 0.5)
+	accel, mag := Vec3{0, 0, 1}, testEarthField
+	for i := 0; i < 5000; i++ {
+		f.Update(bias, accel, mag, 0.01)
+	}
+
+	if b := f.GyroBias(); !b.ApproxEqualThreshold(bias, 1e-2) {
+		t.Errorf("GyroBias = %v, want %v", b, bias)
+	}
+	if f.Orientation.BoxMinus(QuatIdent()).Len() > 0.02 {
+		t.Errorf("Orientation with a biased gyroscope = %v, want the identity", f.Orientation)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// AimConstraint returns the rotation that points the +x axis of an object at
+// sourcePos towards targetPos, with its +y axis as close as possible to
+// upHint, like the aim constraint of DCC applications with their default aim
+// and up vectors. See AimConstraintAxes to aim other axes.
+func AimConstraint(sourcePos, targetPos, upHint Vec3) Quat {
+	return AimConstraintAxes(sourcePos, targetPos, upHint, Vec3{1, 0, 0}, Vec3{0, 1, 0})
+}
+
+// AimConstraintAxes returns the rotation that points the local axis aimAxis
+// of an object at sourcePos towards targetPos, and turns it about that
+// direction so that the local axis upAxis is as close as possible to the world
+// direction upHint. UpAxis must not be parallel to aimAxis; if upHint is
+// parallel to the aim direction, an arbitrary perpendicular is used instead.
+//
+// For example, aimAxis {0, 0, -1} and upAxis {0, 1, 0} orient an OpenGL
+// camera at sourcePos like LookAtV.
+func AimConstraintAxes(sourcePos, targetPos, upHint, aimAxis, upAxis Vec3) Quat {
+	return Mat4ToQuat(aimFrame(targetPos.Sub(sourcePos), upHint).Mul3(aimFrame(aimAxis, upAxis).Transpose()).Mat4())
+}
+
+// aimFrame returns the rotation matrix whose columns are the unit vector
+// along aim, the closest perpendicular unit vector to up, and their cross
+// product.
+func aimFrame(aim, up Vec3) Mat3 {
+	a := aim.Normalize()
+	u := ProjectOntoPlane(up, a)
+	if u.LenSqr() < 1e-12 {
+		u = anyPerpendicular(a)
+	}
+	u = u.Normalize()
+	return Mat3FromCols(a, u, a.Cross(u))
+}
+
+// PoleVector returns the unit direction in which a chain of two bones with
+// the given root, middle and tip joint positions bends: from its root to tip
+// line towards the middle joint. A pole vector control is usually placed
+// along it from the middle joint. If the chain is straight, the result is the
+// zero vector.
+func PoleVector(root, mid, tip Vec3) Vec3 {
+	axis := tip.Sub(root)
+	if axis.LenSqr() == 0 {
+		return Vec3{}
+	}
+	d := ProjectOntoPlane(mid.Sub(root), axis)
+	if d.LenSqr() < 1e-12 {
+		return Vec3{}
+	}
+	return d.Normalize()
+}
+
+// PoleVectorConstraint returns the world space rotation about the line from
+// root to tip that turns a chain of two bones, with the given joint
+// positions, so that it bends towards the position pole, like the pole vector
+// constraint of DCC applications. Applied to the root joint, it keeps the tip
+// in place. If the chain is straight or the pole is on the line, the result is
+// the identity.
+func PoleVectorConstraint(root, mid, tip, pole Vec3) Quat {
+	axis := tip.Sub(root)
+	current := PoleVector(root, mid, tip)
+	want := ProjectOntoPlane(pole.Sub(root), axis)
+	if current == (Vec3{}) || want.LenSqr() < 1e-12 {
+		return QuatIdent()
+	}
+	n := axis.Normalize()
+	angle := math.Atan2(float64(n.Dot(current.Cross(want))), float64(current.Dot(want)))
+	return QuatRotate(float32(angle), n)
+}
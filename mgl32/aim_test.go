@@ -0,0 +1,74 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAimConstraint(t *testing.T) {
+	t.Parallel()
+
+	approx := absEqual(1e-5)
+	source := Vec3{1, 2, 3}
+	for _, test := range []struct{ target, up Vec3 }{
+		{Vec3{5, 2, 3}, Vec3{0, 1, 0}},
+		{Vec3{1, 2, -4}, Vec3{0, 1, 0}},
+		{Vec3{-2, 6, 0}, Vec3{0, 0, 1}},
+		{Vec3{1, 7, 3}, Vec3{0, 1, 0}},
+	} {
+		q := AimConstraint(source, test.target, test.up)
+		aim := test.target.Sub(source).Normalize()
+		if got := q.Rotate(Vec3{1, 0, 0}); !got.ApproxFuncEqual(aim, approx) {
+			t.Errorf("AimConstraint(%v, %v) aims +x at %v, want %v", test.target, test.up, got, aim)
+		}
+		up := q.Rotate(Vec3{0, 1, 0})
+		if want := ProjectOntoPlane(test.up, aim); want.LenSqr() > 1e-6 && !up.ApproxFuncEqual(want.Normalize(), approx) {
+			t.Errorf("AimConstraint(%v, %v) has +y at %v, want %v", test.target, test.up, up, want.Normalize())
+		} else if !approx(up.Dot(aim), 0) {
+			t.Errorf("AimConstraint(%v, %v) has +y at %v, not perpendicular to the aim", test.target, test.up, up)
+		}
+	}
+
+	// Against LookAtV, which aims -z with +y up.
+	eye, center, up := Vec3{1, 2, 3}, Vec3{-1, 0, 5}, Vec3{0, 1, 0}
+	q := AimConstraintAxes(eye, center, up, Vec3{0, 0, -1}, Vec3{0, 1, 0})
+	if got, want := q.Mat4(), LookAtV(eye, center, up).Mat3().Transpose().Mat4(); !got.ApproxFuncEqual(want, approx) {
+		t.Errorf("AimConstraintAxes = %v, want the inverse rotation of LookAtV %v", got, want)
+	}
+}
+
+func TestPoleVector(t *testing.T) {
+	t.Parallel()
+
+	approx := absEqual(1e-5)
+	root, mid, tip := Vec3{0, 0, 0}, Vec3{1, 1, 0}, Vec3{2, 0, 0}
+	if got, want := PoleVector(root, mid, tip), (Vec3{0, 1, 0}); !got.ApproxFuncEqual(want, approx) {
+		t.Errorf("PoleVector(%v, %v, %v) = %v, want %v", root, mid, tip, got, want)
+	}
+	if got := PoleVector(root, Vec3{1, 0, 0}, tip); got != (Vec3{}) {
+		t.Errorf("PoleVector of a straight chain = %v, want zero", got)
+	}
+
+	for _, pole := range []Vec3{{1, 0, 5}, {3, -2, 0}, {0, 1, -1}, {1, 1, 0}} {
+		q := PoleVectorConstraint(root, mid, tip, pole)
+		newMid, newTip := q.Rotate(mid), q.Rotate(tip)
+		if !newTip.ApproxFuncEqual(tip, approx) {
+			t.Errorf("PoleVectorConstraint(%v) moves the tip to %v", pole, newTip)
+		}
+		want := ProjectOntoPlane(pole, tip).Normalize()
+		if got := PoleVector(root, newMid, newTip); !got.ApproxFuncEqual(want, approx) {
+			t.Errorf("PoleVectorConstraint(%v) bends the chain towards %v, want %v", pole, got, want)
+		}
+	}
+
+	if q := PoleVectorConstraint(root, mid, tip, Vec3{5, 0, 0}); !q.ApproxEqual(QuatIdent()) {
+		t.Errorf("PoleVectorConstraint with the pole on the axis = %v, want identity", q)
+	}
+	if q := PoleVectorConstraint(root, mid, tip, Vec3{1, -1, 0}); !q.OrientationEqualThreshold(QuatRotate(math.Pi, Vec3{1, 0, 0}), 1e-5) {
+		t.Errorf("PoleVectorConstraint with the pole opposite = %v, want a half turn about the chain", q)
+	}
+}
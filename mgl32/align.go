@@ -0,0 +1,75 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"errors"
+)
+
+// AlignPoints computes the rigid transformation (a rotation followed by a
+// translation) that best maps the points in src onto the corresponding points
+// in dst, in the sense of minimizing the root mean square distance between
+// them. This is the Kabsch algorithm; the rotation is always proper, never a
+// reflection.
+//
+// An error is returned if src and dst have different lengths or are empty. If
+// the points are degenerate (e.g. all collinear), the rotation about the
+// degenerate axes is arbitrary but the result still minimizes the error.
+func AlignPoints(src, dst []Vec3) (Mat4, error) {
+	return alignPoints(src, dst, false)
+}
+
+// AlignPointsSimilarity is like AlignPoints, except that the transformation
+// may also contain a uniform scale (Umeyama's method). This is useful to
+// register point sets measured in different units.
+func AlignPointsSimilarity(src, dst []Vec3) (Mat4, error) {
+	return alignPoints(src, dst, true)
+}
+
+func alignPoints(src, dst []Vec3, withScale bool) (Mat4, error) {
+	if len(src) != len(dst) {
+		return Mat4{}, errors.New("point sets must have the same number of points")
+	}
+	if len(src) == 0 {
+		return Mat4{}, errors.New("cannot align empty point sets")
+	}
+
+	n := float32(len(src))
+	var srcMean, dstMean Vec3
+	for i := range src {
+		srcMean = srcMean.Add(src[i])
+		dstMean = dstMean.Add(dst[i])
+	}
+	srcMean, dstMean = srcMean.Mul(1/n), dstMean.Mul(1/n)
+
+	var cov Mat3
+	var srcVar float32
+	for i := range src {
+		s, d := src[i].Sub(srcMean), dst[i].Sub(dstMean)
+		cov = cov.Add(d.OuterProd3(s))
+		srcVar += s.LenSqr()
+	}
+
+	u, sigma, v := cov.SVD()
+
+	// Flip the axis of the smallest singular value if the optimal orthogonal
+	// matrix would be a reflection.
+	d := Vec3{1, 1, 1}
+	if u.Det()*v.Det() < 0 {
+		d[2] = -1
+	}
+	rot := u.Mul3(Diag3(d)).Mul3(v.Transpose())
+
+	scale := float32(1)
+	if withScale && srcVar > 0 {
+		scale = sigma.Dot(d) / srcVar
+	}
+
+	t := dstMean.Sub(rot.Mul3x1(srcMean).Mul(scale))
+	m := rot.Mul(scale).Mat4()
+	m.SetCol(3, t.Vec4(1))
+
+	return m, nil
+}
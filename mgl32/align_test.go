@@ -0,0 +1,83 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+var alignTestPoints = []Vec3{
+	{0, 0, 0}, {1, 0, 0}, {0, 2, 0}, {0, 0, 3}, {1, 1, 1}, {-2, 0.5, 1},
+}
+
+func TestAlignPointsRigid(t *testing.T) {
+	t.Parallel()
+
+	expected := Translate3D(1, -2, 5).Mul4(HomogRotate3D(1.1, Vec3{1, 2, -1}.Normalize()))
+	dst := make([]Vec3, len(alignTestPoints))
+	for i, p := range alignTestPoints {
+		dst[i] = TransformCoordinate(p, expected)
+	}
+
+	m, err := AlignPoints(alignTestPoints, dst)
+	if err != nil {
+		t.Fatalf("AlignPoints returned error: %v", err)
+	}
+	if !m.ApproxFuncEqual(expected, func(a, b float32) bool { return Abs(a-b) < 1e-4 }) {
+		t.Errorf("AlignPoints found %v, expected %v", m, expected)
+	}
+}
+
+func TestAlignPointsSimilarity(t *testing.T) {
+	t.Parallel()
+
+	expected := Translate3D(-3, 0, 2).Mul4(HomogRotate3DY(2.5)).Mul4(Scale3D(2.5, 2.5, 2.5))
+	dst := make([]Vec3, len(alignTestPoints))
+	for i, p := range alignTestPoints {
+		dst[i] = TransformCoordinate(p, expected)
+	}
+
+	m, err := AlignPointsSimilarity(alignTestPoints, dst)
+	if err != nil {
+		t.Fatalf("AlignPointsSimilarity returned error: %v", err)
+	}
+	if !m.ApproxFuncEqual(expected, func(a, b float32) bool { return Abs(a-b) < 1e-4 }) {
+		t.Errorf("AlignPointsSimilarity found %v, expected %v", m, expected)
+	}
+
+	// A rigid alignment can't recover the scale, but should never reflect.
+	if m, _ = AlignPoints(alignTestPoints, dst); m.Mat3().Det() < 0 {
+		t.Errorf("AlignPoints returned a reflection: %v", m)
+	}
+}
+
+func TestAlignPointsReflection(t *testing.T) {
+	t.Parallel()
+
+	mirror := Scale3D(1, 1, -1)
+	dst := make([]Vec3, len(alignTestPoints))
+	for i, p := range alignTestPoints {
+		dst[i] = TransformCoordinate(p, mirror)
+	}
+
+	m, err := AlignPoints(alignTestPoints, dst)
+	if err != nil {
+		t.Fatalf("AlignPoints returned error: %v", err)
+	}
+	if !FloatEqualThreshold(m.Mat3().Det(), 1, 1e-4) {
+		t.Errorf("AlignPoints of mirrored points should still give a rotation, got det %v", m.Mat3().Det())
+	}
+}
+
+func TestAlignPointsErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := AlignPoints(alignTestPoints, alignTestPoints[:2]); err == nil {
+		t.Errorf("Expected an error for point sets of different length")
+	}
+	if _, err := AlignPoints(nil, nil); err == nil {
+		t.Errorf("Expected an error for empty point sets")
+	}
+}
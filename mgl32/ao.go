@@ -0,0 +1,143 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// IntegrateHemisphere returns the Monte Carlo estimate of the integral of f
+// over the hemisphere around normal, with respect to solid angle, from the
+// sample directions dirs drawn with the density pdf (such as
+// CosineHemispherePDF). Samples below the hemisphere, or with a density of
+// 0, contribute nothing.
+func IntegrateHemisphere(normal Vec3, dirs []Vec3, f func(dir Vec3) float32, pdf func(normal, dir Vec3) float32) float32 {
+	if len(dirs) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, dir := range dirs {
+		if normal.Dot(dir) <= 0 {
+			continue
+		}
+		if p := pdf(normal, dir); p > 0 {
+			sum += float64(f(dir)) / float64(p)
+		}
+	}
+	return float32(sum / float64(len(dirs)))
+}
+
+// AmbientOcclusion returns the ambient visibility of a surface point with the
+// given normal, the cosine-weighted fraction of its hemisphere that isn't
+// occluded, from the sample directions dirs drawn with the density pdf and
+// whether each of them escapes the scene. It is 1 for an unoccluded point
+// and 0 for a fully occluded one; the ambient occlusion is 1 minus it.
+//
+// With directions from SampleCosineHemisphere and CosineHemispherePDF, this is
+// the fraction of visible samples.
+func AmbientOcclusion(normal Vec3, dirs []Vec3, visible []bool, pdf func(normal, dir Vec3) float32) float32 {
+	if len(dirs) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for i, dir := range dirs {
+		c := normal.Dot(dir)
+		if !visible[i] || c <= 0 {
+			continue
+		}
+		if p := pdf(normal, dir); p > 0 {
+			sum += float64(c) / (math.Pi * float64(p))
+		}
+	}
+	return float32(sum / float64(len(dirs)))
+}
+
+// BentNormal returns the average of the unoccluded sample directions, the
+// bent normal used for ambient lighting lookups in place of the surface
+// normal. Samples should be cosine distributed, as from
+// SampleCosineHemisphere. If no sample is visible, the result is normal.
+func BentNormal(normal Vec3, dirs []Vec3, visible []bool) Vec3 {
+	var sum Vec3
+	for i, dir := range dirs {
+		if visible[i] {
+			sum = sum.Add(dir)
+		}
+	}
+	if sum.LenSqr() == 0 {
+		return normal
+	}
+	return sum.Normalize()
+}
+
+// VisibilityConeAngle returns the half-angle of the cone around the bent
+// normal whose cosine-weighted solid angle matches the given ambient
+// visibility, asin(sqrt(visibility)), for cone-traced ambient lighting.
+func VisibilityConeAngle(visibility float32) float32 {
+	return float32(math.Asin(math.Sqrt(float64(Clamp(visibility, 0, 1)))))
+}
+
+// HorizonAngle returns the elevation of the horizon seen from origin in the
+// direction tangent, perpendicular to the surface normal: the largest angle
+// above the tangent plane of the points that lie ahead of origin along
+// tangent, in [0, Pi/2]. It is 0 if no point rises above the plane.
+func HorizonAngle(origin, normal, tangent Vec3, points []Vec3) float32 {
+	var h float64
+	for _, p := range points {
+		d := p.Sub(origin)
+		x, y := d.Dot(tangent), d.Dot(normal)
+		if x < 0 || y <= 0 {
+			continue
+		}
+		if a := math.Atan2(float64(y), float64(x)); a > h {
+			h = a
+		}
+	}
+	return float32(h)
+}
+
+// HorizonVisibility returns the ambient visibility, as with AmbientOcclusion,
+// of a point whose horizon elevations, as returned by HorizonAngle, are given
+// for evenly spaced directions around its normal: the mean of cos(h)^2.
+func HorizonVisibility(horizons []float32) float32 {
+	if len(horizons) == 0 {
+		return 1
+	}
+
+	var sum float64
+	for _, h := range horizons {
+		c := math.Cos(float64(h))
+		sum += c * c
+	}
+	return float32(sum / float64(len(horizons)))
+}
+
+// ClampHorizonAngles clamps the horizon angles h1 and h2 of a slice through
+// the view direction, measured from it on either side (h1 <= 0 <= h2 for an
+// unoccluded flat surface facing the viewer), to the hemisphere around the
+// normal, whose projection onto the slice is at angle n. This is the
+// clamping step of ground truth ambient occlusion (Jimenez et al., "Practical
+// Realtime Strategies for Accurate Indirect Occlusion", 2016).
+func ClampHorizonAngles(h1, h2, n float32) (float32, float32) {
+	if h1 < n-math.Pi/2 {
+		h1 = n - math.Pi/2
+	}
+	if h2 > n+math.Pi/2 {
+		h2 = n + math.Pi/2
+	}
+	return h1, h2
+}
+
+// SliceVisibility returns the cosine-weighted visibility of a slice with the
+// clamped horizon angles h1 and h2 and projected normal angle n, as used by
+// ClampHorizonAngles. Unoccluded, with h1 = n-Pi/2 and h2 = n+Pi/2, it is
+// cos(n)+n*sin(n), so 1 when the normal faces the viewer. As in the paper,
+// average it over slices weighted by the length of the projected normal.
+func SliceVisibility(h1, h2, n float32) float32 {
+	cn, sn := math.Cos(float64(n)), math.Sin(float64(n))
+	arc := func(h float64) float64 {
+		return -math.Cos(2*h-float64(n)) + cn + 2*h*sn
+	}
+	return float32((arc(float64(h1)) + arc(float64(h2))) / 4)
+}
@@ -0,0 +1,172 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// hemisphereSamples returns n*n stratified directions around normal from
+// sample.
+func hemisphereSamples(normal Vec3, n int, sample func(Vec3, Vec2) Vec3) []Vec3 {
+	dirs := make([]Vec3, 0, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			u := Vec2{(float32(i) + 0.5) / float32(n), (float32(j) + 0.5) / float32(n)}
+			dirs = append(dirs, sample(normal, u))
+		}
+	}
+	return dirs
+}
+
+func uniformPDF(normal, dir Vec3) float32 {
+	return UniformHemispherePDF()
+}
+
+func TestIntegrateHemisphere(t *testing.T) {
+	t.Parallel()
+
+	normal := Vec3{0, 0, 1}
+	dirs := hemisphereSamples(normal, 32, SampleUniformHemisphere)
+	cos := func(dir Vec3) float32 { return normal.Dot(dir) }
+	if got := IntegrateHemisphere(normal, dirs, cos, uniformPDF); !FloatEqualThreshold(got, math.Pi, 1e-2) {
+		t.Errorf("IntegrateHemisphere(cos) = %v, want Pi", got)
+	}
+
+	one := func(Vec3) float32 { return 1 }
+	if got := IntegrateHemisphere(normal, dirs, one, uniformPDF); !FloatEqualThreshold(got, 2*math.Pi, 1e-5) {
+		t.Errorf("IntegrateHemisphere(1) = %v, want 2*Pi", got)
+	}
+
+	below := []Vec3{{0, 0, -1}, {1, 0, -0.5}}
+	if got := IntegrateHemisphere(normal, below, one, uniformPDF); got != 0 {
+		t.Errorf("IntegrateHemisphere below the surface = %v, want 0", got)
+	}
+}
+
+func TestAmbientOcclusion(t *testing.T) {
+	t.Parallel()
+
+	normal := Vec3{0, 1, 1}.Normalize()
+	for _, test := range []struct {
+		sample func(Vec3, Vec2) Vec3
+		pdf    func(Vec3, Vec3) float32
+		eps    float32
+	}{
+		{SampleCosineHemisphere, CosineHemispherePDF, 1e-5},
+		{SampleUniformHemisphere, uniformPDF, 1e-2},
+	} {
+		dirs := hemisphereSamples(normal, 32, test.sample)
+		visible := make([]bool, len(dirs))
+		for i := range visible {
+			visible[i] = true
+		}
+		if got := AmbientOcclusion(normal, dirs, visible, test.pdf); !FloatEqualThreshold(got, 1, test.eps) {
+			t.Errorf("AmbientOcclusion unoccluded = %v, want 1", got)
+		}
+		if got := BentNormal(normal, dirs, visible); !got.ApproxFuncEqual(normal, absEqual(1e-2)) {
+			t.Errorf("BentNormal unoccluded = %v, want %v", got, normal)
+		}
+
+		// A wall blocking the half of the hemisphere towards +x.
+		for i, dir := range dirs {
+			visible[i] = dir[0] < 0
+		}
+		if got := AmbientOcclusion(normal, dirs, visible, test.pdf); !FloatEqualThreshold(got, 0.5, 2*test.eps) {
+			t.Errorf("AmbientOcclusion half occluded = %v, want 0.5", got)
+		}
+		if got := BentNormal(normal, dirs, visible); got[0] >= 0 || got.Dot(normal) <= 0 {
+			t.Errorf("BentNormal half occluded = %v, want it bent towards -x", got)
+		}
+
+		for i := range visible {
+			visible[i] = false
+		}
+		if got := AmbientOcclusion(normal, dirs, visible, test.pdf); got != 0 {
+			t.Errorf("AmbientOcclusion occluded = %v, want 0", got)
+		}
+		if got := BentNormal(normal, dirs, visible); got != normal {
+			t.Errorf("BentNormal occluded = %v, want %v", got, normal)
+		}
+	}
+}
+
+func TestVisibilityConeAngle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct{ visibility, angle float32 }{
+		{0, 0},
+		{0.5, math.Pi / 4},
+		{1, math.Pi / 2},
+		{1.5, math.Pi / 2},
+	}
+	for _, test := range tests {
+		if got := VisibilityConeAngle(test.visibility); !FloatEqualThreshold(got, test.angle, 1e-6) {
+			t.Errorf("VisibilityConeAngle(%v) = %v, want %v", test.visibility, got, test.angle)
+		}
+	}
+}
+
+func TestHorizonAngle(t *testing.T) {
+	t.Parallel()
+
+	origin, normal, tangent := Vec3{1, 1, 1}, Vec3{0, 1, 0}, Vec3{1, 0, 0}
+
+	tests := []struct {
+		points []Vec3
+		angle  float32
+	}{
+		{nil, 0},
+		{[]Vec3{{2, 2, 1}}, math.Pi / 4},
+		{[]Vec3{{3, 0, 1}, {2, 1, 5}}, 0},
+		{[]Vec3{{0, 9, 1}, {5, 2, 1}, {1, 3, 1}}, math.Pi / 2},
+		{[]Vec3{{4, 1 + float32(math.Sqrt(3)), 1}, {10, 2, 1}}, math.Pi / 6},
+	}
+	for _, test := range tests {
+		if got := HorizonAngle(origin, normal, tangent, test.points); !FloatEqualThreshold(got, test.angle, 1e-6) {
+			t.Errorf("HorizonAngle(%v) = %v, want %v", test.points, got, test.angle)
+		}
+	}
+
+	horizons := []float32{0, 0, 0, 0}
+	if got := HorizonVisibility(horizons); got != 1 {
+		t.Errorf("HorizonVisibility(%v) = %v, want 1", horizons, got)
+	}
+	horizons = []float32{math.Pi / 4, math.Pi / 4, 0, math.Pi / 2}
+	if got := HorizonVisibility(horizons); !FloatEqualThreshold(got, 0.5, 1e-6) {
+		t.Errorf("HorizonVisibility(%v) = %v, want 0.5", horizons, got)
+	}
+}
+
+func TestSliceVisibility(t *testing.T) {
+	t.Parallel()
+
+	h1, h2 := ClampHorizonAngles(-2, 2, 0)
+	if !FloatEqualThreshold(h1, -math.Pi/2, 1e-6) || !FloatEqualThreshold(h2, math.Pi/2, 1e-6) {
+		t.Errorf("ClampHorizonAngles(-2, 2, 0) = %v, %v", h1, h2)
+	}
+	if got := SliceVisibility(h1, h2, 0); !FloatEqualThreshold(got, 1, 1e-6) {
+		t.Errorf("SliceVisibility unoccluded = %v, want 1", got)
+	}
+	if got := SliceVisibility(h1, 0, 0); !FloatEqualThreshold(got, 0.5, 1e-6) {
+		t.Errorf("SliceVisibility half occluded = %v, want 0.5", got)
+	}
+	if h1, h2 := ClampHorizonAngles(-1, 0.5, 0.3); h1 != -1 || h2 != 0.5 {
+		t.Errorf("ClampHorizonAngles(-1, 0.5, 0.3) = %v, %v", h1, h2)
+	}
+
+	// Against numerical integration of cos(theta-n)*|sin(theta)|.
+	a, b, n := -1.0, 0.8, 0.3
+	const steps = 10000
+	var want float64
+	for i := 0; i < steps; i++ {
+		th := a + (b-a)*(float64(i)+0.5)/steps
+		want += math.Cos(th-n) * math.Abs(math.Sin(th)) * (b - a) / steps
+	}
+	if got := SliceVisibility(float32(a), float32(b), float32(n)); !FloatEqualThreshold(got, float32(want), 1e-5) {
+		t.Errorf("SliceVisibility(%v, %v, %v) = %v, want %v", a, b, n, got, want)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"sort"
+)
+
+// Curve is a parametric curve in 3D space for t in [0, 1]. The parameter of
+// most curves doesn't advance at a constant speed along them; an
+// ArcLengthTable maps distances along a Curve to its parameter.
+type Curve interface {
+	// Point returns the point of the curve at t.
+	Point(t float32) Vec3
+	// Derivative returns the derivative of Point with respect to t.
+	Derivative(t float32) Vec3
+}
+
+// CubicBezier3D is the Curve of CubicBezierCurve3D through its four control
+// points.
+type CubicBezier3D [4]Vec3
+
+// Point returns the point at t along the curve, as CubicBezierCurve3D does.
+func (c CubicBezier3D) Point(t float32) Vec3 {
+	return CubicBezierCurve3D(t, c[0], c[1], c[2], c[3])
+}
+
+// Derivative returns the derivative of the curve at t.
+func (c CubicBezier3D) Derivative(t float32) Vec3 {
+	s := 1 - t
+	d := c[1].Sub(c[0]).Mul(s * s).Add(c[2].Sub(c[1]).Mul(2 * s * t)).Add(c[3].Sub(c[2]).Mul(t * t))
+	return d.Mul(3)
+}
+
+// Split splits the curve at t with De Casteljau's algorithm into the curves
+// before and after t, each covering its part of the curve for t in [0, 1].
+func (c CubicBezier3D) Split(t float32) (CubicBezier3D, CubicBezier3D) {
+	p01, p12, p23 := lerp3(c[0], c[1], t), lerp3(c[1], c[2], t), lerp3(c[2], c[3], t)
+	p012, p123 := lerp3(p01, p12, t), lerp3(p12, p23, t)
+	mid := lerp3(p012, p123, t)
+	return CubicBezier3D{c[0], p01, p012, mid}, CubicBezier3D{mid, p123, p23, c[3]}
+}
+
+func lerp3(a, b Vec3, t float32) Vec3 {
+	return a.Add(b.Sub(a).Mul(t))
+}
+
+// CatmullRomCurve3D is the Curve of CatmullRomSpline3D through its points,
+// with the whole spline mapped to t in [0, 1].
+type CatmullRomCurve3D []Vec3
+
+// Point returns the point at t along the curve.
+func (c CatmullRomCurve3D) Point(t float32) Vec3 {
+	return CatmullRomSpline3D(t*float32(len(c)-1), c)
+}
+
+// Derivative returns the derivative of the curve at t.
+func (c CatmullRomCurve3D) Derivative(t float32) Vec3 {
+	n := float32(len(c) - 1)
+	return CatmullRomSplineTangent3D(t*n, c).Mul(n)
+}
+
+// SubCurve returns the part of c for t in [t0, t1], as a Curve of its own
+// for t in [0, 1].
+func SubCurve(c Curve, t0, t1 float32) Curve {
+	return subCurve{c, t0, t1}
+}
+
+type subCurve struct {
+	c      Curve
+	t0, t1 float32
+}
+
+func (c subCurve) Point(t float32) Vec3 {
+	return c.c.Point(c.t0 + t*(c.t1-c.t0))
+}
+
+func (c subCurve) Derivative(t float32) Vec3 {
+	return c.c.Derivative(c.t0 + t*(c.t1-c.t0)).Mul(c.t1 - c.t0)
+}
+
+// ArcLengthTable maps the parameter of a curve to the distance along it and
+// back, to move along the curve at a constant speed. It holds the length of
+// the curve up to parameters chosen adaptively, and integrates the speed of the
+// curve between them when queried.
+type ArcLengthTable struct {
+	curve   Curve
+	ts      []float32
+	lengths []float32
+}
+
+const (
+	arcLengthMinDepth = 3
+	arcLengthMaxDepth = 16
+)
+
+// NewArcLengthTable measures the curve c, splitting it in halves until the
+// length of each span agrees with the sum of the lengths of its halves, to
+// within a tolerance halved with each split. The length of the whole curve is
+// then accurate to about tolerance.
+func NewArcLengthTable(c Curve, tolerance float32) *ArcLengthTable {
+	tab := &ArcLengthTable{curve: c, ts: []float32{0}, lengths: []float32{0}}
+	tab.subdivide(0, 1, tab.spanLength(0, 1), tolerance, 0)
+	return tab
+}
+
+func (tab *ArcLengthTable) subdivide(a, b, length, tolerance float32, depth int) {
+	m := (a + b) / 2
+	left, right := tab.spanLength(a, m), tab.spanLength(m, b)
+	if depth < arcLengthMinDepth || depth < arcLengthMaxDepth && Abs(left+right-length) > tolerance {
+		tab.subdivide(a, m, left, tolerance/2, depth+1)
+		tab.subdivide(m, b, right, tolerance/2, depth+1)
+		return
+	}
+
+	last := tab.lengths[len(tab.lengths)-1]
+	tab.ts = append(tab.ts, m, b)
+	tab.lengths = append(tab.lengths, last+left, last+left+right)
+}
+
+// gaussLegendre5 are the nodes in [-1, 1] and weights of the 5 point
+// Gauss-Legendre quadrature, exact for polynomials up to degree 9.
+var gaussLegendre5 = [5][2]float32{
+	{0, 0.5688888888888889},
+	{-0.5384693101056831, 0.4786286704993665},
+	{0.5384693101056831, 0.4786286704993665},
+	{-0.9061798459386640, 0.2369268850561891},
+	{0.9061798459386640, 0.2369268850561891},
+}
+
+// spanLength integrates the speed of the curve from a to b.
+func (tab *ArcLengthTable) spanLength(a, b float32) float32 {
+	half, mid := (b-a)/2, (a+b)/2
+	var sum float32
+	for _, node := range gaussLegendre5 {
+		sum += node[1] * tab.curve.Derivative(mid+half*node[0]).Len()
+	}
+	return sum * half
+}
+
+// Curve returns the curve of the table.
+func (tab *ArcLengthTable) Curve() Curve {
+	return tab.curve
+}
+
+// Length returns the length of the curve.
+func (tab *ArcLengthTable) Length() float32 {
+	return tab.lengths[len(tab.lengths)-1]
+}
+
+// DistanceAt returns the distance along the curve from its start to the point
+// at t, with t clamped to [0, 1].
+func (tab *ArcLengthTable) DistanceAt(t float32) float32 {
+	t = Clamp(t, 0, 1)
+	i := sort.Search(len(tab.ts), func(i int) bool { return tab.ts[i] >= t })
+	if tab.ts[i] == t {
+		return tab.lengths[i]
+	}
+	return tab.lengths[i-1] + tab.spanLength(tab.ts[i-1], t)
+}
+
+// TAtDistance returns the parameter of the point at the distance d along the
+// curve from its start, with d clamped to [0, Length()]. Starting from the
+// linear interpolation of the table, it refines t with Newton's method.
+func (tab *ArcLengthTable) TAtDistance(d float32) float32 {
+	d = Clamp(d, 0, tab.Length())
+	i := sort.Search(len(tab.lengths), func(i int) bool { return tab.lengths[i] >= d })
+	if tab.lengths[i] == d {
+		return tab.ts[i]
+	}
+
+	t0, t1 := tab.ts[i-1], tab.ts[i]
+	s0, s1 := tab.lengths[i-1], tab.lengths[i]
+	t := t0 + (t1-t0)*(d-s0)/(s1-s0)
+	for iter := 0; iter < 4; iter++ {
+		speed := tab.curve.Derivative(t).Len()
+		if speed == 0 {
+			break
+		}
+		t = Clamp(t-(s0+tab.spanLength(t0, t)-d)/speed, t0, t1)
+	}
+	return t
+}
+
+// PointAtDistance returns the point at the distance d along the curve from its
+// start, with d clamped to [0, Length()].
+func (tab *ArcLengthTable) PointAtDistance(d float32) Vec3 {
+	return tab.curve.Point(tab.TAtDistance(d))
+}
+
+// Sample returns numPoints points evenly spaced along the curve by distance,
+// starting and ending at the ends of the curve if numPoints is at least 2.
+func (tab *ArcLengthTable) Sample(numPoints int) []Vec3 {
+	points := make([]Vec3, numPoints)
+	if numPoints == 1 {
+		points[0] = tab.curve.Point(0)
+		return points
+	}
+	for i := range points {
+		points[i] = tab.PointAtDistance(tab.Length() * float32(i) / float32(numPoints-1))
+	}
+	return points
+}
+
+// SplitAtDistance splits the curve at the distance d along it from its start
+// into the curves before and after that point, as from SubCurve.
+func (tab *ArcLengthTable) SplitAtDistance(d float32) (Curve, Curve) {
+	t := tab.TAtDistance(d)
+	return SubCurve(tab.curve, 0, t), SubCurve(tab.curve, t, 1)
+}
@@ -0,0 +1,89 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestArcLengthTable(t *testing.T) {
+	t.Parallel()
+
+	// A quarter circle of radius 2, approximated with the usual Bezier.
+	k := float32(4 * (math.Sqrt2 - 1) / 3)
+	arc := CubicBezier3D{{2, 0, 0}, {2, 2 * k, 0}, {2 * k, 2, 0}, {0, 2, 0}}
+	line := CubicBezier3D{{0, 0, 0}, {0, 0, 0}, {0, 0, 3}, {0, 0, 3}} // Slow at the ends
+	tests := []struct {
+		Description string
+		Curve       Curve
+		Length      float32
+	}{
+		{"Quarter circle", arc, math.Pi},
+		{"Line", line, 3},
+		{"Catmull-Rom", CatmullRomCurve3D{{0, 0, 0}, {1, 0, 0}, {2, 0, 0}}, 2},
+	}
+
+	for _, c := range tests {
+		tab := NewArcLengthTable(c.Curve, 1e-5)
+		if !FloatEqualThreshold(tab.Length(), c.Length, 1e-3) {
+			t.Errorf("%s: Length = %v, want %v", c.Description, tab.Length(), c.Length)
+		}
+
+		for _, d := range []float32{0, 0.3, 1, 1.7, tab.Length()} {
+			s := tab.TAtDistance(d)
+			if got := tab.DistanceAt(s); Abs(got-d) > 1e-4 {
+				t.Errorf("%s: DistanceAt(TAtDistance(%v)) = %v", c.Description, d, got)
+			}
+		}
+	}
+
+	tab := NewArcLengthTable(line, 1e-5)
+	points := tab.Sample(7)
+	for i, p := range points {
+		if want := (Vec3{0, 0, 0.5 * float32(i)}); !p.ApproxEqualThreshold(want, 1e-4) {
+			t.Errorf("Sample(7)[%d] of a line = %v, want %v", i, p, want)
+		}
+	}
+	if got := tab.PointAtDistance(10); got != line[3] {
+		t.Errorf("PointAtDistance beyond the end = %v, want %v", got, line[3])
+	}
+
+	before, after := tab.SplitAtDistance(1)
+	if p := before.Point(1); !p.ApproxEqualThreshold(Vec3{0, 0, 1}, 1e-4) || after.Point(0) != p {
+		t.Errorf("SplitAtDistance(1) split at %v and %v", p, after.Point(0))
+	}
+	if l := NewArcLengthTable(after, 1e-5).Length(); !FloatEqualThreshold(l, 2, 1e-4) {
+		t.Errorf("The length after SplitAtDistance(1) is %v, want 2", l)
+	}
+}
+
+func TestCubicBezier3D(t *testing.T) {
+	t.Parallel()
+
+	c := CubicBezier3D{{0, 0, 0}, {1, 2, 0}, {3, 2, 1}, {4, -1, 2}}
+	const h = 1e-2
+	for _, s := range []float32{0.1, 0.5, 0.8} {
+		want := c.Point(s + h).Sub(c.Point(s - h)).Mul(1 / (2 * h))
+		if got := c.Derivative(s); !got.ApproxEqualThreshold(want, 1e-2) {
+			t.Errorf("Derivative(%v) = %v, want about %v", s, got, want)
+		}
+	}
+
+	a, b := c.Split(0.25)
+	for _, s := range []float32{0, 0.3, 1} {
+		if got, want := a.Point(s), c.Point(0.25*s); !got.ApproxEqualThreshold(want, 1e-5) {
+			t.Errorf("first half of Split(0.25) at %v = %v, want %v", s, got, want)
+		}
+		if got, want := b.Point(s), c.Point(0.25+0.75*s); !got.ApproxEqualThreshold(want, 1e-5) {
+			t.Errorf("second half of Split(0.25) at %v = %v, want %v", s, got, want)
+		}
+	}
+
+	sub := SubCurve(c, 0.25, 1)
+	if got, want := sub.Derivative(0.5), b.Derivative(0.5); !got.ApproxEqualThreshold(want, 1e-4) {
+		t.Errorf("SubCurve derivative = %v, want %v", got, want)
+	}
+}
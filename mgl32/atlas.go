@@ -0,0 +1,52 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// AtlasTransform returns the 2D homogeneous transform mapping texture
+// coordinates in [0, 1] of a texture packed into a region of an atlas to the
+// matching texture coordinates of the atlas. The region is in the same units
+// as atlasSize, usually pixels, so UV (0, 0) maps to region.Min/atlasSize and
+// (1, 1) to region.Max/atlasSize. Its inverse maps back.
+//
+// A rotated region, as produced by some packers, can be handled by applying
+// a rotation of the UVs about (0.5, 0.5) first.
+func AtlasTransform(region Rect2, atlasSize Vec2) Mat3 {
+	size := region.Max.Sub(region.Min)
+	return Mat3{
+		size[0] / atlasSize[0], 0, 0,
+		0, size[1] / atlasSize[1], 0,
+		region.Min[0] / atlasSize[0], region.Min[1] / atlasSize[1], 1,
+	}
+}
+
+// TransformUVs stores in dst the texture coordinates of src transformed by the
+// 2D homogeneous transform m, such as one returned by AtlasTransform, and
+// returns it. Dst is reallocated if it is too short; it may be src itself to
+// transform in place.
+func TransformUVs(dst, src []Vec2, m Mat3) []Vec2 {
+	if len(dst) < len(src) {
+		dst = make([]Vec2, len(src))
+	}
+	dst = dst[:len(src)]
+	for i, uv := range src {
+		dst[i] = Vec2{
+			m[0]*uv[0] + m[3]*uv[1] + m[6],
+			m[1]*uv[0] + m[4]*uv[1] + m[7],
+		}
+	}
+	return dst
+}
+
+// TransformUVsInterleaved transforms, in place, texture coordinates stored in
+// an interleaved vertex buffer by the 2D homogeneous transform m. The first
+// coordinate pair starts at data[offset] and each vertex spans stride
+// elements, so for tightly packed UVs stride is 2.
+func TransformUVsInterleaved(data []float32, offset, stride int, m Mat3) {
+	for i := offset; i+1 < len(data); i += stride {
+		u, v := data[i], data[i+1]
+		data[i] = m[0]*u + m[3]*v + m[6]
+		data[i+1] = m[1]*u + m[4]*v + m[7]
+	}
+}
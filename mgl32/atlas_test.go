@@ -0,0 +1,67 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "testing"
+
+func TestAtlasTransform(t *testing.T) {
+	t.Parallel()
+
+	region := Rect2{Vec2{256, 128}, Vec2{384, 192}}
+	m := AtlasTransform(region, Vec2{512, 256})
+
+	tests := []struct{ uv, want Vec2 }{
+		{Vec2{0, 0}, Vec2{0.5, 0.5}},
+		{Vec2{1, 1}, Vec2{0.75, 0.75}},
+		{Vec2{0.5, 0.25}, Vec2{0.625, 0.5625}},
+	}
+	for _, test := range tests {
+		if got := m.Mul3x1(test.uv.Vec3(1)).Vec2(); !got.ApproxEqual(test.want) {
+			t.Errorf("AtlasTransform maps %v to %v, want %v", test.uv, got, test.want)
+		}
+		if got := m.Inv().Mul3x1(test.want.Vec3(1)).Vec2(); !got.ApproxEqual(test.uv) {
+			t.Errorf("inverse AtlasTransform maps %v to %v, want %v", test.want, got, test.uv)
+		}
+	}
+
+	if got, want := m, Translate2D(0.5, 0.5).Mul3(Scale2D(0.25, 0.25)); !got.ApproxEqual(want) {
+		t.Errorf("AtlasTransform(%v) = %v, want %v", region, got, want)
+	}
+}
+
+func TestTransformUVs(t *testing.T) {
+	t.Parallel()
+
+	m := AtlasTransform(Rect2{Vec2{2, 0}, Vec2{4, 1}}, Vec2{4, 4})
+	src := []Vec2{{0, 0}, {1, 0}, {0.5, 1}}
+	want := []Vec2{{0.5, 0}, {1, 0}, {0.75, 0.25}}
+
+	dst := TransformUVs(nil, src, m)
+	for i := range want {
+		if !dst[i].ApproxEqual(want[i]) {
+			t.Errorf("TransformUVs()[%d] = %v, want %v", i, dst[i], want[i])
+		}
+	}
+
+	// In place.
+	inPlace := append([]Vec2(nil), src...)
+	if got := TransformUVs(inPlace, inPlace, m); &got[0] != &inPlace[0] || got[2] != dst[2] {
+		t.Errorf("TransformUVs in place = %v, want %v", got, dst)
+	}
+
+	// Position (x, y, z) followed by UV (u, v).
+	data := []float32{
+		9, 9, 9, 0, 0,
+		9, 9, 9, 1, 0,
+		9, 9, 9, 0.5, 1,
+	}
+	TransformUVsInterleaved(data, 3, 5, m)
+	for i := range want {
+		got := Vec2{data[5*i+3], data[5*i+4]}
+		if !got.ApproxEqual(want[i]) || data[5*i] != 9 {
+			t.Errorf("TransformUVsInterleaved vertex %d = %v, want UV %v", i, data[5*i:5*i+5], want[i])
+		}
+	}
+}
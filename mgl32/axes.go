@@ -0,0 +1,91 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// AxisConvention describes the coordinate system conventions of a tool or
+// file format by the directions its right, up, and forward vectors point
+// along. Each of them should be one of the (possibly negated) unit axes.
+type AxisConvention struct {
+	Right, Up, Forward Vec3
+}
+
+// Common axis conventions. "Forward" is the direction a camera or character
+// looks along by default.
+var (
+	// YUpRightHanded is used by OpenGL, glTF, Maya, and this package's
+	// LookAt and Perspective functions.
+	YUpRightHanded = AxisConvention{Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, -1}}
+
+	// ZUpRightHanded is used by Blender and 3ds Max.
+	ZUpRightHanded = AxisConvention{Vec3{1, 0, 0}, Vec3{0, 0, 1}, Vec3{0, 1, 0}}
+
+	// YUpLeftHanded is used by Unity and Direct3D.
+	YUpLeftHanded = AxisConvention{Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1}}
+
+	// ZUpLeftHanded is used by Unreal Engine.
+	ZUpLeftHanded = AxisConvention{Vec3{0, 1, 0}, Vec3{0, 0, 1}, Vec3{1, 0, 0}}
+)
+
+// RightHanded returns whether the convention is right handed, meaning that
+// Right cross Up points backward (the opposite of Forward).
+func (c AxisConvention) RightHanded() bool {
+	return c.Right.Cross(c.Up).Dot(c.Forward) < 0
+}
+
+// basis returns the matrix taking (right, up, forward) coordinates to this
+// convention's coordinates.
+func (c AxisConvention) basis() Mat3 {
+	return Mat3FromCols(c.Right, c.Up, c.Forward)
+}
+
+// AxisRemap3 returns the matrix that converts vectors and positions from the
+// from convention to the to convention. The result is always orthogonal, and a
+// reflection whenever the handedness of the conventions differs.
+func AxisRemap3(from, to AxisConvention) Mat3 {
+	// The bases are orthogonal, so their inverse is their transpose.
+	return to.basis().Mul3(from.basis().Transpose())
+}
+
+// AxisRemap is the homogeneous version of AxisRemap3.
+func AxisRemap(from, to AxisConvention) Mat4 {
+	return AxisRemap3(from, to).Mat4()
+}
+
+// RemapTransform converts the transformation matrix m, expressed in the from
+// convention, to the equivalent transformation in the to convention. That is,
+// transforming a remapped point with the result is the same as remapping the
+// point transformed by m.
+func RemapTransform(m Mat4, from, to AxisConvention) Mat4 {
+	r := AxisRemap(from, to)
+	return r.Mul4(m).Mul4(r.Transpose())
+}
+
+// RemapQuat converts the rotation q, expressed in the from convention, to the
+// equivalent rotation in the to convention. This is the quaternion version of
+// RemapTransform.
+func RemapQuat(q Quat, from, to AxisConvention) Quat {
+	r := AxisRemap3(from, to)
+
+	// The vector part of a quaternion transforms as an axis (a pseudovector),
+	// so it picks up an extra sign flip when the handedness changes.
+	v := r.Mul3x1(q.V)
+	if r.Det() < 0 {
+		v = v.Mul(-1)
+	}
+
+	return Quat{q.W, v}
+}
+
+// ConvertHandedness converts a transformation matrix between a right handed
+// and a left handed coordinate system by mirroring the Z axis (e.g. between
+// YUpRightHanded and YUpLeftHanded). The conversion is its own inverse.
+func ConvertHandedness(m Mat4) Mat4 {
+	return RemapTransform(m, YUpRightHanded, YUpLeftHanded)
+}
+
+// ConvertHandednessQuat is the quaternion version of ConvertHandedness.
+func ConvertHandednessQuat(q Quat) Quat {
+	return Quat{q.W, Vec3{-q.V[0], -q.V[1], q.V[2]}}
+}
@@ -0,0 +1,81 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestAxisConventionHandedness(t *testing.T) {
+	t.Parallel()
+
+	if !YUpRightHanded.RightHanded() || !ZUpRightHanded.RightHanded() {
+		t.Errorf("Right handed conventions reported as left handed")
+	}
+	if YUpLeftHanded.RightHanded() || ZUpLeftHanded.RightHanded() {
+		t.Errorf("Left handed conventions reported as right handed")
+	}
+}
+
+func TestAxisRemap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		From, To AxisConvention
+		In, Out  Vec3
+	}{
+		// Blender's up is GL's up.
+		{ZUpRightHanded, YUpRightHanded, Vec3{0, 0, 1}, Vec3{0, 1, 0}},
+		// Blender's forward is GL's forward.
+		{ZUpRightHanded, YUpRightHanded, Vec3{0, 1, 0}, Vec3{0, 0, -1}},
+		{YUpRightHanded, YUpLeftHanded, Vec3{1, 2, 3}, Vec3{1, 2, -3}},
+		// Unreal's forward (X) is Unity's forward (Z), right (Y) is right (X).
+		{ZUpLeftHanded, YUpLeftHanded, Vec3{1, 2, 3}, Vec3{2, 3, 1}},
+	}
+
+	for _, test := range tests {
+		if got := TransformCoordinate(test.In, AxisRemap(test.From, test.To)); !got.ApproxEqual(test.Out) {
+			t.Errorf("AxisRemap(%v, %v) maps %v to %v, expected %v", test.From, test.To, test.In, got, test.Out)
+		}
+		if got := AxisRemap3(test.To, test.From).Mul3x1(test.Out); !got.ApproxEqual(test.In) {
+			t.Errorf("Inverse AxisRemap3(%v, %v) maps %v to %v, expected %v", test.To, test.From, test.Out, got, test.In)
+		}
+	}
+}
+
+func TestRemapTransformAndQuat(t *testing.T) {
+	t.Parallel()
+
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+	q := QuatRotate(0.7, Vec3{1, 2, 3}.Normalize())
+	m := Translate3D(1, 2, 3).Mul4(q.Mat4())
+	p := Vec3{0.5, -1, 2}
+
+	for _, conv := range [][2]AxisConvention{
+		{YUpRightHanded, ZUpRightHanded},
+		{YUpRightHanded, YUpLeftHanded},
+		{ZUpRightHanded, ZUpLeftHanded},
+	} {
+		r := AxisRemap(conv[0], conv[1])
+		m2 := RemapTransform(m, conv[0], conv[1])
+
+		expected := TransformCoordinate(TransformCoordinate(p, m), r)
+		if got := TransformCoordinate(TransformCoordinate(p, r), m2); !got.ApproxFuncEqual(expected, near) {
+			t.Errorf("Remapped transform gives %v, expected %v", got, expected)
+		}
+
+		q2 := RemapQuat(q, conv[0], conv[1])
+		if !q2.Mat4().ApproxFuncEqual(RemapTransform(q.Mat4(), conv[0], conv[1]), near) {
+			t.Errorf("RemapQuat gives %v, expected the rotation of %v", q2.Mat4(), RemapTransform(q.Mat4(), conv[0], conv[1]))
+		}
+	}
+
+	if !ConvertHandednessQuat(q).Mat4().ApproxFuncEqual(ConvertHandedness(q.Mat4()), near) {
+		t.Errorf("ConvertHandednessQuat doesn't match ConvertHandedness")
+	}
+	if !ConvertHandedness(ConvertHandedness(m)).ApproxFuncEqual(m, near) {
+		t.Errorf("ConvertHandedness should be its own inverse")
+	}
+}
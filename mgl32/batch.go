@@ -0,0 +1,132 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// The batch transforms below apply a matrix to every element of a slice.
+// Compared to calling Mul4x1 in a loop, the matrix is loaded into locals once
+// and the loops are free of bounds checks, which matters when transforming
+// hundreds of thousands of vectors. They are written in pure Go; dst may be
+// src itself to transform in place, and is reallocated if it is too short.
+
+// MulVec4Many stores m.Mul4x1(src[i]) in dst[i] for every vector of src and
+// returns dst.
+func (m Mat4) MulVec4Many(dst, src []Vec4) []Vec4 {
+	if len(dst) < len(src) {
+		dst = make([]Vec4, len(src))
+	}
+	dst = dst[:len(src)]
+
+	m00, m10, m20, m30 := m[0], m[1], m[2], m[3]
+	m01, m11, m21, m31 := m[4], m[5], m[6], m[7]
+	m02, m12, m22, m32 := m[8], m[9], m[10], m[11]
+	m03, m13, m23, m33 := m[12], m[13], m[14], m[15]
+	for i, v := range src {
+		dst[i] = Vec4{
+			m00*v[0] + m01*v[1] + m02*v[2] + m03*v[3],
+			m10*v[0] + m11*v[1] + m12*v[2] + m13*v[3],
+			m20*v[0] + m21*v[1] + m22*v[2] + m23*v[3],
+			m30*v[0] + m31*v[1] + m32*v[2] + m33*v[3],
+		}
+	}
+	return dst
+}
+
+// MulVec3Many stores m.Mul3x1(src[i]) in dst[i] for every vector of src and
+// returns dst.
+func (m Mat3) MulVec3Many(dst, src []Vec3) []Vec3 {
+	if len(dst) < len(src) {
+		dst = make([]Vec3, len(src))
+	}
+	dst = dst[:len(src)]
+
+	m00, m10, m20 := m[0], m[1], m[2]
+	m01, m11, m21 := m[3], m[4], m[5]
+	m02, m12, m22 := m[6], m[7], m[8]
+	for i, v := range src {
+		dst[i] = Vec3{
+			m00*v[0] + m01*v[1] + m02*v[2],
+			m10*v[0] + m11*v[1] + m12*v[2],
+			m20*v[0] + m21*v[1] + m22*v[2],
+		}
+	}
+	return dst
+}
+
+// TransformPoints stores in dst the points of src transformed by the affine
+// matrix m and returns dst. The bottom row of m is ignored, so unlike
+// TransformCoordinates there is no division by w; use it for model and view
+// matrices.
+func (m Mat4) TransformPoints(dst, src []Vec3) []Vec3 {
+	if len(dst) < len(src) {
+		dst = make([]Vec3, len(src))
+	}
+	dst = dst[:len(src)]
+
+	m00, m10, m20 := m[0], m[1], m[2]
+	m01, m11, m21 := m[4], m[5], m[6]
+	m02, m12, m22 := m[8], m[9], m[10]
+	m03, m13, m23 := m[12], m[13], m[14]
+	for i, v := range src {
+		dst[i] = Vec3{
+			m00*v[0] + m01*v[1] + m02*v[2] + m03,
+			m10*v[0] + m11*v[1] + m12*v[2] + m13,
+			m20*v[0] + m21*v[1] + m22*v[2] + m23,
+		}
+	}
+	return dst
+}
+
+// TransformCoordinates stores TransformCoordinate(src[i], m) in dst[i] for
+// every point of src, including the division by w, and returns dst.
+func (m Mat4) TransformCoordinates(dst, src []Vec3) []Vec3 {
+	if len(dst) < len(src) {
+		dst = make([]Vec3, len(src))
+	}
+	dst = dst[:len(src)]
+
+	m00, m10, m20, m30 := m[0], m[1], m[2], m[3]
+	m01, m11, m21, m31 := m[4], m[5], m[6], m[7]
+	m02, m12, m22, m32 := m[8], m[9], m[10], m[11]
+	m03, m13, m23, m33 := m[12], m[13], m[14], m[15]
+	for i, v := range src {
+		w := 1 / (m30*v[0] + m31*v[1] + m32*v[2] + m33)
+		dst[i] = Vec3{
+			(m00*v[0] + m01*v[1] + m02*v[2] + m03) * w,
+			(m10*v[0] + m11*v[1] + m12*v[2] + m13) * w,
+			(m20*v[0] + m21*v[1] + m22*v[2] + m23) * w,
+		}
+	}
+	return dst
+}
+
+// TransformNormals stores TransformNormal(src[i], m) in dst[i] for every
+// vector of src, ignoring the translation of m, and returns dst. As with
+// TransformNormal, normals only stay perpendicular to their surface if m has
+// no non-uniform scaling; otherwise transform them by the inverse transpose.
+func (m Mat4) TransformNormals(dst, src []Vec3) []Vec3 {
+	return m.Mat3().MulVec3Many(dst, src)
+}
+
+// TransformPointsSoA transforms in place points stored as a structure of
+// arrays, point i being {x[i], y[i], z[i]}, by the affine matrix m, like
+// TransformPoints. This layout lets the loop work on one coordinate of many
+// points at a time. It panics if the slices don't have the same length.
+func (m Mat4) TransformPointsSoA(x, y, z []float32) {
+	if len(x) != len(y) || len(x) != len(z) {
+		panic("Mismatched coordinate slice lengths")
+	}
+	y, z = y[:len(x)], z[:len(x)]
+
+	m00, m10, m20 := m[0], m[1], m[2]
+	m01, m11, m21 := m[4], m[5], m[6]
+	m02, m12, m22 := m[8], m[9], m[10]
+	m03, m13, m23 := m[12], m[13], m[14]
+	for i, px := range x {
+		py, pz := y[i], z[i]
+		x[i] = m00*px + m01*py + m02*pz + m03
+		y[i] = m10*px + m11*py + m12*pz + m13
+		z[i] = m20*px + m21*py + m22*pz + m23
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func batchTestMatrices() (affine, projective Mat4) {
+	affine = Translate3D(1, -2, 3).Mul4(HomogRotate3D(0.7, Vec3{1, 2, 2}.Normalize())).Mul4(Scale3D(2, 3, 0.5))
+	projective = Perspective(1, 1.5, 0.1, 100).Mul4(affine)
+	return affine, projective
+}
+
+func TestBatchTransforms(t *testing.T) {
+	t.Parallel()
+
+	affine, projective := batchTestMatrices()
+	r := rand.New(rand.NewSource(1))
+	points := make([]Vec3, 37)
+	vecs := make([]Vec4, len(points))
+	for i := range points {
+		points[i] = Vec3{r.Float32()*10 - 5, r.Float32()*10 - 5, r.Float32()*10 - 5}
+		vecs[i] = points[i].Vec4(r.Float32())
+	}
+
+	got4 := projective.MulVec4Many(nil, vecs)
+	got3 := affine.Mat3().MulVec3Many(nil, points)
+	gotPoints := affine.TransformPoints(nil, points)
+	gotCoords := projective.TransformCoordinates(nil, points)
+	gotNormals := affine.TransformNormals(nil, points)
+	x, y, z := make([]float32, len(points)), make([]float32, len(points)), make([]float32, len(points))
+	for i, p := range points {
+		x[i], y[i], z[i] = p[0], p[1], p[2]
+	}
+	affine.TransformPointsSoA(x, y, z)
+
+	for i, p := range points {
+		if want := projective.Mul4x1(vecs[i]); got4[i] != want {
+			t.Errorf("MulVec4Many element %d = %v, want %v", i, got4[i], want)
+		}
+		if want := affine.Mat3().Mul3x1(p); got3[i] != want {
+			t.Errorf("MulVec3Many element %d = %v, want %v", i, got3[i], want)
+		}
+		want := TransformCoordinate(p, affine)
+		if !gotPoints[i].ApproxEqualThreshold(want, 1e-5) {
+			t.Errorf("TransformPoints element %d = %v, want %v", i, gotPoints[i], want)
+		}
+		if soa := (Vec3{x[i], y[i], z[i]}); soa != gotPoints[i] {
+			t.Errorf("TransformPointsSoA element %d = %v, want %v", i, soa, gotPoints[i])
+		}
+		if want := TransformCoordinate(p, projective); !gotCoords[i].ApproxEqualThreshold(want, 1e-5) {
+			t.Errorf("TransformCoordinates element %d = %v, want %v", i, gotCoords[i], want)
+		}
+		if want := TransformNormal(p, affine); !gotNormals[i].ApproxEqualThreshold(want, 1e-5) {
+			t.Errorf("TransformNormals element %d = %v, want %v", i, gotNormals[i], want)
+		}
+	}
+}
+
+func TestBatchTransformsInPlace(t *testing.T) {
+	t.Parallel()
+
+	affine, _ := batchTestMatrices()
+	src := []Vec3{{1, 2, 3}, {-4, 5, 0.5}}
+	want := affine.TransformPoints(nil, src)
+
+	buf := make([]Vec3, len(src), 8)
+	copy(buf, src)
+	if got := affine.TransformPoints(buf, buf); &got[0] != &buf[0] || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("TransformPoints in place = %v, want %v in the same array", got, want)
+	}
+
+	// A longer dst is truncated, a shorter one reallocated.
+	if got := affine.TransformPoints(make([]Vec3, 5), src); len(got) != len(src) {
+		t.Errorf("TransformPoints with a long dst returned %d elements, want %d", len(got), len(src))
+	}
+	if got := affine.TransformPoints(make([]Vec3, 1), src); len(got) != len(src) || got[1] != want[1] {
+		t.Errorf("TransformPoints with a short dst = %v, want %v", got, want)
+	}
+}
+
+func TestTransformPointsSoAPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TransformPointsSoA with mismatched lengths didn't panic")
+		}
+	}()
+	Ident4().TransformPointsSoA(make([]float32, 2), make([]float32, 2), make([]float32, 1))
+}
+
+func BenchmarkMulVec4Many(b *testing.B) {
+	m, _ := batchTestMatrices()
+	src, dst := make([]Vec4, 4096), make([]Vec4, 4096)
+	for i := range src {
+		src[i] = Vec4{float32(i), 1, 2, 1}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.MulVec4Many(dst, src)
+	}
+}
+
+func BenchmarkMul4x1Loop(b *testing.B) {
+	m, _ := batchTestMatrices()
+	src, dst := make([]Vec4, 4096), make([]Vec4, 4096)
+	for i := range src {
+		src[i] = Vec4{float32(i), 1, 2, 1}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range src {
+			dst[j] = m.Mul4x1(src[j])
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// FitCubicBezier3D fits a sequence of cubic bezier segments to the sampled
+// polyline points, using Philip J. Schneider's algorithm from "An Algorithm for
+// Automatically Fitting Digitized Curves" (Graphics Gems, 1990).
+//
+// Each returned segment holds its four control points, in the order expected
+// by CubicBezierCurve3D. Consecutive segments share their end points. The
+// segments are subdivided until no input point is further than tolerance from
+// the curve.
+//
+// Points where the polyline turns by more than cornerAngle (in radians) are
+// treated as corners: the curve is split there and the tangents on either side
+// are left independent. Passing math.Pi or more disables corner detection.
+//
+// Consecutive duplicate points are ignored. If fewer than two distinct points
+// are given, nil is returned.
+func FitCubicBezier3D(points []Vec3, tolerance, cornerAngle float32) [][4]Vec3 {
+	pts := make([]Vec3, 0, len(points))
+	for _, p := range points {
+		if len(pts) == 0 || !p.ApproxEqual(pts[len(pts)-1]) {
+			pts = append(pts, p)
+		}
+	}
+	if len(pts) < 2 {
+		return nil
+	}
+
+	cosCorner := float32(math.Cos(float64(cornerAngle)))
+	var curves [][4]Vec3
+	start := 0
+	for i := 1; i < len(pts); i++ {
+		if i != len(pts)-1 && cornerAngle < math.Pi {
+			in, out := pts[i].Sub(pts[i-1]).Normalize(), pts[i+1].Sub(pts[i]).Normalize()
+			if in.Dot(out) >= cosCorner {
+				continue
+			}
+		} else if i != len(pts)-1 {
+			continue
+		}
+
+		piece := pts[start : i+1]
+		tHat1 := piece[1].Sub(piece[0]).Normalize()
+		tHat2 := piece[len(piece)-2].Sub(piece[len(piece)-1]).Normalize()
+		curves = fitCubic(curves, piece, tHat1, tHat2, tolerance*tolerance)
+		start = i
+	}
+
+	return curves
+}
+
+// FitCubicBezier2D is the same as FitCubicBezier3D, except the points and
+// resulting control points are in 2D, suitable for CubicBezierCurve2D.
+func FitCubicBezier2D(points []Vec2, tolerance, cornerAngle float32) [][4]Vec2 {
+	pts := make([]Vec3, len(points))
+	for i, p := range points {
+		pts[i] = p.Vec3(0)
+	}
+
+	curves3 := FitCubicBezier3D(pts, tolerance, cornerAngle)
+	if curves3 == nil {
+		return nil
+	}
+
+	curves := make([][4]Vec2, len(curves3))
+	for i, c := range curves3 {
+		curves[i] = [4]Vec2{c[0].Vec2(), c[1].Vec2(), c[2].Vec2(), c[3].Vec2()}
+	}
+
+	return curves
+}
+
+// fitCubic fits a bezier curve to pts (with the given end tangents) and
+// appends it to curves, recursively splitting it if the squared error is
+// bigger than errSqr.
+func fitCubic(curves [][4]Vec3, pts []Vec3, tHat1, tHat2 Vec3, errSqr float32) [][4]Vec3 {
+	const maxIterations = 20
+
+	if len(pts) == 2 {
+		dist := pts[1].Sub(pts[0]).Len() / 3
+		return append(curves, [4]Vec3{pts[0], pts[0].Add(tHat1.Mul(dist)), pts[1].Add(tHat2.Mul(dist)), pts[1]})
+	}
+
+	u := chordLengthParameterize(pts)
+	curve := generateBezier(pts, u, tHat1, tHat2)
+	maxErr, split := maxBezierError(pts, curve, u)
+	if maxErr < errSqr {
+		return append(curves, curve)
+	}
+
+	// If the error isn't too large, try to improve the parameterization
+	// before giving up and splitting the curve.
+	if maxErr < errSqr*4 {
+		for i := 0; i < maxIterations; i++ {
+			u = reparameterize(pts, u, curve)
+			curve = generateBezier(pts, u, tHat1, tHat2)
+			maxErr, split = maxBezierError(pts, curve, u)
+			if maxErr < errSqr {
+				return append(curves, curve)
+			}
+		}
+	}
+
+	tHatCenter := pts[split-1].Sub(pts[split+1]).Normalize()
+	curves = fitCubic(curves, pts[:split+1], tHat1, tHatCenter, errSqr)
+	return fitCubic(curves, pts[split:], tHatCenter.Mul(-1), tHat2, errSqr)
+}
+
+// generateBezier finds the least-squares cubic bezier for the given points,
+// parameters, and end tangents.
+func generateBezier(pts []Vec3, u []float32, tHat1, tHat2 Vec3) [4]Vec3 {
+	first, last := pts[0], pts[len(pts)-1]
+
+	var c Mat2
+	var x Vec2
+	for i, p := range pts {
+		b0, b1, b2, b3 := bernstein(u[i])
+		a1, a2 := tHat1.Mul(b1), tHat2.Mul(b2)
+
+		c[0] += a1.Dot(a1)
+		c[1] += a1.Dot(a2)
+		c[3] += a2.Dot(a2)
+
+		tmp := p.Sub(first.Mul(b0 + b1)).Sub(last.Mul(b2 + b3))
+		x[0] += a1.Dot(tmp)
+		x[1] += a2.Dot(tmp)
+	}
+	c[2] = c[1]
+
+	var alpha1, alpha2 float32
+	if det := c.Det(); det != 0 {
+		alpha1 = (x[0]*c[3] - x[1]*c[2]) / det
+		alpha2 = (c[0]*x[1] - c[1]*x[0]) / det
+	}
+
+	// If alpha is negative or tiny, the least squares fit is degenerate, so fall
+	// back on the Wu/Barsky heuristic of using a third of the chord length.
+	segLength := last.Sub(first).Len()
+	epsilon := 1e-6 * segLength
+	if alpha1 < epsilon || alpha2 < epsilon {
+		alpha1, alpha2 = segLength/3, segLength/3
+	}
+
+	return [4]Vec3{first, first.Add(tHat1.Mul(alpha1)), last.Add(tHat2.Mul(alpha2)), last}
+}
+
+// reparameterize improves the parameter of every point with a Newton-Raphson
+// step towards the closest point on the curve.
+func reparameterize(pts []Vec3, u []float32, curve [4]Vec3) []float32 {
+	d1 := [3]Vec3{curve[1].Sub(curve[0]).Mul(3), curve[2].Sub(curve[1]).Mul(3), curve[3].Sub(curve[2]).Mul(3)}
+	d2 := [2]Vec3{d1[1].Sub(d1[0]).Mul(2), d1[2].Sub(d1[1]).Mul(2)}
+
+	newU := make([]float32, len(u))
+	for i, p := range pts {
+		t := u[i]
+		diff := CubicBezierCurve3D(t, curve[0], curve[1], curve[2], curve[3]).Sub(p)
+		q1 := QuadraticBezierCurve3D(t, d1[0], d1[1], d1[2])
+		q2 := d2[0].Mul(1 - t).Add(d2[1].Mul(t))
+
+		newU[i] = t
+		if denom := q1.Dot(q1) + diff.Dot(q2); denom != 0 {
+			newU[i] = Clamp(t-diff.Dot(q1)/denom, 0, 1)
+		}
+	}
+
+	return newU
+}
+
+// maxBezierError returns the maximum squared distance between the points and
+// their parameterized location on the curve, and the index of the point where
+// it occurs. The end points are never chosen for the split.
+func maxBezierError(pts []Vec3, curve [4]Vec3, u []float32) (maxErr float32, split int) {
+	split = len(pts) / 2
+	for i := 1; i < len(pts)-1; i++ {
+		dist := CubicBezierCurve3D(u[i], curve[0], curve[1], curve[2], curve[3]).Sub(pts[i]).LenSqr()
+		if dist >= maxErr {
+			maxErr, split = dist, i
+		}
+	}
+
+	return maxErr, split
+}
+
+func chordLengthParameterize(pts []Vec3) []float32 {
+	u := make([]float32, len(pts))
+	for i := 1; i < len(pts); i++ {
+		u[i] = u[i-1] + pts[i].Sub(pts[i-1]).Len()
+	}
+	for i := range u {
+		u[i] /= u[len(u)-1]
+	}
+
+	return u
+}
+
+func bernstein(t float32) (b0, b1, b2, b3 float32) {
+	mt := 1 - t
+	return mt * mt * mt, 3 * t * mt * mt, 3 * t * t * mt, t * t * t
+}
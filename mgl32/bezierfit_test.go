@@ -0,0 +1,93 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// distanceToCurves approximates the distance between p and the closest of the
+// given curves by dense sampling.
+func distanceToCurves(p Vec2, curves [][4]Vec2) float32 {
+	best := InfPos
+	for _, c := range curves {
+		for i := 0; i <= 200; i++ {
+			d := CubicBezierCurve2D(float32(i)/200, c[0], c[1], c[2], c[3]).Sub(p).Len()
+			SetMin(&best, &d)
+		}
+	}
+	return best
+}
+
+func TestFitCubicBezierExactCurve(t *testing.T) {
+	t.Parallel()
+
+	c := [4]Vec2{{0, 0}, {1, 2}, {3, 2}, {4, 0}}
+	points := MakeBezierCurve2D(30, c[:])
+
+	curves := FitCubicBezier2D(points, 0.1, math.Pi)
+	if len(curves) != 1 {
+		t.Fatalf("Fitting points sampled from a single cubic gave %d segments", len(curves))
+	}
+	if curves[0][0] != c[0] || curves[0][3] != c[3] {
+		t.Errorf("Fitted curve %v doesn't interpolate the end points of %v", curves[0], c)
+	}
+	for _, p := range points {
+		if d := distanceToCurves(p, curves); d > 0.1 {
+			t.Errorf("Point %v is %v away from the fitted curve", p, d)
+		}
+	}
+}
+
+func TestFitCubicBezierTolerance(t *testing.T) {
+	t.Parallel()
+
+	var points []Vec2
+	for i := 0; i <= 100; i++ {
+		x := float32(i) / 10
+		points = append(points, Vec2{x, float32(math.Sin(float64(x)))})
+	}
+
+	tolerance := float32(0.01)
+	curves := FitCubicBezier2D(points, tolerance, math.Pi)
+	if len(curves) < 2 {
+		t.Errorf("Expected a sine wave to need several segments, got %d", len(curves))
+	}
+	for i := 1; i < len(curves); i++ {
+		if curves[i][0] != curves[i-1][3] {
+			t.Errorf("Segment %d doesn't start where segment %d ends", i, i-1)
+		}
+	}
+	for _, p := range points {
+		if d := distanceToCurves(p, curves); d > tolerance*1.1 {
+			t.Errorf("Point %v is %v away from the fitted curve, tolerance is %v", p, d, tolerance)
+		}
+	}
+}
+
+func TestFitCubicBezierCorners(t *testing.T) {
+	t.Parallel()
+
+	// An L shape should be split at its corner.
+	var points []Vec3
+	for i := 0; i <= 10; i++ {
+		points = append(points, Vec3{float32(i), 0, 0})
+	}
+	for i := 1; i <= 10; i++ {
+		points = append(points, Vec3{10, float32(i), 0})
+	}
+
+	curves := FitCubicBezier3D(points, 0.01, DegToRad(45))
+	if len(curves) != 2 {
+		t.Fatalf("Expected the L shape to be fit by 2 curves, got %d", len(curves))
+	}
+	if !curves[0][3].ApproxEqual(Vec3{10, 0, 0}) {
+		t.Errorf("Expected the curves to split at the corner, got %v", curves)
+	}
+	if FitCubicBezier3D([]Vec3{{1, 1, 1}, {1, 1, 1}}, 1, math.Pi) != nil {
+		t.Errorf("Expected nil result when fitting a single distinct point")
+	}
+}
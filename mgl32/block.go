@@ -0,0 +1,53 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Mat4FromBlocks returns the affine transform [[r, t], [0, 1]], which applies
+// the linear map r and then translates by t.
+func Mat4FromBlocks(r Mat3, t Vec3) Mat4 {
+	return Mat4{
+		r[0], r[1], r[2], 0,
+		r[3], r[4], r[5], 0,
+		r[6], r[7], r[8], 0,
+		t[0], t[1], t[2], 1,
+	}
+}
+
+// SetBlock copies sub into mat with its top left element at row i and column
+// j, and returns mat. The other elements of mat are left unchanged.
+//
+// If mat or sub is nil, or sub doesn't fit inside mat at (i, j), this is a
+// no-op and returns nil.
+func (mat *MatMxN) SetBlock(i, j int, sub *MatMxN) *MatMxN {
+	if mat == nil || sub == nil || i < 0 || j < 0 || i+sub.m > mat.m || j+sub.n > mat.n {
+		return nil
+	}
+
+	for col := 0; col < sub.n; col++ {
+		start := (j+col)*mat.m + i
+		copy(mat.dat[start:start+sub.m], sub.dat[col*sub.m:(col+1)*sub.m])
+	}
+
+	return mat
+}
+
+// GetBlock stores in dst the rows x cols block of mat whose top left element
+// is at row i and column j, reshaping dst as necessary, and returns dst.
+//
+// If mat is nil, dst is mat, or the block doesn't fit inside mat, this
+// returns nil.
+func (mat *MatMxN) GetBlock(dst *MatMxN, i, j, rows, cols int) *MatMxN {
+	if mat == nil || dst == mat || i < 0 || j < 0 || rows < 0 || cols < 0 || i+rows > mat.m || j+cols > mat.n {
+		return nil
+	}
+
+	dst = dst.Reshape(rows, cols)
+	for col := 0; col < cols; col++ {
+		start := (j+col)*mat.m + i
+		copy(dst.dat[col*rows:(col+1)*rows], mat.dat[start:start+rows])
+	}
+
+	return dst
+}
@@ -0,0 +1,71 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestMat4FromBlocks(t *testing.T) {
+	t.Parallel()
+
+	r := Rotate3DZ(0.3).Mul3(Diag3(Vec3{2, 1, 3}))
+	tr := Vec3{1, -2, 5}
+	want := Translate3D(tr[0], tr[1], tr[2]).Mul4(r.Mat4())
+	if got := Mat4FromBlocks(r, tr); !got.ApproxEqualThreshold(want, 1e-6) {
+		t.Errorf("Mat4FromBlocks(%v, %v) = %v, want %v", r, tr, got, want)
+	}
+}
+
+func TestMxNBlocks(t *testing.T) {
+	t.Parallel()
+
+	// A 5x4 matrix whose element (i, j) is 10*i + j.
+	mat := NewMatrix(5, 4)
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 4; j++ {
+			mat.Set(i, j, float32(10*i+j))
+		}
+	}
+
+	block := mat.GetBlock(nil, 1, 2, 3, 2)
+	want := NewMatrixFromData([]float32{12, 22, 32, 13, 23, 33}, 3, 2)
+	if !block.ApproxEqual(want) {
+		t.Errorf("GetBlock(1, 2, 3, 2) = %v, want %v", block.Raw(), want.Raw())
+	}
+
+	sub := NewMatrixFromData([]float32{-1, -2, -3, -4}, 2, 2)
+	if mat.SetBlock(3, 1, sub) != mat {
+		t.Fatalf("SetBlock(3, 1) didn't return the receiver")
+	}
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 4; j++ {
+			want := float32(10*i + j)
+			if i >= 3 && j >= 1 && j < 3 {
+				want = sub.At(i-3, j-1)
+			}
+			if got := mat.At(i, j); got != want {
+				t.Errorf("after SetBlock, At(%d, %d) = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+
+	// Stacking blocks round trips through GetBlock.
+	stacked := NewMatrix(4, 4)
+	stacked.SetBlock(0, 0, sub).SetBlock(2, 2, sub).SetBlock(0, 2, block.GetBlock(nil, 0, 0, 2, 2))
+	if got := stacked.GetBlock(nil, 2, 2, 2, 2); !got.ApproxEqual(sub) {
+		t.Errorf("stacked GetBlock = %v, want %v", got.Raw(), sub.Raw())
+	}
+
+	if mat.SetBlock(4, 0, sub) != nil {
+		t.Errorf("SetBlock past the last row didn't return nil")
+	}
+	if mat.GetBlock(nil, 0, 3, 1, 2) != nil {
+		t.Errorf("GetBlock past the last column didn't return nil")
+	}
+	if mat.GetBlock(mat, 0, 0, 1, 1) != nil {
+		t.Errorf("GetBlock into the receiver didn't return nil")
+	}
+}
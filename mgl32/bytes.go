@@ -0,0 +1,51 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// The PutBytes methods and FromBytes functions of the vectors and matrices
+// store each element as its IEEE 754 bits, 4 bytes in mgl32 and 8 in mgl64, in
+// the order of the elements in memory (column major for matrices) and the
+// given byte order. They are the explicit equivalent of casting the types to
+// bytes with unsafe, which ties files and network messages to the byte order
+// of the machine, and much faster than binary.Write and binary.Read, which go
+// through reflection.
+
+// scalarSize is the number of bytes each element is stored in.
+var scalarSize = scalarBits / 8
+
+// putScalars stores the elements of s in b, which must hold all of them.
+func putScalars(b []byte, order binary.ByteOrder, s []float32) {
+	if len(b) < len(s)*scalarSize {
+		panic("Byte slice too short for the elements")
+	}
+
+	for i, v := range s {
+		if scalarSize == 4 {
+			order.PutUint32(b[4*i:], uint32(packFloat(float64(v), 8, 23)))
+		} else {
+			order.PutUint64(b[8*i:], math.Float64bits(float64(v)))
+		}
+	}
+}
+
+// getScalars reads the elements of s from b, which must hold all of them.
+func getScalars(s []float32, b []byte, order binary.ByteOrder) {
+	if len(b) < len(s)*scalarSize {
+		panic("Byte slice too short for the elements")
+	}
+
+	for i := range s {
+		if scalarSize == 4 {
+			s[i] = float32(math.Float32frombits(order.Uint32(b[4*i:])))
+		} else {
+			s[i] = float32(math.Float64frombits(order.Uint64(b[8*i:])))
+		}
+	}
+}
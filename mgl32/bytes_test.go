@@ -0,0 +1,79 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestVec3Bytes(t *testing.T) {
+	t.Parallel()
+
+	v := Vec3{1, -2.5, float32(math.SmallestNonzeroFloat32)}
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		var want bytes.Buffer
+		binary.Write(&want, order, v)
+
+		b := make([]byte, want.Len()+1)
+		v.PutBytes(b, order)
+		if !bytes.Equal(b[:want.Len()], want.Bytes()) {
+			t.Errorf("%v: PutBytes(%v) = %v, want %v as from binary.Write", order, v, b, want.Bytes())
+		}
+		if got := Vec3FromBytes(b, order); got != v {
+			t.Errorf("%v: Vec3FromBytes = %v, want %v", order, got, v)
+		}
+	}
+
+	b := make([]byte, 3*scalarSize)
+	Vec3{NaN, InfPos, InfNeg}.PutBytes(b, binary.LittleEndian)
+	if got := Vec3FromBytes(b, binary.LittleEndian); got[0] == got[0] || got[1] != InfPos || got[2] != InfNeg {
+		t.Errorf("Vec3FromBytes of NaN and infinities = %v", got)
+	}
+}
+
+func TestMat4Bytes(t *testing.T) {
+	t.Parallel()
+
+	m := Translate3D(1, 2, 3).Mul4(HomogRotate3DY(0.5))
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		var want bytes.Buffer
+		binary.Write(&want, order, m)
+
+		b := make([]byte, want.Len())
+		m.PutBytes(b, order)
+		if !bytes.Equal(b, want.Bytes()) {
+			t.Errorf("%v: PutBytes not the same as binary.Write", order)
+		}
+		if got := Mat4FromBytes(b, order); got != m {
+			t.Errorf("%v: Mat4FromBytes = %v, want %v", order, got, m)
+		}
+	}
+}
+
+func TestBytesTooShort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Description string
+		F           func()
+	}{
+		{"PutBytes", func() { Vec4{}.PutBytes(make([]byte, 4*scalarSize-1), binary.LittleEndian) }},
+		{"FromBytes", func() { Mat2FromBytes(make([]byte, 3), binary.BigEndian) }},
+	}
+
+	for _, c := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s didn't panic", c.Description)
+				}
+			}()
+			c.F()
+		}()
+	}
+}
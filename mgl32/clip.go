@@ -0,0 +1,168 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"errors"
+)
+
+// ClipConventions describes the clip space, view space and window coordinate
+// conventions of a graphics API. The projection functions of this package
+// (Perspective, Ortho, Frustum, Project, UnProject, and LookAt for the view
+// matrix) follow OpenGL's, which is the zero value; the methods of
+// ClipConventions are equivalents for other APIs, so that the same code can
+// target any of them by picking the conventions once.
+type ClipConventions struct {
+	// ZeroToOneDepth is whether NDC depth ranges over [0, 1] rather than [-1, 1].
+	ZeroToOneDepth bool
+	// YDown is whether NDC +y points down the screen rather than up.
+	YDown bool
+	// LeftHanded is whether the camera looks down +z in view space rather than
+	// -z. It is the handedness toggle of c.LookAtV, and the projections of c
+	// expect view space of the same handedness, so the two must be used
+	// together. It's independent of the other conventions: Vulkan, with
+	// right-handed view space, and D3D, usually left-handed, have the same
+	// depth range.
+	LeftHanded bool
+	// WindowYDown is whether window coordinates have their origin at the
+	// top-left of the viewport with y growing down, rather than at the bottom-left.
+	WindowYDown bool
+}
+
+// The conventions of common graphics APIs. D3D and Metal don't require a
+// handedness for view space, LeftHanded reflects their usual samples and tooling.
+var (
+	GLConventions     = ClipConventions{}
+	VulkanConventions = ClipConventions{ZeroToOneDepth: true, YDown: true, WindowYDown: true}
+	D3DConventions    = ClipConventions{ZeroToOneDepth: true, LeftHanded: true, WindowYDown: true}
+	MetalConventions  = ClipConventions{ZeroToOneDepth: true, LeftHanded: true, WindowYDown: true}
+)
+
+// Adapt converts the OpenGL projection matrix proj, such as one built by
+// PerspectiveFromIntrinsics, to the conventions c.
+func (c ClipConventions) Adapt(proj Mat4) Mat4 {
+	if c.LeftHanded {
+		// Negate view z on the way in.
+		proj[8], proj[9], proj[10], proj[11] = -proj[8], -proj[9], -proj[10], -proj[11]
+	}
+	if c.YDown {
+		proj[1], proj[5], proj[9], proj[13] = -proj[1], -proj[5], -proj[9], -proj[13]
+	}
+	if c.ZeroToOneDepth {
+		// z' = (z + w) / 2
+		for col := 0; col < 4; col++ {
+			proj[col*4+2] = (proj[col*4+2] + proj[col*4+3]) / 2
+		}
+	}
+	return proj
+}
+
+// Perspective is the equivalent of Perspective for the conventions c.
+func (c ClipConventions) Perspective(fovy, aspect, near, far float32) Mat4 {
+	return c.Adapt(Perspective(fovy, aspect, near, far))
+}
+
+// PerspectiveInfinite is the equivalent of PerspectiveInfinite for the
+// conventions c.
+func (c ClipConventions) PerspectiveInfinite(fovy, aspect, near float32) Mat4 {
+	return c.Adapt(PerspectiveInfinite(fovy, aspect, near))
+}
+
+// PerspectiveReversedZ is the equivalent of PerspectiveReversedZ for the
+// conventions c. With ZeroToOneDepth, the near plane maps to a depth of 1 and
+// the far plane to 0.
+func (c ClipConventions) PerspectiveReversedZ(fovy, aspect, near, far float32) Mat4 {
+	return c.Adapt(PerspectiveReversedZ(fovy, aspect, near, far))
+}
+
+// PerspectiveReversedZInfinite is the equivalent of
+// PerspectiveReversedZInfinite for the conventions c. With ZeroToOneDepth, the
+// depth of a point at a distance d is near/d.
+func (c ClipConventions) PerspectiveReversedZInfinite(fovy, aspect, near float32) Mat4 {
+	return c.Adapt(PerspectiveReversedZInfinite(fovy, aspect, near))
+}
+
+// Frustum is the equivalent of Frustum for the conventions c.
+func (c ClipConventions) Frustum(left, right, bottom, top, near, far float32) Mat4 {
+	return c.Adapt(Frustum(left, right, bottom, top, near, far))
+}
+
+// Ortho is the equivalent of Ortho for the conventions c. As for Ortho, near and
+// far are distances along the view direction.
+func (c ClipConventions) Ortho(left, right, bottom, top, near, far float32) Mat4 {
+	return c.Adapt(Ortho(left, right, bottom, top, near, far))
+}
+
+// Ortho2D is the equivalent of Ortho2D for the conventions c.
+func (c ClipConventions) Ortho2D(left, right, bottom, top float32) Mat4 {
+	return c.Adapt(Ortho2D(left, right, bottom, top))
+}
+
+// LookAt is the equivalent of LookAt for the conventions c, see LookAtV.
+func (c ClipConventions) LookAt(eyeX, eyeY, eyeZ, centerX, centerY, centerZ, upX, upY, upZ float32) Mat4 {
+	return c.LookAtV(Vec3{eyeX, eyeY, eyeZ}, Vec3{centerX, centerY, centerZ}, Vec3{upX, upY, upZ})
+}
+
+// LookAtV is the equivalent of LookAtV for the conventions c: for left-handed
+// conventions, center lies on the +z axis of the view space.
+func (c ClipConventions) LookAtV(eye, center, up Vec3) Mat4 {
+	view := LookAtV(eye, center, up)
+	if c.LeftHanded {
+		view[2], view[6], view[10], view[14] = -view[2], -view[6], -view[10], -view[14]
+	}
+	return view
+}
+
+// Project is the equivalent of Project for the conventions c, where projection
+// follows c (e.g. is built by c.Perspective). The depth of win is always in [0, 1]
+// and y follows c.WindowYDown.
+func (c ClipConventions) Project(obj Vec3, modelview, projection Mat4, initialX, initialY, width, height int) (win Vec3) {
+	vpp := projection.Mul4(modelview).Mul4x1(obj.Vec4(1))
+	vpp = vpp.Mul(1 / vpp.W())
+
+	up := vpp[1]
+	if c.YDown {
+		up = -up
+	}
+	if c.WindowYDown {
+		up = -up
+	}
+	win[0] = float32(initialX) + (float32(width)*(vpp[0]+1))/2
+	win[1] = float32(initialY) + (float32(height)*(up+1))/2
+	win[2] = vpp[2]
+	if !c.ZeroToOneDepth {
+		win[2] = (vpp[2] + 1) / 2
+	}
+
+	return win
+}
+
+// UnProject is the equivalent of UnProject for the conventions c, the inverse of
+// c.Project.
+func (c ClipConventions) UnProject(win Vec3, modelview, projection Mat4, initialX, initialY, width, height int) (obj Vec3, err error) {
+	inv, err := projection.Mul4(modelview).TryInv()
+	if err != nil {
+		return Vec3{}, errors.New("Could not find matrix inverse (projection times modelview is probably non-singular)")
+	}
+
+	ndc := Vec4{
+		(2 * (win[0] - float32(initialX)) / float32(width)) - 1,
+		(2 * (win[1] - float32(initialY)) / float32(height)) - 1,
+		win[2],
+		1.0,
+	}
+	if c.YDown {
+		ndc[1] = -ndc[1]
+	}
+	if c.WindowYDown {
+		ndc[1] = -ndc[1]
+	}
+	if !c.ZeroToOneDepth {
+		ndc[2] = 2*win[2] - 1
+	}
+
+	obj4 := inv.Mul4x1(ndc)
+	return obj4.Vec3().Mul(1 / obj4[3]), nil
+}
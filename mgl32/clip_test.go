@@ -0,0 +1,161 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestGLConventions(t *testing.T) {
+	t.Parallel()
+
+	c := GLConventions
+	if m, want := c.Perspective(1, 1.5, 0.1, 100), Perspective(1, 1.5, 0.1, 100); m != want {
+		t.Errorf("GLConventions.Perspective = %v, want %v", m, want)
+	}
+	if m, want := c.Ortho(-1, 2, -3, 4, 0.5, 10), Ortho(-1, 2, -3, 4, 0.5, 10); m != want {
+		t.Errorf("GLConventions.Ortho = %v, want %v", m, want)
+	}
+	if m, want := c.Frustum(-1, 2, -3, 4, 0.5, 10), Frustum(-1, 2, -3, 4, 0.5, 10); m != want {
+		t.Errorf("GLConventions.Frustum = %v, want %v", m, want)
+	}
+	if m, want := c.Ortho2D(-1, 2, -3, 4), Ortho2D(-1, 2, -3, 4); m != want {
+		t.Errorf("GLConventions.Ortho2D = %v, want %v", m, want)
+	}
+	eye, center, up := Vec3{1, 2, 3}, Vec3{0, 0, 0}, Vec3{0, 1, 0}
+	if m, want := c.LookAtV(eye, center, up), LookAtV(eye, center, up); m != want {
+		t.Errorf("GLConventions.LookAtV = %v, want %v", m, want)
+	}
+	if m, want := c.LookAt(1, 2, 3, 0, 0, 0, 0, 1, 0), LookAt(1, 2, 3, 0, 0, 0, 0, 1, 0); m != want {
+		t.Errorf("GLConventions.LookAt = %v, want %v", m, want)
+	}
+
+	obj, mv, proj := Vec3{0.5, -0.2, -3}, Ident4(), Perspective(1, 1.5, 0.1, 100)
+	if win, want := c.Project(obj, mv, proj, 10, 20, 800, 600), Project(obj, mv, proj, 10, 20, 800, 600); win != want {
+		t.Errorf("GLConventions.Project = %v, want %v", win, want)
+	}
+}
+
+func TestClipConventions(t *testing.T) {
+	t.Parallel()
+
+	const near, far = 0.5, 50
+	presets := []struct {
+		name string
+		c    ClipConventions
+	}{
+		{"GL", GLConventions},
+		{"Vulkan", VulkanConventions},
+		{"D3D", D3DConventions},
+		{"Metal", MetalConventions},
+	}
+
+	approx := func(a, b float32) bool { return Abs(a-b) < 1e-4 }
+	for _, p := range presets {
+		c := p.c
+		// A camera at the origin looking at -x, with +y up.
+		view := c.LookAtV(Vec3{}, Vec3{-1, 0, 0}, Vec3{0, 1, 0})
+		mvp := c.Perspective(1, 1, near, far).Mul4(view)
+
+		ndc := func(v Vec3) Vec3 {
+			clip := mvp.Mul4x1(v.Vec4(1))
+			return clip.Vec3().Mul(1 / clip[3])
+		}
+
+		minDepth := float32(-1)
+		if c.ZeroToOneDepth {
+			minDepth = 0
+		}
+		if d := ndc(Vec3{-near, 0, 0})[2]; !approx(d, minDepth) {
+			t.Errorf("%s: NDC depth of the near plane = %v, want %v", p.name, d, minDepth)
+		}
+		if d := ndc(Vec3{-far, 0, 0})[2]; !approx(d, 1) {
+			t.Errorf("%s: NDC depth of the far plane = %v, want 1", p.name, d)
+		}
+		if y := ndc(Vec3{-2, 1, 0})[1]; (y < 0) != c.YDown {
+			t.Errorf("%s: NDC y of a point above the view direction = %v", p.name, y)
+		}
+
+		// In window coordinates, up is up or down according to WindowYDown only.
+		win := c.Project(Vec3{-2, 1, 0.5}, view, c.Perspective(1, 1, near, far), 0, 0, 400, 300)
+		if (win[1] < 150) != c.WindowYDown {
+			t.Errorf("%s: window y of a point above the view direction = %v", p.name, win[1])
+		}
+		if win[2] < 0 || win[2] > 1 {
+			t.Errorf("%s: window depth %v out of [0, 1]", p.name, win[2])
+		}
+
+		obj, err := c.UnProject(win, view, c.Perspective(1, 1, near, far), 0, 0, 400, 300)
+		if err != nil {
+			t.Fatalf("%s: UnProject returned error %v", p.name, err)
+		}
+		if !obj.ApproxFuncEqual(Vec3{-2, 1, 0.5}, approx) {
+			t.Errorf("%s: UnProject(Project(v)) = %v, want %v", p.name, obj, Vec3{-2, 1, 0.5})
+		}
+	}
+
+	// Points in front of a left-handed camera have positive view z.
+	view := D3DConventions.LookAtV(Vec3{}, Vec3{0, 0, -5}, Vec3{0, 1, 0})
+	if z := view.Mul4x1(Vec4{0, 0, -5, 1})[2]; !approx(z, 5) {
+		t.Errorf("Left-handed view z of the target = %v, want 5", z)
+	}
+	if d := D3DConventions.Ortho(-1, 1, -1, 1, 1, 3).Mul4x1(Vec4{0, 0, 3, 1})[2]; !approx(d, 1) {
+		t.Errorf("Left-handed Ortho depth of the far plane = %v, want 1", d)
+	}
+
+	// Vulkan NDC y points down, so the top of an Ortho2D maps to -1.
+	if y := VulkanConventions.Ortho2D(0, 800, 0, 600).Mul4x1(Vec4{0, 600, 0, 1})[1]; !approx(y, -1) {
+		t.Errorf("Vulkan Ortho2D NDC y of the top = %v, want -1", y)
+	}
+	lh := D3DConventions.LookAt(0, 0, 0, 0, 0, -5, 0, 1, 0)
+	if want := D3DConventions.LookAtV(Vec3{}, Vec3{0, 0, -5}, Vec3{0, 1, 0}); lh != want {
+		t.Errorf("D3DConventions.LookAt = %v, want %v", lh, want)
+	}
+}
+
+func TestReversedAndInfinitePerspective(t *testing.T) {
+	t.Parallel()
+
+	const fovy, aspect, near, far = 1, 1.5, 0.5, 50
+	approx := absEqual(1e-5)
+	depth := func(proj Mat4, dist float32) float32 {
+		clip := proj.Mul4x1(Vec4{0, 0, -dist, 1})
+		return clip[2] / clip[3]
+	}
+
+	tests := []struct {
+		Description   string
+		Proj          Mat4
+		Near, Far     float32
+		FarDist       float32
+		SameXYAsPersp bool
+	}{
+		{"Reversed", PerspectiveReversedZ(fovy, aspect, near, far), 1, -1, far, true},
+		{"Reversed [0, 1]", VulkanConventions.PerspectiveReversedZ(fovy, aspect, near, far), 1, 0, far, false},
+		{"Infinite", PerspectiveInfinite(fovy, aspect, near), -1, 1, 1e7, true},
+		{"Infinite [0, 1]", VulkanConventions.PerspectiveInfinite(fovy, aspect, near), 0, 1, 1e7, false},
+		{"Reversed infinite", PerspectiveReversedZInfinite(fovy, aspect, near), 1, -1, 1e7, true},
+		{"Reversed infinite [0, 1]", VulkanConventions.PerspectiveReversedZInfinite(fovy, aspect, near), 1, 0, 1e7, false},
+	}
+
+	persp := Perspective(fovy, aspect, near, far)
+	for _, c := range tests {
+		if d := depth(c.Proj, near); !approx(d, c.Near) {
+			t.Errorf("%s: depth of the near plane = %v, want %v", c.Description, d, c.Near)
+		}
+		if d := depth(c.Proj, c.FarDist); !approx(d, c.Far) {
+			t.Errorf("%s: depth far away = %v, want %v", c.Description, d, c.Far)
+		}
+		if c.SameXYAsPersp && (c.Proj.Row(0) != persp.Row(0) || c.Proj.Row(1) != persp.Row(1) || c.Proj.Row(3) != persp.Row(3)) {
+			t.Errorf("%s: x, y or w rows differ from Perspective: %v", c.Description, c.Proj)
+		}
+	}
+
+	// Reversed [0, 1] infinite depth is near/distance.
+	proj := VulkanConventions.PerspectiveReversedZInfinite(fovy, aspect, near)
+	if d := depth(proj, 20); !approx(d, near/20) {
+		t.Errorf("reversed infinite depth at 20 = %v, want %v", d, near/20)
+	}
+}
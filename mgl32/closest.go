@@ -0,0 +1,139 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// ClosestPointSegment returns the point on the segment [a,b] closest to p,
+// along with its parameter t, such that the point is a + t*(b-a).
+func ClosestPointSegment(p, a, b Vec3) (c Vec3, t float32) {
+	ab := b.Sub(a)
+	denom := ab.LenSqr()
+	if denom == 0 {
+		return a, 0
+	}
+
+	t = Clamp(p.Sub(a).Dot(ab)/denom, 0, 1)
+	return a.Add(ab.Mul(t)), t
+}
+
+// ClosestPointsSegmentSegment computes the closest points c1 on the segment
+// [p1,q1] and c2 on the segment [p2,q2], returning them along with their
+// squared distance.
+//
+// Parallel segments (where the closest points aren't unique) and degenerate
+// segments (where an end point is repeated) are handled; for parallel
+// segments some valid pair of closest points is returned.
+//
+// This follows Christer Ericson's Real-Time Collision Detection, section 5.1.9.
+func ClosestPointsSegmentSegment(p1, q1, p2, q2 Vec3) (c1, c2 Vec3, distSqr float32) {
+	d1, d2 := q1.Sub(p1), q2.Sub(p2)
+	r := p1.Sub(p2)
+	a, e, f := d1.LenSqr(), d2.LenSqr(), d2.Dot(r)
+
+	var s, t float32
+	switch {
+	case a <= Epsilon && e <= Epsilon:
+		// Both segments are points
+		return p1, p2, p1.Sub(p2).LenSqr()
+	case a <= Epsilon:
+		t = Clamp(f/e, 0, 1)
+	default:
+		c := d1.Dot(r)
+		if e <= Epsilon {
+			s = Clamp(-c/a, 0, 1)
+			break
+		}
+
+		b := d1.Dot(d2)
+		if denom := a*e - b*b; denom != 0 {
+			s = Clamp((b*f-c*e)/denom, 0, 1)
+		} // Else the segments are parallel, any s will do so keep s = 0
+
+		t = (b*s + f) / e
+		if t < 0 {
+			t, s = 0, Clamp(-c/a, 0, 1)
+		} else if t > 1 {
+			t, s = 1, Clamp((b-c)/a, 0, 1)
+		}
+	}
+
+	c1, c2 = p1.Add(d1.Mul(s)), p2.Add(d2.Mul(t))
+	return c1, c2, c1.Sub(c2).LenSqr()
+}
+
+// ClosestPointsLineLine computes the closest points c1 on the infinite line
+// through p1 with direction d1 and c2 on the one through p2 with direction d2.
+// If the lines are parallel, the closest points are not unique and ok is false.
+func ClosestPointsLineLine(p1, d1, p2, d2 Vec3) (c1, c2 Vec3, ok bool) {
+	r := p1.Sub(p2)
+	a, b, c := d1.LenSqr(), d1.Dot(d2), d1.Dot(r)
+	e, f := d2.LenSqr(), d2.Dot(r)
+
+	denom := a*e - b*b
+	if FloatEqual(denom, 0) {
+		return Vec3{}, Vec3{}, false
+	}
+
+	s := (b*f - c*e) / denom
+	t := (a*f - b*c) / denom
+
+	return p1.Add(d1.Mul(s)), p2.Add(d2.Mul(t)), true
+}
+
+// ClosestPointTriangle returns the point of the triangle (a,b,c) closest to p.
+// Degenerate triangles are handled, and reduce to closest points on their
+// edges.
+//
+// This follows Christer Ericson's Real-Time Collision Detection, section 5.1.5.
+func ClosestPointTriangle(p, a, b, c Vec3) Vec3 {
+	ab, ac, ap := b.Sub(a), c.Sub(a), p.Sub(a)
+
+	// Vertex region outside of a
+	d1, d2 := ab.Dot(ap), ac.Dot(ap)
+	if d1 <= 0 && d2 <= 0 {
+		return a
+	}
+
+	// Vertex region outside of b
+	bp := p.Sub(b)
+	d3, d4 := ab.Dot(bp), ac.Dot(bp)
+	if d3 >= 0 && d4 <= d3 {
+		return b
+	}
+
+	// Edge region of ab
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return a.Add(ab.Mul(d1 / (d1 - d3)))
+	}
+
+	// Vertex region outside of c
+	cp := p.Sub(c)
+	d5, d6 := ab.Dot(cp), ac.Dot(cp)
+	if d6 >= 0 && d5 <= d6 {
+		return c
+	}
+
+	// Edge region of ac
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return a.Add(ac.Mul(d2 / (d2 - d6)))
+	}
+
+	// Edge region of bc
+	va := d3*d6 - d5*d4
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		return b.Add(c.Sub(b).Mul((d4 - d3) / ((d4 - d3) + (d5 - d6))))
+	}
+
+	// Inside the face
+	denom := va + vb + vc
+	if denom == 0 {
+		// Degenerate triangle with p projecting inside all edge regions,
+		// which only happens when all vertices coincide.
+		return a
+	}
+	v, w := vb/denom, vc/denom
+	return a.Add(ab.Mul(v)).Add(ac.Mul(w))
+}
@@ -0,0 +1,103 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestClosestPointsSegmentSegment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Description    string
+		P1, Q1, P2, Q2 Vec3
+		C1, C2         Vec3
+		DistSqr        float32
+	}{
+		{"crossing", Vec3{-1, 0, 0}, Vec3{1, 0, 0}, Vec3{0, -1, 1}, Vec3{0, 1, 1}, Vec3{0, 0, 0}, Vec3{0, 0, 1}, 1},
+		{"end points", Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{3, 0, 0}, Vec3{3, 2, 0}, Vec3{1, 0, 0}, Vec3{3, 0, 0}, 4},
+		{"parallel", Vec3{0, 0, 0}, Vec3{2, 0, 0}, Vec3{1, 1, 0}, Vec3{3, 1, 0}, Vec3{1, 0, 0}, Vec3{1, 1, 0}, 1},
+		{"point and segment", Vec3{0, 2, 0}, Vec3{0, 2, 0}, Vec3{-1, 0, 0}, Vec3{1, 0, 0}, Vec3{0, 2, 0}, Vec3{0, 0, 0}, 4},
+		{"two points", Vec3{1, 1, 1}, Vec3{1, 1, 1}, Vec3{1, 1, 3}, Vec3{1, 1, 3}, Vec3{1, 1, 1}, Vec3{1, 1, 3}, 4},
+	}
+
+	for _, test := range tests {
+		c1, c2, d := ClosestPointsSegmentSegment(test.P1, test.Q1, test.P2, test.Q2)
+		if !FloatEqual(d, test.DistSqr) {
+			t.Errorf("%s: squared distance %v, expected %v", test.Description, d, test.DistSqr)
+		}
+		if test.Description != "parallel" && (!c1.ApproxEqual(test.C1) || !c2.ApproxEqual(test.C2)) {
+			t.Errorf("%s: closest points %v, %v, expected %v, %v", test.Description, c1, c2, test.C1, test.C2)
+		}
+	}
+}
+
+func TestClosestPointsSegmentSegmentBruteForce(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(3))
+	rnd := func() Vec3 { return Vec3{r.Float32()*4 - 2, r.Float32()*4 - 2, r.Float32()*4 - 2} }
+
+	for i := 0; i < 100; i++ {
+		p1, q1, p2, q2 := rnd(), rnd(), rnd(), rnd()
+		_, _, d := ClosestPointsSegmentSegment(p1, q1, p2, q2)
+
+		best := InfPos
+		for s := 0; s <= 100; s++ {
+			a := p1.Add(q1.Sub(p1).Mul(float32(s) / 100))
+			_, b := ClosestPointSegment(a, p2, q2)
+			dist := a.Sub(p2.Add(q2.Sub(p2).Mul(b))).LenSqr()
+			SetMin(&best, &dist)
+		}
+
+		if d > best+1e-5 || d < best-0.05 {
+			t.Errorf("Segments %v-%v and %v-%v: got squared distance %v, brute force %v", p1, q1, p2, q2, d, best)
+		}
+	}
+}
+
+func TestClosestPointsLineLine(t *testing.T) {
+	t.Parallel()
+
+	c1, c2, ok := ClosestPointsLineLine(Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{5, -3, 2}, Vec3{0, 1, 0})
+	if !ok || !c1.ApproxEqual(Vec3{5, 0, 0}) || !c2.ApproxEqual(Vec3{5, 0, 2}) {
+		t.Errorf("ClosestPointsLineLine = %v, %v, %v; expected [5 0 0], [5 0 2], true", c1, c2, ok)
+	}
+
+	if _, _, ok := ClosestPointsLineLine(Vec3{}, Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{-2, 0, 0}); ok {
+		t.Errorf("Parallel lines should not have unique closest points")
+	}
+}
+
+func TestClosestPointTriangle(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := Vec3{0, 0, 0}, Vec3{4, 0, 0}, Vec3{0, 4, 0}
+
+	tests := []struct {
+		P, Want Vec3
+	}{
+		{Vec3{1, 1, 3}, Vec3{1, 1, 0}},         // face
+		{Vec3{-1, -1, 1}, a},                   // vertex a
+		{Vec3{6, -1, 0}, b},                    // vertex b
+		{Vec3{-1, 6, 2}, c},                    // vertex c
+		{Vec3{2, -3, 0}, Vec3{2, 0, 0}},        // edge ab
+		{Vec3{-3, 2, 0}, Vec3{0, 2, 0}},        // edge ac
+		{Vec3{3, 3, -1}, Vec3{2, 2, 0}},        // edge bc
+		{Vec3{0.5, 0.5, 0}, Vec3{0.5, 0.5, 0}}, // in the triangle
+	}
+
+	for _, test := range tests {
+		if got := ClosestPointTriangle(test.P, a, b, c); !got.ApproxFuncEqual(test.Want, func(x, y float32) bool { return Abs(x-y) < 1e-5 }) {
+			t.Errorf("ClosestPointTriangle(%v) = %v, expected %v", test.P, got, test.Want)
+		}
+	}
+
+	if got := ClosestPointTriangle(Vec3{1, 1, 1}, a, a, a); got != a {
+		t.Errorf("ClosestPointTriangle on a point triangle = %v, expected %v", got, a)
+	}
+}
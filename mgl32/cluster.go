@@ -0,0 +1,134 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// ClusterSliceDepth returns the view-space depth range (as positive distances
+// from the eye) of depth slice k when the range [near,far] is split into
+// numSlices logarithmically spaced slices. That is, the near plane of slice k is
+// near*(far/near)^(k/numSlices).
+//
+// Logarithmic slicing keeps clusters roughly cube-shaped in view space, which
+// is what most clustered shading implementations use.
+func ClusterSliceDepth(k, numSlices int, near, far float32) (sliceNear, sliceFar float32) {
+	ratio := float64(far / near)
+	sliceNear = near * float32(math.Pow(ratio, float64(k)/float64(numSlices)))
+	sliceFar = near * float32(math.Pow(ratio, float64(k+1)/float64(numSlices)))
+
+	return sliceNear, sliceFar
+}
+
+// ClusterSlice returns the index of the logarithmic depth slice containing the
+// given view-space depth (a positive distance from the eye). This is the inverse
+// of ClusterSliceDepth. Depths outside of [near,far] are clamped to the first
+// or last slice.
+func ClusterSlice(depth float32, numSlices int, near, far float32) int {
+	k := int(math.Floor(math.Log(float64(depth/near)) / math.Log(float64(far/near)) * float64(numSlices)))
+	if k < 0 {
+		return 0
+	} else if k >= numSlices {
+		return numSlices - 1
+	}
+
+	return k
+}
+
+// TileAABB computes the view-space AABB of the part of the view volume that
+// projects onto the screen rectangle [x0,x1]x[y0,y1] (in normalized device
+// coordinates) and lies between the view-space depths near and far (positive
+// distances from the eye).
+//
+// The projection may be either perspective or orthographic. If it isn't
+// invertible, EmptyAABB() is returned.
+func TileAABB(projection Mat4, x0, y0, x1, y1, near, far float32) AABB {
+	inv, err := projection.TryInv()
+	if err != nil {
+		return EmptyAABB()
+	}
+
+	var corners [8]Vec3
+	for i, ndc := range [4]Vec2{{x0, y0}, {x1, y0}, {x0, y1}, {x1, y1}} {
+		// Every NDC point defines a line through the view volume, so we
+		// unproject it at both NDC depth extremes and intersect the resulting
+		// line with the planes z=-near and z=-far.
+		a := TransformCoordinate(ndc.Vec3(-1), inv)
+		b := TransformCoordinate(ndc.Vec3(1), inv)
+		dir := b.Sub(a)
+
+		corners[2*i] = a.Add(dir.Mul((-near - a[2]) / dir[2]))
+		corners[2*i+1] = a.Add(dir.Mul((-far - a[2]) / dir[2]))
+	}
+
+	return AABBFromPoints(corners[:]...)
+}
+
+// ClusterAABB returns the view-space AABB of a single cluster when the screen
+// is split into tilesX by tilesY equally sized tiles and the depth range
+// [near,far] into numSlices logarithmic slices (see ClusterSliceDepth).
+//
+// Tile (0,0) is the bottom left one, as with window coordinates in Project.
+func ClusterAABB(projection Mat4, x, y, k, tilesX, tilesY, numSlices int, near, far float32) AABB {
+	x0 := 2*float32(x)/float32(tilesX) - 1
+	x1 := 2*float32(x+1)/float32(tilesX) - 1
+	y0 := 2*float32(y)/float32(tilesY) - 1
+	y1 := 2*float32(y+1)/float32(tilesY) - 1
+	sliceNear, sliceFar := ClusterSliceDepth(k, numSlices, near, far)
+
+	return TileAABB(projection, x0, y0, x1, y1, sliceNear, sliceFar)
+}
+
+// ClusterAABBs computes the view-space AABBs of every cluster in the grid
+// described by tilesX, tilesY, and numSlices. The boxes are stored in dst, which
+// is grown if needed and returned.
+//
+// The cluster (x,y,k) is stored at index x + tilesX*(y + tilesY*k), which
+// matches the index returned by ClusterIndex.
+func ClusterAABBs(dst []AABB, projection Mat4, tilesX, tilesY, numSlices int, near, far float32) []AABB {
+	n := tilesX * tilesY * numSlices
+	if cap(dst) < n {
+		dst = make([]AABB, n)
+	}
+	dst = dst[:n]
+
+	for k := 0; k < numSlices; k++ {
+		for y := 0; y < tilesY; y++ {
+			for x := 0; x < tilesX; x++ {
+				dst[x+tilesX*(y+tilesY*k)] = ClusterAABB(projection, x, y, k, tilesX, tilesY, numSlices, near, far)
+			}
+		}
+	}
+
+	return dst
+}
+
+// ClusterIndex returns the index (as laid out by ClusterAABBs) of the cluster
+// containing the view-space point p. If p does not project inside the screen
+// (or is behind the eye), ok is false. Depths outside of [near,far] are clamped to the first or last
+// slice.
+func ClusterIndex(p Vec3, projection Mat4, tilesX, tilesY, numSlices int, near, far float32) (index int, ok bool) {
+	clip := projection.Mul4x1(p.Vec4(1))
+	if clip[3] <= 0 { // Behind the eye
+		return 0, false
+	}
+	nx, ny := clip[0]/clip[3], clip[1]/clip[3]
+	if nx < -1 || nx > 1 || ny < -1 || ny > 1 {
+		return 0, false
+	}
+
+	x := int((nx + 1) / 2 * float32(tilesX))
+	y := int((ny + 1) / 2 * float32(tilesY))
+	if x == tilesX {
+		x--
+	}
+	if y == tilesY {
+		y--
+	}
+	k := ClusterSlice(-p[2], numSlices, near, far)
+
+	return x + tilesX*(y+tilesY*k), true
+}
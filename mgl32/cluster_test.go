@@ -0,0 +1,82 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestClusterSliceDepth(t *testing.T) {
+	t.Parallel()
+
+	near, far := float32(0.1), float32(1000)
+	prev := near
+	for k := 0; k < 16; k++ {
+		sn, sf := ClusterSliceDepth(k, 16, near, far)
+		if !FloatEqualThreshold(sn, prev, 1e-4) {
+			t.Errorf("Slice %d starts at %v, previous slice ended at %v", k, sn, prev)
+		}
+		if got := ClusterSlice((sn+sf)/2, 16, near, far); got != k {
+			t.Errorf("ClusterSlice of the middle of slice %d returned %d", k, got)
+		}
+		prev = sf
+	}
+
+	if !FloatEqualThreshold(prev, far, 1e-4) {
+		t.Errorf("Last slice ends at %v, expected %v", prev, far)
+	}
+}
+
+func TestTileAABBFullScreen(t *testing.T) {
+	t.Parallel()
+
+	proj := Perspective(DegToRad(90), 1, 1, 10)
+	box := TileAABB(proj, -1, -1, 1, 1, 1, 10)
+	expected := AABB{Vec3{-10, -10, -10}, Vec3{10, 10, -1}}
+
+	if !box.Min.ApproxEqualThreshold(expected.Min, 1e-4) || !box.Max.ApproxEqualThreshold(expected.Max, 1e-4) {
+		t.Errorf("TileAABB(full screen) = %v, expected %v", box, expected)
+	}
+
+	ortho := Ortho(-2, 2, -1, 1, 0, 5)
+	box = TileAABB(ortho, 0, 0, 1, 1, 1, 3)
+	expected = AABB{Vec3{0, 0, -3}, Vec3{2, 1, -1}}
+	if !box.Min.ApproxEqualThreshold(expected.Min, 1e-4) || !box.Max.ApproxEqualThreshold(expected.Max, 1e-4) {
+		t.Errorf("TileAABB(ortho quadrant) = %v, expected %v", box, expected)
+	}
+}
+
+func TestClusterIndexContainsPoint(t *testing.T) {
+	t.Parallel()
+
+	proj := Perspective(DegToRad(60), 16.0/9.0, 0.5, 100)
+	tilesX, tilesY, slices := 16, 9, 24
+	boxes := ClusterAABBs(nil, proj, tilesX, tilesY, slices, 0.5, 100)
+
+	if len(boxes) != tilesX*tilesY*slices {
+		t.Fatalf("ClusterAABBs returned %d boxes, expected %d", len(boxes), tilesX*tilesY*slices)
+	}
+
+	points := []Vec3{{0, 0, -1}, {3, -1, -20}, {-10, 5, -60}, {0.2, 0.3, -0.7}}
+	for _, p := range points {
+		i, ok := ClusterIndex(p, proj, tilesX, tilesY, slices, 0.5, 100)
+		if !ok {
+			t.Errorf("Point %v is on screen but ClusterIndex says it isn't", p)
+			continue
+		}
+
+		b := boxes[i]
+		for j := range p {
+			if p[j] < b.Min[j]-1e-4 || p[j] > b.Max[j]+1e-4 {
+				t.Errorf("Point %v is not inside its cluster %d's AABB %v", p, i, b)
+				break
+			}
+		}
+	}
+
+	if _, ok := ClusterIndex(Vec3{0, 0, 5}, proj, tilesX, tilesY, slices, 0.5, 100); ok {
+		t.Errorf("Point behind the camera should not be assigned a cluster")
+	}
+}
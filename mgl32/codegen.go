@@ -10,6 +10,23 @@
 // See the invocation in mgl32/util.go for details.
 // To use it, just run "go generate github.com/go-gl/mathgl/mgl32"
 // (or "go generate" in mgl32 directory).
+//
+// It can also generate the API of mgl32 over another scalar type, for
+// downstream projects: the templates take the scalar type and package name
+// from the -scalar and -package flags, and the -convert mode rewrites the
+// given handwritten files of mgl32 (e.g. quat.go, util.go, singular.go and
+// bytes.go, which the templates depend on, with format.go and half.go for
+// bytes.go) the same way mgl64 is made. For instance, from a copy of the mgl32
+// directory:
+//
+//	go run codegen.go -template vector.tmpl -output ../geom/vector.go -scalar Meters -package geom
+//	go run codegen.go -template matrix.tmpl -output ../geom/matrix.go -scalar Meters -package geom
+//	go run codegen.go -convert -scalar Meters -package geom -dir ../geom util.go quat.go singular.go bytes.go format.go half.go
+//
+// The scalar must be a defined type whose underlying type is float32 or
+// float64, declared in a separate file of the destination package, since the
+// generated code relies on the arithmetic operators and on conversions to and
+// from float64 for the math package.
 
 package main
 
@@ -28,6 +45,8 @@ import (
 type Context struct {
 	Comment      string
 	TemplateName string
+	Scalar       string // Element type of vectors and matrices
+	Package      string
 }
 
 type MatrixIter struct {
@@ -43,22 +62,32 @@ var mgl64RewriteRules = []string{
 	"a.Float32 -> a.Float64",
 	"math.MaxFloat32 -> math.MaxFloat64",
 	"math.SmallestNonzeroFloat32 -> math.SmallestNonzeroFloat64",
+	"floats32 -> floats64",
+	"complex64 -> complex128",
 }
 
+// mgl64PathRewrites renames the subpackages of mgl32 whose name mentions the
+// precision.
+var mgl64PathRewrites = strings.NewReplacer("floats32", "floats64")
+
 func main() {
 	flag.Usage = func() {
 		fmt.Println("Usage: codegen -template file.tmpl -output file.go")
 		fmt.Println("Usage: codegen -mgl64 [-dir ../mgl64]")
+		fmt.Println("Usage: codegen -convert -scalar type -package name -dir path file.go...")
 		flag.PrintDefaults()
 	}
 
 	tmplPath := flag.String("template", "file.tmpl", "template path")
 	oPath := flag.String("output", "file.go", "output path")
 	mgl64 := flag.Bool("mgl64", false, "make mgl64")
-	mgl64Path := flag.String("dir", "../mgl64", "path to mgl64 location")
+	mgl64Path := flag.String("dir", "../mgl64", "path to mgl64 location, or destination of -convert")
+	convert := flag.Bool("convert", false, "convert the given files to the scalar type and package")
+	scalar := flag.String("scalar", "float32", "scalar type of the generated code")
+	pkg := flag.String("package", "mgl32", "package name of the generated code")
 
 	flag.Parse()
-	if flag.NArg() > 0 || flag.NFlag() == 0 {
+	if flag.NArg() > 0 && !*convert || flag.NFlag() == 0 {
 		flag.Usage()
 		os.Exit(2)
 	}
@@ -68,6 +97,11 @@ func main() {
 		return
 	}
 
+	if *convert {
+		genConverted(*mgl64Path, flag.Args(), *scalar, *pkg)
+		return
+	}
+
 	tmpl := template.New("").Delims("<<", ">>").Funcs(template.FuncMap{
 		"typename":    typenameHelper,
 		"elementname": elementNameHelper,
@@ -90,6 +124,8 @@ func main() {
 	context := Context{
 		Comment:      "This file is generated by codegen.go; DO NOT EDIT",
 		TemplateName: tmplName,
+		Scalar:       *scalar,
+		Package:      *pkg,
 	}
 	if err = tmpl.ExecuteTemplate(oFile, tmplName, context); err != nil {
 		panic(err)
@@ -106,7 +142,7 @@ func genMgl64(destPath string) {
 		if err != nil {
 			return err
 		}
-		dest := filepath.Join(destPath, source)
+		dest := filepath.Join(destPath, mgl64PathRewrites.Replace(source))
 
 		if info.IsDir() {
 			return os.MkdirAll(dest, info.Mode())
@@ -152,6 +188,35 @@ func genMgl64(destPath string) {
 	}
 }
 
+// genConverted rewrites the given files of mgl32 into destPath, for the
+// scalar type and package name given.
+func genConverted(destPath string, sources []string, scalar, pkg string) {
+	rules := []string{
+		"mgl32 -> " + pkg,
+		"float32 -> " + scalar,
+	}
+
+	for _, source := range sources {
+		in, err := ioutil.ReadFile(source)
+		if err != nil {
+			panic(err)
+		}
+
+		dest := filepath.Join(destPath, filepath.Base(source))
+		comment := fmt.Sprintf(
+			"// This file is generated from mgl32/%s; DO NOT EDIT\n\n",
+			filepath.ToSlash(filepath.Base(source)))
+		r := strings.NewReplacer("//go:generate ", "//#go:generate ")
+		if err = ioutil.WriteFile(dest, []byte(comment+r.Replace(string(in))), 0644); err != nil {
+			panic(err)
+		}
+
+		if err = rungofmt(dest, true, rules); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func rungofmt(path string, fiximports bool, rewriteRules []string) error {
 	args := []string{"-w", path}
 	output, err := exec.Command("gofmt", args...).CombinedOutput()
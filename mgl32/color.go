@@ -0,0 +1,219 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Blackbody returns the color of a black body radiator at the given
+// temperature in Kelvin, as linear RGB with Rec. 709 (sRGB) primaries and a
+// D65 white point. Only the chromaticity is meaningful: the color is scaled so
+// that its largest component is 1, and components outside of the gamut are
+// clamped to 0. The temperature is clamped to [1667, 25000], the range of the
+// underlying approximation of the Planckian locus (Kim et al., 2002).
+//
+// Around 6500K the result is close to white; lower temperatures are reddish
+// and higher ones bluish.
+func Blackbody(kelvin float32) Vec3 {
+	t := float64(Clamp(kelvin, 1667, 25000))
+	t2, t3 := t*t, t*t*t
+
+	var x float64
+	if t <= 4000 {
+		x = -0.2661239e9/t3 - 0.2343589e6/t2 + 0.8776956e3/t + 0.179910
+	} else {
+		x = -3.0258469e9/t3 + 2.1070379e6/t2 + 0.2226347e3/t + 0.240390
+	}
+
+	x2, x3 := x*x, x*x*x
+	var y float64
+	switch {
+	case t <= 2222:
+		y = -1.1063814*x3 - 1.34811020*x2 + 2.18555832*x - 0.20219683
+	case t <= 4000:
+		y = -0.9549476*x3 - 1.37418593*x2 + 2.09137015*x - 0.16748867
+	default:
+		y = 3.0817580*x3 - 5.87338670*x2 + 3.75112997*x - 0.37001483
+	}
+
+	// xyY with Y = 1 to XYZ, then XYZ to linear sRGB.
+	cx, cz := x/y, (1-x-y)/y
+	rgb := Vec3{
+		float32(3.2404542*cx - 1.5371385 - 0.4985314*cz),
+		float32(-0.9692660*cx + 1.8760108 + 0.0415560*cz),
+		float32(0.0556434*cx - 0.2040259 + 1.0572252*cz),
+	}
+
+	var max float32
+	for i := range rgb {
+		if rgb[i] < 0 {
+			rgb[i] = 0
+		}
+		if rgb[i] > max {
+			max = rgb[i]
+		}
+	}
+	return rgb.Mul(1 / max)
+}
+
+// The colormaps below map a scalar t in [0,1] to a color, and clamp t to that
+// range. They are evaluated with polynomial fits of the reference tables,
+// which avoids storing the tables at the price of an error of a few percent.
+// Like the reference tables, they return sRGB encoded (not linear) colors.
+
+// Viridis samples the perceptually uniform viridis colormap (dark blue to
+// yellow), the default colormap of matplotlib. The fit is by Matt Zucker.
+func Viridis(t float32) Vec3 {
+	return colormapPoly(t, &viridisCoeffs)
+}
+
+// Inferno samples the perceptually uniform inferno colormap (black to pale
+// yellow through red), also from matplotlib. The fit is by Matt Zucker.
+func Inferno(t float32) Vec3 {
+	return colormapPoly(t, &infernoCoeffs)
+}
+
+// Turbo samples Google's Turbo colormap, an improved rainbow colormap (dark
+// blue to dark red), using Google's own polynomial approximation.
+func Turbo(t float32) Vec3 {
+	return colormapPoly(t, &turboCoeffs)
+}
+
+// Polynomial coefficients of the colormaps, lowest degree first.
+var (
+	viridisCoeffs = [7]Vec3{
+		{0.2777273272234177, 0.005407344544966578, 0.3340998053353061},
+		{0.1050930431085774, 1.404613529898575, 1.384590162594685},
+		{-0.3308618287255563, 0.214847559468213, 0.09509516302823659},
+		{-4.634230498983486, -5.799100973351585, -19.33244095627987},
+		{6.228269936347081, 14.17993336680509, 56.69055260068105},
+		{4.776384997670288, -13.74514537774601, -65.35303263337234},
+		{-5.435455855934631, 4.645852612178535, 26.3124352495832},
+	}
+	infernoCoeffs = [7]Vec3{
+		{0.0002189403691192265, 0.001651004631001012, -0.01948089843709184},
+		{0.1065134194856116, 0.5639564367884091, 3.932712388889277},
+		{11.60249308247187, -3.972853965665698, -15.9423941062914},
+		{-41.70399613139459, 17.43639888205313, 44.35414519872813},
+		{77.162935699427, -33.40235894210092, -81.80730925738993},
+		{-71.31942824499214, 32.62606426397723, 73.20951985803202},
+		{25.13112622477341, -12.24266895238567, -23.07032500287172},
+	}
+	turboCoeffs = [7]Vec3{
+		{0.13572138, 0.09140261, 0.10667330},
+		{4.61539260, 2.19418839, 12.64194608},
+		{-42.66032258, 4.84296658, -60.58204836},
+		{132.13108234, -14.18503333, 110.36276771},
+		{-152.94239396, 4.27729857, -89.90310912},
+		{59.28637943, 2.82956604, 27.34824973},
+		{},
+	}
+)
+
+// colormapPoly evaluates the polynomial with the given coefficients at t with
+// Horner's method.
+func colormapPoly(t float32, coeffs *[7]Vec3) Vec3 {
+	t = Clamp(t, 0, 1)
+	c := coeffs[6]
+	for i := 5; i >= 0; i-- {
+		c = c.Mul(t).Add(coeffs[i])
+	}
+	for i := range c {
+		c[i] = Clamp(c[i], 0, 1)
+	}
+	return c
+}
+
+// Premultiply converts a color with straight (unassociated) alpha to
+// premultiplied alpha, by multiplying its RGB components by its alpha.
+func Premultiply(c Vec4) Vec4 {
+	return Vec4{c[0] * c[3], c[1] * c[3], c[2] * c[3], c[3]}
+}
+
+// Unpremultiply converts a color with premultiplied alpha back to straight
+// alpha. The color of a (nearly) fully transparent pixel is unknown, and
+// dividing by its alpha only amplifies rounding noise: when alpha is below
+// 1/65536, less than the smallest step of a 16 bit channel, the result is
+// transparent black.
+func Unpremultiply(c Vec4) Vec4 {
+	if c[3] < 1./65536 {
+		return Vec4{}
+	}
+	inv := 1 / c[3]
+	return Vec4{c[0] * inv, c[1] * inv, c[2] * inv, c[3]}
+}
+
+// BlendOp is one of the Porter-Duff compositing operators, for the use of
+// Blend.
+type BlendOp int
+
+// The BlendOp constants are the Porter-Duff operators, which combine a source
+// color with a destination color. The names follow the usual convention,
+// e.g. SrcOver draws the source over the destination.
+const (
+	BlendClear BlendOp = iota
+	BlendSrc
+	BlendDst
+	BlendSrcOver
+	BlendDstOver
+	BlendSrcIn
+	BlendDstIn
+	BlendSrcOut
+	BlendDstOut
+	BlendSrcAtop
+	BlendDstAtop
+	BlendXor
+	BlendPlus
+)
+
+// Blend composites the src color with the dst color using the given
+// Porter-Duff operator. Both colors, and the result, use premultiplied alpha;
+// see BlendStraight for colors with straight alpha. BlendPlus clamps the
+// result to 1. If op is not a valid BlendOp, this function will panic.
+func Blend(src, dst Vec4, op BlendOp) Vec4 {
+	as, ad := src[3], dst[3]
+
+	var fs, fd float32
+	switch op {
+	case BlendClear:
+		fs, fd = 0, 0
+	case BlendSrc:
+		fs, fd = 1, 0
+	case BlendDst:
+		fs, fd = 0, 1
+	case BlendSrcOver:
+		fs, fd = 1, 1-as
+	case BlendDstOver:
+		fs, fd = 1-ad, 1
+	case BlendSrcIn:
+		fs, fd = ad, 0
+	case BlendDstIn:
+		fs, fd = 0, as
+	case BlendSrcOut:
+		fs, fd = 1-ad, 0
+	case BlendDstOut:
+		fs, fd = 0, 1-as
+	case BlendSrcAtop:
+		fs, fd = ad, 1-as
+	case BlendDstAtop:
+		fs, fd = 1-ad, as
+	case BlendXor:
+		fs, fd = 1-ad, 1-as
+	case BlendPlus:
+		c := src.Add(dst)
+		for i := range c {
+			c[i] = Clamp(c[i], 0, 1)
+		}
+		return c
+	default:
+		panic("Unsupported blend operator")
+	}
+
+	return src.Mul(fs).Add(dst.Mul(fd))
+}
+
+// BlendStraight is like Blend, but for colors with straight alpha: the colors
+// are premultiplied, composited, and the result converted back with
+// Unpremultiply.
+func BlendStraight(src, dst Vec4, op BlendOp) Vec4 {
+	return Unpremultiply(Blend(Premultiply(src), Premultiply(dst), op))
+}
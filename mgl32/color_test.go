@@ -0,0 +1,119 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestBlackbody(t *testing.T) {
+	t.Parallel()
+
+	// D65 lies slightly off the Planckian locus, so a 6504K black body is
+	// only approximately white.
+	if c := Blackbody(6504); !c.ApproxFuncEqual(Vec3{1, 1, 1}, func(a, b float32) bool { return Abs(a-b) < 0.08 }) {
+		t.Errorf("Blackbody(6504) = %v, expected close to white", c)
+	}
+
+	if c := Blackbody(2000); !(c[0] == 1 && c[0] > c[1] && c[1] > c[2]) {
+		t.Errorf("Blackbody(2000) = %v, expected a red-orange color", c)
+	}
+
+	if c := Blackbody(15000); !(c[2] == 1 && c[2] > c[1] && c[1] > c[0]) {
+		t.Errorf("Blackbody(15000) = %v, expected a blue color", c)
+	}
+
+	if c, clamped := Blackbody(100), Blackbody(1667); c != clamped {
+		t.Errorf("Blackbody did not clamp the temperature: %v != %v", c, clamped)
+	}
+}
+
+func TestColormaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Name string
+		Map  func(float32) Vec3
+		T    float32
+		Want Vec3
+	}{
+		// Reference values from the original tables.
+		{"Viridis", Viridis, 0, Vec3{0.267004, 0.004874, 0.329415}},
+		{"Viridis", Viridis, 0.5, Vec3{0.128729, 0.563265, 0.551229}},
+		{"Viridis", Viridis, 1, Vec3{0.993248, 0.906157, 0.143936}},
+		{"Inferno", Inferno, 0, Vec3{0.001462, 0.000466, 0.013866}},
+		{"Inferno", Inferno, 0.5, Vec3{0.735683, 0.215906, 0.330245}},
+		{"Inferno", Inferno, 1, Vec3{0.988362, 0.998364, 0.644924}},
+		{"Turbo", Turbo, 2, Turbo(1)},
+	}
+
+	for _, c := range tests {
+		if got := c.Map(c.T); !got.ApproxFuncEqual(c.Want, func(a, b float32) bool { return Abs(a-b) < 0.05 }) {
+			t.Errorf("%s(%v) = %v, expected %v", c.Name, c.T, got, c.Want)
+		}
+	}
+
+	if c := Turbo(0.1); !(c[2] > c[0] && c[2] > c[1]) {
+		t.Errorf("Turbo(0.1) = %v, expected a blue", c)
+	}
+	if c := Turbo(1); !(c[0] > c[1] && c[0] > c[2]) {
+		t.Errorf("Turbo(1) = %v, expected a dark red", c)
+	}
+}
+
+func TestPremultiply(t *testing.T) {
+	t.Parallel()
+
+	c := Vec4{0.8, 0.4, 0.2, 0.5}
+	p := Premultiply(c)
+	if correct := (Vec4{0.4, 0.2, 0.1, 0.5}); !p.ApproxEqualThreshold(correct, 1e-6) {
+		t.Errorf("Premultiply(%v) = %v, expected %v", c, p, correct)
+	}
+	if u := Unpremultiply(p); !u.ApproxEqualThreshold(c, 1e-6) {
+		t.Errorf("Unpremultiply(%v) = %v, expected %v", p, u, c)
+	}
+
+	if u := Unpremultiply(Vec4{1e-7, 0, 0, 1e-8}); u != (Vec4{}) {
+		t.Errorf("Unpremultiply of a transparent color = %v, expected transparent black", u)
+	}
+}
+
+func TestBlend(t *testing.T) {
+	t.Parallel()
+
+	// Premultiplied half transparent red over half transparent blue.
+	src := Vec4{0.5, 0, 0, 0.5}
+	dst := Vec4{0, 0, 0.5, 0.5}
+
+	tests := []struct {
+		Op   BlendOp
+		Want Vec4
+	}{
+		{BlendClear, Vec4{0, 0, 0, 0}},
+		{BlendSrc, src},
+		{BlendDst, dst},
+		{BlendSrcOver, Vec4{0.5, 0, 0.25, 0.75}},
+		{BlendDstOver, Vec4{0.25, 0, 0.5, 0.75}},
+		{BlendSrcIn, Vec4{0.25, 0, 0, 0.25}},
+		{BlendDstIn, Vec4{0, 0, 0.25, 0.25}},
+		{BlendSrcOut, Vec4{0.25, 0, 0, 0.25}},
+		{BlendDstOut, Vec4{0, 0, 0.25, 0.25}},
+		{BlendSrcAtop, Vec4{0.25, 0, 0.25, 0.5}},
+		{BlendDstAtop, Vec4{0.25, 0, 0.25, 0.5}},
+		{BlendXor, Vec4{0.25, 0, 0.25, 0.5}},
+		{BlendPlus, Vec4{0.5, 0, 0.5, 1}},
+	}
+
+	for _, c := range tests {
+		if got := Blend(src, dst, c.Op); !got.ApproxFuncEqual(c.Want, func(a, b float32) bool { return Abs(a-b) < 1e-6 }) {
+			t.Errorf("Blend with operator %v = %v, expected %v", c.Op, got, c.Want)
+		}
+	}
+
+	// Opaque colors stay opaque, and straight alpha gives the usual result.
+	if got := BlendStraight(Vec4{1, 0, 0, 0.25}, Vec4{0, 0, 1, 1}, BlendSrcOver); !got.ApproxEqualThreshold(Vec4{0.25, 0, 0.75, 1}, 1e-6) {
+		t.Errorf("BlendStraight = %v, expected %v", got, Vec4{0.25, 0, 0.75, 1})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Vec2FromComplex returns the vector {real(c), imag(c)}.
+func Vec2FromComplex(c complex64) Vec2 {
+	return Vec2{real(c), imag(c)}
+}
+
+// Complex returns the vector as the complex number v[0] + v[1]i.
+func (v Vec2) Complex() complex64 {
+	return complex(v[0], v[1])
+}
+
+// A unit complex number cos(theta) + sin(theta)i is a 2D rotation by theta, a
+// rotor: multiplying the complex form of a vector by it rotates the vector, and
+// multiplying two rotors composes their rotations. This is cheaper than
+// composing Mat2s and, like a Quat, can be renormalized to undo drift.
+
+// RotorFromAngle returns the rotor that rotates counterclockwise by angle
+// radians, cos(angle) + sin(angle)i.
+func RotorFromAngle(angle float32) complex64 {
+	sin, cos := math.Sincos(float64(angle))
+	return complex(float32(cos), float32(sin))
+}
+
+// RotorBetween returns the rotor that rotates the direction of from onto the
+// direction of to. If either vector has zero length, the result is the
+// identity rotor 1.
+func RotorBetween(from, to Vec2) complex64 {
+	r := Vec2{from.Dot(to), from.Cross(to)}
+	l := r.Len()
+	if l == 0 {
+		return 1
+	}
+	return complex(r[0]/l, r[1]/l)
+}
+
+// RotateByRotor rotates v by the rotor r. This is Vec2FromComplex(r * v.Complex()).
+func RotateByRotor(v Vec2, r complex64) Vec2 {
+	return Vec2FromComplex(r * v.Complex())
+}
+
+// RotorAngle returns the angle in radians, in [-Pi, Pi], that the rotor r
+// rotates by.
+func RotorAngle(r complex64) float32 {
+	return float32(math.Atan2(float64(imag(r)), float64(real(r))))
+}
+
+// Mat2FromRotor returns the rotation matrix of the rotor r, which for
+// RotorFromAngle(angle) is the same as Rotate2D(angle).
+func Mat2FromRotor(r complex64) Mat2 {
+	return Mat2{real(r), imag(r), -imag(r), real(r)}
+}
+
+// RotorFromMat2 returns the rotor of the rotation matrix m, the inverse of
+// Mat2FromRotor. The matrix is assumed to be a rotation.
+func RotorFromMat2(m Mat2) complex64 {
+	return complex(m[0], m[1])
+}
@@ -0,0 +1,72 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComplexVec2RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	v := Vec2{3, -4}
+	if c := v.Complex(); c != complex(3, -4) {
+		t.Errorf("%v.Complex() = %v, want (3-4i)", v, c)
+	}
+	if r := Vec2FromComplex(v.Complex()); r != v {
+		t.Errorf("Vec2FromComplex(%v.Complex()) = %v", v, r)
+	}
+}
+
+func TestRotors(t *testing.T) {
+	t.Parallel()
+
+	approx := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+	tests := []struct {
+		v     Vec2
+		angle float32
+	}{
+		{Vec2{1, 0}, math.Pi / 2},
+		{Vec2{3, 4}, -1},
+		{Vec2{-2, 1}, 2.5},
+		{Vec2{0.5, 0.5}, 0},
+	}
+
+	for _, c := range tests {
+		r := RotorFromAngle(c.angle)
+		want := c.v.Rotated(c.angle)
+		if got := RotateByRotor(c.v, r); !got.ApproxFuncEqual(want, approx) {
+			t.Errorf("RotateByRotor(%v, RotorFromAngle(%v)) = %v, want %v", c.v, c.angle, got, want)
+		}
+		if m := Mat2FromRotor(r); !m.ApproxFuncEqual(Rotate2D(c.angle), approx) {
+			t.Errorf("Mat2FromRotor(RotorFromAngle(%v)) = %v, want %v", c.angle, m, Rotate2D(c.angle))
+		}
+		if back := RotorFromMat2(Rotate2D(c.angle)); !approx(real(back), real(r)) || !approx(imag(back), imag(r)) {
+			t.Errorf("RotorFromMat2(Rotate2D(%v)) = %v, want %v", c.angle, back, r)
+		}
+		if a := RotorAngle(r); !approx(a, c.angle) {
+			t.Errorf("RotorAngle(RotorFromAngle(%v)) = %v", c.angle, a)
+		}
+		if b := RotateByRotor(c.v, RotorBetween(c.v, want)); !b.ApproxFuncEqual(want, approx) {
+			t.Errorf("RotorBetween(%v, %v) rotates %v to %v", c.v, want, c.v, b)
+		}
+	}
+
+	// Composition is multiplication.
+	r := RotorFromAngle(0.3) * RotorFromAngle(1.1)
+	if a := RotorAngle(r); !approx(a, 1.4) {
+		t.Errorf("RotorAngle of composed rotors = %v, want 1.4", a)
+	}
+
+	// Lengths don't matter to RotorBetween.
+	r = RotorBetween(Vec2{2, 0}, Vec2{0, 5})
+	if !approx(real(r), 0) || !approx(imag(r), 1) {
+		t.Errorf("RotorBetween({2, 0}, {0, 5}) = %v, want 1i", r)
+	}
+	if r = RotorBetween(Vec2{}, Vec2{1, 2}); r != 1 {
+		t.Errorf("RotorBetween of a zero vector = %v, want 1", r)
+	}
+}
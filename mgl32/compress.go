@@ -0,0 +1,126 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// CompressSmallestThree packs a unit quaternion into the low 2+3*bits bits of
+// a uint64 using the "smallest three" encoding common in networked games.
+//
+// The largest component (by absolute value) is dropped and its index stored in
+// the two lowest bits; the other three components lie in [-1/sqrt(2),1/sqrt(2)]
+// and are quantized to the given number of bits each. Since q and -q represent
+// the same rotation, the quaternion is negated if needed so the dropped
+// component is positive.
+//
+// The quaternion is expected to be normalized. Bits must be in the range
+// [2,20] (so the result fits into 62 bits, or 32 bits for bits <= 10) or this
+// function will panic.
+func (q Quat) CompressSmallestThree(bits uint) uint64 {
+	if bits < 2 || bits > 20 {
+		panic("CompressSmallestThree needs between 2 and 20 bits per component")
+	}
+
+	c := [4]float32{q.W, q.V[0], q.V[1], q.V[2]}
+	largest := 0
+	for i := 1; i < 4; i++ {
+		if Abs(c[i]) > Abs(c[largest]) {
+			largest = i
+		}
+	}
+	if c[largest] < 0 {
+		for i := range c {
+			c[i] = -c[i]
+		}
+	}
+
+	packed := uint64(largest)
+	shift := uint(2)
+	for i := 0; i < 4; i++ {
+		if i == largest {
+			continue
+		}
+		packed |= uint64(quantize(c[i], -math.Sqrt2/2, math.Sqrt2/2, bits)) << shift
+		shift += bits
+	}
+
+	return packed
+}
+
+// DecompressSmallestThree unpacks a quaternion packed by
+// Quat.CompressSmallestThree with the same number of bits per component.
+// The result is normalized.
+func DecompressSmallestThree(packed uint64, bits uint) Quat {
+	if bits < 2 || bits > 20 {
+		panic("DecompressSmallestThree needs between 2 and 20 bits per component")
+	}
+
+	largest := int(packed & 3)
+	shift := uint(2)
+
+	var c [4]float32
+	var sum float32
+	for i := 0; i < 4; i++ {
+		if i == largest {
+			continue
+		}
+		c[i] = dequantize(uint32(packed>>shift)&(1<<bits-1), -math.Sqrt2/2, math.Sqrt2/2, bits)
+		sum += c[i] * c[i]
+		shift += bits
+	}
+	c[largest] = float32(math.Sqrt(float64(Clamp(1-sum, 0, 1))))
+
+	return Quat{c[0], Vec3{c[1], c[2], c[3]}}.Normalize()
+}
+
+// QuantizeVec3 packs a position inside of the given bounds into the low 3*bits
+// bits of a uint64, with X in the lowest bits. Each component is mapped
+// linearly onto 2^bits evenly spaced values between bounds.Min and bounds.Max,
+// values outside of the bounds are clamped to them.
+//
+// Bits must be in the range [1,21] or this function will panic.
+func QuantizeVec3(v Vec3, bounds AABB, bits uint) uint64 {
+	if bits < 1 || bits > 21 {
+		panic("QuantizeVec3 needs between 1 and 21 bits per component")
+	}
+
+	var packed uint64
+	for i := range v {
+		packed |= uint64(quantize(v[i], bounds.Min[i], bounds.Max[i], bits)) << (uint(i) * bits)
+	}
+
+	return packed
+}
+
+// DequantizeVec3 unpacks a position packed by QuantizeVec3 with the same
+// bounds and number of bits per component.
+func DequantizeVec3(packed uint64, bounds AABB, bits uint) Vec3 {
+	if bits < 1 || bits > 21 {
+		panic("DequantizeVec3 needs between 1 and 21 bits per component")
+	}
+
+	var v Vec3
+	for i := range v {
+		v[i] = dequantize(uint32(packed>>(uint(i)*bits))&(1<<bits-1), bounds.Min[i], bounds.Max[i], bits)
+	}
+
+	return v
+}
+
+// quantize maps v from [low,high] to the nearest of 2^bits evenly spaced
+// integer steps, clamping values outside of the range.
+func quantize(v, low, high float32, bits uint) uint32 {
+	steps := float64(uint32(1)<<bits - 1)
+	t := float64(Clamp((v-low)/(high-low), 0, 1))
+
+	return uint32(math.Floor(t*steps + 0.5))
+}
+
+func dequantize(v uint32, low, high float32, bits uint) float32 {
+	steps := float64(uint32(1)<<bits - 1)
+	return low + float32(float64(v)/steps)*(high-low)
+}
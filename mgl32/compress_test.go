@@ -0,0 +1,69 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSmallestThreeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(42))
+	for _, bits := range []uint{9, 10, 15, 20} {
+		// The worst case error of a component is half a quantization step.
+		maxErr := float32(1.5 / float64(uint32(1)<<bits))
+
+		for i := 0; i < 200; i++ {
+			q := Quat{r.Float32()*2 - 1, Vec3{r.Float32()*2 - 1, r.Float32()*2 - 1, r.Float32()*2 - 1}}.Normalize()
+
+			packed := q.CompressSmallestThree(bits)
+			if packed>>(2+3*bits) != 0 {
+				t.Fatalf("CompressSmallestThree(%d) used more than %d bits: %x", bits, 2+3*bits, packed)
+			}
+
+			got := DecompressSmallestThree(packed, bits)
+			if got.Dot(q) < 0 {
+				got = got.Scale(-1)
+			}
+			if diff := got.Sub(q); Abs(diff.W) > 2*maxErr || diff.V.Len() > 4*maxErr {
+				t.Errorf("DecompressSmallestThree(CompressSmallestThree(%v, %d)) = %v", q, bits, got)
+			}
+		}
+	}
+}
+
+func TestSmallestThreeNegatedLargest(t *testing.T) {
+	t.Parallel()
+
+	q := QuatRotate(3, Vec3{0, 1, 0}) // W is negative, Y is the biggest component
+	got := DecompressSmallestThree(q.CompressSmallestThree(12), 12)
+	if !got.OrientationEqualThreshold(q, 1e-5) {
+		t.Errorf("Quaternion %v decompressed as %v", q, got)
+	}
+}
+
+func TestQuantizeVec3(t *testing.T) {
+	t.Parallel()
+
+	bounds := AABB{Vec3{-100, 0, -50}, Vec3{100, 20, 50}}
+
+	tests := []struct {
+		In, Out Vec3
+	}{
+		{Vec3{-100, 0, -50}, Vec3{-100, 0, -50}},
+		{Vec3{100, 20, 50}, Vec3{100, 20, 50}},
+		{Vec3{1000, -10, 0}, Vec3{100, 0, 0}},
+		{Vec3{12.5, 7.25, -3.125}, Vec3{12.5, 7.25, -3.125}},
+	}
+
+	for _, test := range tests {
+		packed := QuantizeVec3(test.In, bounds, 16)
+		if got := DequantizeVec3(packed, bounds, 16); got.Sub(test.Out).Len() > 2e-3 {
+			t.Errorf("DequantizeVec3(QuantizeVec3(%v)) = %v, expected %v", test.In, got, test.Out)
+		}
+	}
+}
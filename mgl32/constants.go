@@ -0,0 +1,37 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Named vectors, and the identities of the quaternions and square matrices.
+// Go has no constant arrays or structs, so these are variables; treat them as
+// constants and never assign to them, since every user of the package shares
+// them. The vectors and matrices are values, so using them in expressions such
+// as Vec3Y.Mul(2) or modifying a copy is fine.
+var (
+	Vec2Zero = Vec2{0, 0}
+	Vec2One  = Vec2{1, 1}
+	Vec2X    = Vec2{1, 0}
+	Vec2Y    = Vec2{0, 1}
+
+	Vec3Zero = Vec3{0, 0, 0}
+	Vec3One  = Vec3{1, 1, 1}
+	Vec3X    = Vec3{1, 0, 0}
+	Vec3Y    = Vec3{0, 1, 0}
+	Vec3Z    = Vec3{0, 0, 1}
+
+	Vec4Zero = Vec4{0, 0, 0, 0}
+	Vec4One  = Vec4{1, 1, 1, 1}
+	Vec4X    = Vec4{1, 0, 0, 0}
+	Vec4Y    = Vec4{0, 1, 0, 0}
+	Vec4Z    = Vec4{0, 0, 1, 0}
+	Vec4W    = Vec4{0, 0, 0, 1}
+
+	// QuatIdentity is QuatIdent(), the rotation by nothing.
+	QuatIdentity = QuatIdent()
+
+	Mat2Identity = Ident2()
+	Mat3Identity = Ident3()
+	Mat4Identity = Ident4()
+)
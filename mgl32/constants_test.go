@@ -0,0 +1,36 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestConstants(t *testing.T) {
+	t.Parallel()
+
+	if Vec3X.Cross(Vec3Y) != Vec3Z || Vec3Y.Cross(Vec3Z) != Vec3X {
+		t.Errorf("The basis vectors %v, %v, %v aren't right handed", Vec3X, Vec3Y, Vec3Z)
+	}
+	if Vec3X.Add(Vec3Y).Add(Vec3Z) != Vec3One || Vec3One.Sub(Vec3One) != Vec3Zero {
+		t.Errorf("Vec3One = %v, Vec3Zero = %v", Vec3One, Vec3Zero)
+	}
+	if Vec2X.Add(Vec2Y) != Vec2One || Vec2Zero != (Vec2{}) {
+		t.Errorf("Vec2One = %v, Vec2Zero = %v", Vec2One, Vec2Zero)
+	}
+	if Vec4X.Add(Vec4Y).Add(Vec4Z).Add(Vec4W) != Vec4One || Vec4Zero != (Vec4{}) || Vec3Z.Vec4(0) != Vec4Z {
+		t.Errorf("Vec4One = %v, Vec4Zero = %v", Vec4One, Vec4Zero)
+	}
+
+	if QuatIdentity != QuatIdent() || QuatIdentity.Rotate(Vec3One) != Vec3One {
+		t.Errorf("QuatIdentity = %v", QuatIdentity)
+	}
+	if Mat2Identity != Ident2() || Mat3Identity != Ident3() || Mat4Identity != Ident4() {
+		t.Errorf("The identity matrices are %v, %v, %v", Mat2Identity, Mat3Identity, Mat4Identity)
+	}
+	if Mat4Identity.Mul4x1(Vec4W) != Vec4W {
+		t.Errorf("Mat4Identity * %v = %v", Vec4W, Mat4Identity.Mul4x1(Vec4W))
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// QuatSwingTwist decomposes the rotation q into a twist about the unit vector
+// axis followed by a swing about an axis perpendicular to it, so that q equals
+// swing.Mul(twist). If q turns axis by 180 degrees, the twist is ambiguous and
+// the identity is used.
+func QuatSwingTwist(q Quat, axis Vec3) (swing, twist Quat) {
+	twist = Quat{q.W, axis.Mul(q.V.Dot(axis))}
+	if l := twist.Len(); l < 1e-6 {
+		twist = QuatIdent()
+	} else {
+		twist = twist.Scale(1 / l)
+	}
+	return q.Mul(twist.Conjugate()), twist
+}
+
+// ConstrainToCone limits the unit quaternion q so that it turns axis by at
+// most maxAngle radians, the ball-and-socket joint limit: q.Rotate(axis) is
+// kept within the cone of that half-angle about axis. The twist of q about
+// axis is preserved, and rotations within the cone are returned unchanged.
+func ConstrainToCone(q Quat, axis Vec3, maxAngle float32) Quat {
+	swing, twist := QuatSwingTwist(q, axis)
+	return clampQuatAngle(swing, maxAngle).Mul(twist)
+}
+
+// ConstrainTwist limits the twist of the unit quaternion q about axis, as
+// returned by QuatSwingTwist, to the angles in [minAngle, maxAngle] radians,
+// within (-Pi, Pi]. The swing is preserved.
+func ConstrainTwist(q Quat, axis Vec3, minAngle, maxAngle float32) Quat {
+	swing, twist := QuatSwingTwist(q, axis)
+	angle := 2 * math.Atan2(float64(twist.V.Dot(axis)), float64(twist.W))
+	if angle > math.Pi {
+		angle -= 2 * math.Pi
+	} else if angle <= -math.Pi {
+		angle += 2 * math.Pi
+	}
+	angle = math.Max(float64(minAngle), math.Min(float64(maxAngle), angle))
+	return swing.Mul(QuatRotate(float32(angle), axis))
+}
+
+// clampQuatAngle limits the rotation angle of the unit quaternion q to
+// maxAngle, keeping its axis.
+func clampQuatAngle(q Quat, maxAngle float32) Quat {
+	if q.W < 0 {
+		q = q.Scale(-1)
+	}
+	if 2*math.Acos(math.Min(1, float64(q.W))) <= float64(maxAngle) {
+		return q
+	}
+	return QuatRotate(maxAngle, q.V.Normalize())
+}
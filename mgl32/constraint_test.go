@@ -0,0 +1,90 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuatSwingTwist(t *testing.T) {
+	t.Parallel()
+
+	axis := Vec3{0, 1, 0}
+	for _, q := range []Quat{
+		QuatIdent(),
+		QuatRotate(0.7, axis),
+		QuatRotate(1.1, Vec3{1, 0, 0}),
+		QuatRotate(0.5, Vec3{1, 0, 0}).Mul(QuatRotate(-2, axis)),
+		QuatRotate(2.5, Vec3{1, 2, -1}.Normalize()),
+	} {
+		swing, twist := QuatSwingTwist(q, axis)
+		if got := swing.Mul(twist); !got.OrientationEqualThreshold(q, 1e-5) {
+			t.Errorf("QuatSwingTwist(%v): swing*twist = %v", q, got)
+		}
+		if !absEqual(1e-5)(swing.V.Dot(axis), 0) {
+			t.Errorf("QuatSwingTwist(%v): swing %v turns about the axis", q, swing)
+		}
+		if tw := twist.V; !absEqual(1e-5)(tw.Cross(axis).Len(), 0) {
+			t.Errorf("QuatSwingTwist(%v): twist %v isn't about the axis", q, twist)
+		}
+	}
+
+	// A half turn about a perpendicular axis has no twist.
+	q := QuatRotate(math.Pi, Vec3{0, 0, 1})
+	if _, twist := QuatSwingTwist(q, axis); twist != QuatIdent() {
+		t.Errorf("QuatSwingTwist(%v) twist = %v, want identity", q, twist)
+	}
+}
+
+func TestConstrainToCone(t *testing.T) {
+	t.Parallel()
+
+	axis := Vec3{0, 0, 1}
+	for _, test := range []struct {
+		q    Quat
+		want float32
+	}{
+		{QuatRotate(0.2, Vec3{1, 0, 0}), 0.2},
+		{QuatRotate(1, Vec3{1, 1, 0}.Normalize()), 0.5},
+		{QuatRotate(1, Vec3{1, 0, 0}).Mul(QuatRotate(0.8, axis)), 0.5},
+		{QuatRotate(3, Vec3{0, -1, 0}), 0.5},
+	} {
+		got := ConstrainToCone(test.q, axis, 0.5)
+		if angle := float32(math.Acos(float64(Clamp(got.Rotate(axis).Dot(axis), -1, 1)))); !FloatEqualThreshold(angle, test.want, 1e-4) {
+			t.Errorf("ConstrainToCone(%v) turns the axis by %v, want %v", test.q, angle, test.want)
+		}
+		_, wantTwist := QuatSwingTwist(test.q, axis)
+		if _, twist := QuatSwingTwist(got, axis); !twist.OrientationEqualThreshold(wantTwist, 1e-5) {
+			t.Errorf("ConstrainToCone(%v) changed the twist to %v, want %v", test.q, twist, wantTwist)
+		}
+	}
+
+	// The swing keeps its direction.
+	q := QuatRotate(1, Vec3{1, 0, 0})
+	if got, want := ConstrainToCone(q, axis, 0.25), QuatRotate(0.25, Vec3{1, 0, 0}); !got.OrientationEqualThreshold(want, 1e-5) {
+		t.Errorf("ConstrainToCone(%v) = %v, want %v", q, got, want)
+	}
+}
+
+func TestConstrainTwist(t *testing.T) {
+	t.Parallel()
+
+	axis := Vec3{1, 0, 0}
+	swing := QuatRotate(0.4, Vec3{0, 1, 0})
+	for _, test := range []struct{ angle, want float32 }{
+		{0.1, 0.1},
+		{1, 0.5},
+		{-1, -0.25},
+		{3, 0.5},
+		{-3, -0.25},
+	} {
+		q := swing.Mul(QuatRotate(test.angle, axis))
+		got := ConstrainTwist(q, axis, -0.25, 0.5)
+		if want := swing.Mul(QuatRotate(test.want, axis)); !got.OrientationEqualThreshold(want, 1e-5) {
+			t.Errorf("ConstrainTwist of a %v twist = %v, want %v", test.angle, got, want)
+		}
+	}
+}
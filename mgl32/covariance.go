@@ -0,0 +1,102 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// The uncertainty of a rigid transform T is modelled as a small random twist
+// e with zero mean and 6x6 covariance matrix cov, in the angular-first order
+// of Twist.VecN, perturbing T on the left: the true transform is
+// e.Exp().Mul4(T). The functions below propagate such covariances to first
+// order, as state estimators and pose graphs do, using the adjoint of SE(3).
+//
+// Like other MatMxN operations, they return nil if any covariance is nil or
+// not 6x6.
+
+// isCov6 reports whether cov is a 6x6 matrix.
+func isCov6(cov *MatMxN) bool {
+	return cov != nil && cov.m == 6 && cov.n == 6
+}
+
+// TransformCovariance stores in dst the covariance of the uncertainty cov of
+// a transform once expressed in the frame m maps to, Ad * cov * Ad^T with Ad
+// the Adjoint of m. This is the covariance of m.Mul4(T).Mul4(m.Inv()), or of
+// m.Mul4(T) for an exactly known m. Dst may be cov.
+func TransformCovariance(dst *MatMxN, m Mat4, cov *MatMxN) *MatMxN {
+	if !isCov6(cov) {
+		return nil
+	}
+
+	a, b, c, d := transformCov6(m, cov)
+	dst = dst.Reshape(6, 6)
+	setBlocks3(dst, a, b, c, d)
+	return dst
+}
+
+// ComposeCovariance stores in dst the covariance of m1.Mul4(m2), given the
+// uncorrelated uncertainties cov1 of m1 and cov2 of m2:
+// cov1 + Ad * cov2 * Ad^T with Ad the Adjoint of m1. m2 itself drops out, as
+// its uncertainty is on the left. Dst may be one of the covariances.
+func ComposeCovariance(dst *MatMxN, m1 Mat4, cov1, cov2 *MatMxN) *MatMxN {
+	if !isCov6(cov1) || !isCov6(cov2) {
+		return nil
+	}
+
+	a, b, c, d := transformCov6(m1, cov2)
+	a1, b1, c1, d1 := blocks3(cov1)
+	dst = dst.Reshape(6, 6)
+	setBlocks3(dst, a.Add(a1), b.Add(b1), c.Add(c1), d.Add(d1))
+	return dst
+}
+
+// transformCov6 returns the 3x3 blocks of Ad * cov * Ad^T, top left, top
+// right, bottom left and bottom right, computed blockwise from those of cov
+// and Ad = [[R, 0], [[p]xR, R]] so that no temporary matrices are needed.
+func transformCov6(m Mat4, cov *MatMxN) (a, b, c, d Mat3) {
+	r := m.Mat3()
+	pr := CrossMat3(m.Col(3).Vec3()).Mul3(r)
+	ca, cb, cc, cd := blocks3(cov)
+
+	// Ad * cov is [[ra, rb], [lower, lowerB]].
+	ra, rb := r.Mul3(ca), r.Mul3(cb)
+	lower, lowerB := pr.Mul3(ca).Add(r.Mul3(cc)), pr.Mul3(cb).Add(r.Mul3(cd))
+	rT, prT := r.Transpose(), pr.Transpose()
+
+	return ra.Mul3(rT), ra.Mul3(prT).Add(rb.Mul3(rT)),
+		lower.Mul3(rT), lower.Mul3(prT).Add(lowerB.Mul3(rT))
+}
+
+// blocks3 returns the 3x3 blocks of the 6x6 matrix mat, top left, top right,
+// bottom left and bottom right.
+func blocks3(mat *MatMxN) (a, b, c, d Mat3) {
+	for col := 0; col < 3; col++ {
+		for row := 0; row < 3; row++ {
+			a[col*3+row] = mat.At(row, col)
+			b[col*3+row] = mat.At(row, col+3)
+			c[col*3+row] = mat.At(row+3, col)
+			d[col*3+row] = mat.At(row+3, col+3)
+		}
+	}
+	return a, b, c, d
+}
+
+// setBlocks3 sets the 3x3 blocks of the 6x6 matrix mat, as returned by
+// blocks3.
+func setBlocks3(mat *MatMxN, a, b, c, d Mat3) {
+	for col := 0; col < 3; col++ {
+		for row := 0; row < 3; row++ {
+			mat.Set(row, col, a[col*3+row])
+			mat.Set(row, col+3, b[col*3+row])
+			mat.Set(row+3, col, c[col*3+row])
+			mat.Set(row+3, col+3, d[col*3+row])
+		}
+	}
+}
+
+// InvertPoseCovariance stores in dst the covariance of m.Inv(), given the
+// uncertainty cov of the rigid transform m. Since the inverse of
+// e.Exp().Mul4(m) is m.Inv().Mul4(e.Mul(-1).Exp()), this is
+// TransformCovariance(dst, m.Inv(), cov). It is not the matrix inverse of cov.
+func InvertPoseCovariance(dst *MatMxN, m Mat4, cov *MatMxN) *MatMxN {
+	return TransformCovariance(dst, m.Inv(), cov)
+}
@@ -0,0 +1,69 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "testing"
+
+// perturbationCov returns the covariance of a twist that is always e, e*e^T.
+func perturbationCov(e Twist) *MatMxN {
+	v := e.VecN(nil)
+	return v.OuterProd(nil, v)
+}
+
+func TestComposeCovariance(t *testing.T) {
+	t.Parallel()
+
+	m1 := Twist{Vec3{0.3, -0.2, 0.5}, Vec3{1, 2, -1}}.Exp()
+	m2 := Twist{Vec3{-1, 0.4, 0}, Vec3{0, -0.5, 2}}.Exp()
+	e := Twist{Vec3{1e-3, -2e-3, 5e-4}, Vec3{2e-3, 1e-3, -1e-3}}
+
+	// An exact error e on m2 becomes an exact error e' on m1 * m2.
+	composed := m1.Mul4(e.Exp()).Mul4(m2)
+	e2 := Mat4ToTwist(composed.Mul4(m1.Mul4(m2).Inv()))
+	zero := &MatMxN{}
+	zero.Zero(6, 6)
+	got := ComposeCovariance(nil, m1, zero, perturbationCov(e))
+	if want := perturbationCov(e2); !got.ApproxEqualFunc(want, absEqual(1e-7)) {
+		t.Errorf("ComposeCovariance of an error on m2 = %v, want %v", got.Raw(), want.Raw())
+	}
+
+	// The error of m1 carries over unchanged.
+	cov := perturbationCov(e)
+	if got := ComposeCovariance(nil, m1, cov, zero); !got.ApproxEqualFunc(cov, absEqual(1e-9)) {
+		t.Errorf("ComposeCovariance of an error on m1 = %v, want %v", got.Raw(), cov.Raw())
+	}
+
+	if got := ComposeCovariance(nil, m1, NewMatrix(3, 3), zero); got != nil {
+		t.Errorf("ComposeCovariance of a 3x3 covariance = %v, want nil", got)
+	}
+}
+
+func TestInvertPoseCovariance(t *testing.T) {
+	t.Parallel()
+
+	m := Twist{Vec3{0.3, -0.2, 0.5}, Vec3{1, 2, -1}}.Exp()
+	e := Twist{Vec3{1e-3, -2e-3, 5e-4}, Vec3{2e-3, 1e-3, -1e-3}}
+
+	inv := e.Exp().Mul4(m).Inv()
+	e2 := Mat4ToTwist(inv.Mul4(m))
+	got := InvertPoseCovariance(nil, m, perturbationCov(e))
+	if want := perturbationCov(e2); !got.ApproxEqualFunc(want, absEqual(1e-7)) {
+		t.Errorf("InvertPoseCovariance = %v, want %v", got.Raw(), want.Raw())
+	}
+
+	// Going back recovers the original covariance.
+	cov := NewMatrixFromData([]float32{
+		4, 1, 0, 0, 0, 0.5,
+		1, 3, 0, 0, 0, 0,
+		0, 0, 2, 0, 0, 0,
+		0, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 1, 0,
+		0.5, 0, 0, 0, 0, 1,
+	}, 6, 6)
+	back := InvertPoseCovariance(nil, m.Inv(), InvertPoseCovariance(nil, m, cov))
+	if !back.ApproxEqualFunc(cov, absEqual(1e-4)) {
+		t.Errorf("InvertPoseCovariance twice = %v, want %v", back.Raw(), cov.Raw())
+	}
+}
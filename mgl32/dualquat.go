@@ -0,0 +1,149 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// DualQuat is a dual quaternion Real + eps*Dual, with eps^2 = 0. Unit dual
+// quaternions represent rigid transforms: Real is the rotation and Dual
+// encodes the translation t as 0.5*t*Real, with t as a pure quaternion.
+type DualQuat struct {
+	Real, Dual Quat
+}
+
+// DualQuatFromRotationTranslation returns the unit dual quaternion of the
+// rigid transform rotating by the unit quaternion r, then translating by t.
+func DualQuatFromRotationTranslation(r Quat, t Vec3) DualQuat {
+	return DualQuat{r, Quat{0, t}.Mul(r).Scale(0.5)}
+}
+
+// Mat4ToDualQuat returns the unit dual quaternion of the rigid transform m.
+// Any scale or shear of m is lost.
+func Mat4ToDualQuat(m Mat4) DualQuat {
+	return DualQuatFromRotationTranslation(Mat4ToQuat(m).Normalize(), m.Col(3).Vec3())
+}
+
+// Translation returns the translation of the unit dual quaternion.
+func (dq DualQuat) Translation() Vec3 {
+	return dq.Dual.Mul(dq.Real.Conjugate()).V.Mul(2)
+}
+
+// TransformPoint applies the rigid transform of the unit dual quaternion to
+// the point p.
+func (dq DualQuat) TransformPoint(p Vec3) Vec3 {
+	return dq.Real.Rotate(p).Add(dq.Translation())
+}
+
+// DualQuatIdent returns the identity dual quaternion, the transform that
+// neither rotates nor translates.
+func DualQuatIdent() DualQuat {
+	return DualQuat{QuatIdent(), Quat{}}
+}
+
+// DualQuatFromTransform returns the unit dual quaternion of the rotation and
+// translation of t. Its scale is lost.
+func DualQuatFromTransform(t Transform) DualQuat {
+	return DualQuatFromRotationTranslation(t.Rotation.Normalize(), t.Translation)
+}
+
+// Add adds two dual quaternions component-wise.
+func (dq1 DualQuat) Add(dq2 DualQuat) DualQuat {
+	return DualQuat{dq1.Real.Add(dq2.Real), dq1.Dual.Add(dq2.Dual)}
+}
+
+// Scale multiplies both parts of the dual quaternion by c.
+func (dq DualQuat) Scale(c float32) DualQuat {
+	return DualQuat{dq.Real.Scale(c), dq.Dual.Scale(c)}
+}
+
+// Mul multiplies two dual quaternions. For unit dual quaternions this
+// composes their transforms: dq1.Mul(dq2) applies dq2 first, then dq1, like
+// the product of their matrices.
+func (dq1 DualQuat) Mul(dq2 DualQuat) DualQuat {
+	return DualQuat{dq1.Real.Mul(dq2.Real), dq1.Real.Mul(dq2.Dual).Add(dq1.Dual.Mul(dq2.Real))}
+}
+
+// Conjugate returns the dual quaternion with both parts conjugated. For a
+// unit dual quaternion this is the inverse transform.
+func (dq DualQuat) Conjugate() DualQuat {
+	return DualQuat{dq.Real.Conjugate(), dq.Dual.Conjugate()}
+}
+
+// Normalize returns the unit dual quaternion closest to dq: both parts are
+// divided by the length of Real, and the component of Dual along Real is
+// removed so that the result is a rigid transform. The zero dual quaternion
+// normalizes to the identity.
+func (dq DualQuat) Normalize() DualQuat {
+	l := dq.Real.Len()
+	if l == 0 {
+		return DualQuatIdent()
+	}
+	r, d := dq.Real.Scale(1/l), dq.Dual.Scale(1/l)
+	return DualQuat{r, d.Sub(r.Scale(r.Dot(d)))}
+}
+
+// Rotation returns the rotation of the unit dual quaternion.
+func (dq DualQuat) Rotation() Quat {
+	return dq.Real
+}
+
+// TransformVector applies the rotation of the unit dual quaternion to the
+// direction v, ignoring its translation.
+func (dq DualQuat) TransformVector(v Vec3) Vec3 {
+	return dq.Real.Rotate(v)
+}
+
+// Mat4 returns the homogeneous matrix of the rigid transform of the unit dual
+// quaternion.
+func (dq DualQuat) Mat4() Mat4 {
+	m := dq.Real.Mat4()
+	t := dq.Translation()
+	m[12], m[13], m[14] = t[0], t[1], t[2]
+	return m
+}
+
+// ApproxEqualThreshold returns whether the dual quaternions are approximately
+// equal, as if FloatEqualThreshold was called on each matching element.
+func (dq1 DualQuat) ApproxEqualThreshold(dq2 DualQuat, epsilon float32) bool {
+	return dq1.Real.ApproxEqualThreshold(dq2.Real, epsilon) && dq1.Dual.ApproxEqualThreshold(dq2.Dual, epsilon)
+}
+
+// ApproxEqualFunc returns whether the dual quaternions are approximately
+// equal using the given comparison function on each matching element.
+func (dq1 DualQuat) ApproxEqualFunc(dq2 DualQuat, f func(float32, float32) bool) bool {
+	return dq1.Real.ApproxEqualFunc(dq2.Real, f) && dq1.Dual.ApproxEqualFunc(dq2.Dual, f)
+}
+
+// DualQuatBlend blends the unit dual quaternions dqs with the given weights by
+// dual quaternion linear blending: their weighted sum normalized (Kavan et
+// al., "Skinning with Dual Quaternions", 2007). Each dual quaternion is
+// blended in the hemisphere of the first one with a non-zero weight, so that
+// the two representations of the same rotation don't cancel out. If all
+// weights are 0, the result is the identity. It panics if dqs and weights
+// don't have the same length.
+func DualQuatBlend(dqs []DualQuat, weights []float32) DualQuat {
+	if len(dqs) != len(weights) {
+		panic("Mismatched blend weight lengths")
+	}
+
+	var sum DualQuat
+	var pivot Quat
+	for i, dq := range dqs {
+		sum = dlbAdd(sum, &pivot, dq, weights[i])
+	}
+	return sum.Normalize()
+}
+
+// dlbAdd adds dq weighted by w to the blend sum, flipping it into the
+// hemisphere of pivot, which is set to the first influence.
+func dlbAdd(sum DualQuat, pivot *Quat, dq DualQuat, w float32) DualQuat {
+	if w == 0 {
+		return sum
+	}
+	if *pivot == (Quat{}) {
+		*pivot = dq.Real
+	} else if pivot.Dot(dq.Real) < 0 {
+		w = -w
+	}
+	return sum.Add(dq.Scale(w))
+}
@@ -0,0 +1,122 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDualQuatTransformPoint(t *testing.T) {
+	t.Parallel()
+
+	r := QuatRotate(1.2, Vec3{1, -1, 2}.Normalize())
+	tr := Vec3{3, -1, 0.5}
+	m := Translate3D(tr[0], tr[1], tr[2]).Mul4(r.Mat4())
+
+	for _, dq := range []DualQuat{DualQuatFromRotationTranslation(r, tr), Mat4ToDualQuat(m)} {
+		if got := dq.Translation(); !got.ApproxFuncEqual(tr, absEqual(1e-5)) {
+			t.Errorf("%v.Translation() = %v, want %v", dq, got, tr)
+		}
+		for _, p := range []Vec3{{}, {1, 2, 3}, {-4, 0.5, 1}} {
+			if got, want := dq.TransformPoint(p), TransformCoordinate(p, m); !got.ApproxFuncEqual(want, absEqual(1e-5)) {
+				t.Errorf("%v.TransformPoint(%v) = %v, want %v", dq, p, got, want)
+			}
+		}
+	}
+}
+
+func TestDualQuatMat4(t *testing.T) {
+	t.Parallel()
+
+	r := QuatRotate(0.8, Vec3{0, 1, 1}.Normalize())
+	tr := Vec3{-2, 4, 1}
+	dq := DualQuatFromRotationTranslation(r, tr)
+	want := Translate3D(tr[0], tr[1], tr[2]).Mul4(r.Mat4())
+
+	if got := dq.Mat4(); !got.ApproxFuncEqual(want, absEqual(1e-5)) {
+		t.Errorf("%v.Mat4() = %v, want %v", dq, got, want)
+	}
+	if got := Mat4ToDualQuat(dq.Mat4()); !got.Mat4().ApproxFuncEqual(want, absEqual(1e-5)) {
+		t.Errorf("Mat4ToDualQuat round trip = %v, want %v", got.Mat4(), want)
+	}
+	v := Vec3{1, 2, 3}
+	if got, want := dq.TransformVector(v), r.Rotate(v); got != want {
+		t.Errorf("%v.TransformVector(%v) = %v, want %v", dq, v, got, want)
+	}
+	if got := DualQuatIdent().Mat4(); got != Ident4() {
+		t.Errorf("DualQuatIdent().Mat4() = %v, want identity", got)
+	}
+}
+
+func TestDualQuatMul(t *testing.T) {
+	t.Parallel()
+
+	a := DualQuatFromRotationTranslation(QuatRotate(0.8, Vec3{0, 1, 0}), Vec3{1, 2, 3})
+	b := DualQuatFromRotationTranslation(QuatRotate(-1.3, Vec3{1, 0, 0}), Vec3{-3, 0, 2})
+	want := a.Mat4().Mul4(b.Mat4())
+
+	if got := a.Mul(b).Mat4(); !got.ApproxFuncEqual(want, absEqual(1e-5)) {
+		t.Errorf("a.Mul(b).Mat4() = %v, want %v", got, want)
+	}
+	if got := a.Mul(a.Conjugate()); !got.Mat4().ApproxFuncEqual(Ident4(), absEqual(1e-5)) {
+		t.Errorf("a.Mul(a.Conjugate()) = %v, want identity", got)
+	}
+}
+
+func TestDualQuatNormalize(t *testing.T) {
+	t.Parallel()
+
+	dq := DualQuatFromRotationTranslation(QuatRotate(0.5, Vec3{0, 0, 1}), Vec3{1, -1, 2})
+	// Scaled, and with a dual part that isn't orthogonal to the real part.
+	skewed := DualQuat{dq.Real.Scale(3), dq.Dual.Scale(3).Add(dq.Real.Scale(0.25))}
+
+	n := skewed.Normalize()
+	if !FloatEqualThreshold(n.Real.Len(), 1, 1e-6) {
+		t.Errorf("%v.Normalize() real part length = %v, want 1", skewed, n.Real.Len())
+	}
+	if d := n.Real.Dot(n.Dual); !absEqual(1e-6)(d, 0) {
+		t.Errorf("%v.Normalize() real and dual parts have dot product %v, want 0", skewed, d)
+	}
+	if !n.ApproxEqualFunc(dq, absEqual(1e-6)) {
+		t.Errorf("%v.Normalize() = %v, want %v", skewed, n, dq)
+	}
+	if got := (DualQuat{}).Normalize(); got != DualQuatIdent() {
+		t.Errorf("zero dual quaternion normalized to %v, want the identity", got)
+	}
+}
+
+func TestDualQuatBlend(t *testing.T) {
+	t.Parallel()
+
+	a := DualQuatFromRotationTranslation(QuatIdent(), Vec3{0, 0, 0})
+	b := DualQuatFromRotationTranslation(QuatRotate(math.Pi/2, Vec3{0, 0, 1}), Vec3{2, 0, 0})
+	// The other representation of b's transform must blend the same way.
+	bFlipped := b.Scale(-1)
+
+	for _, pair := range [][]DualQuat{{a, b}, {a, bFlipped}} {
+		got := DualQuatBlend(pair, []float32{0.5, 0.5})
+		if angle := 2 * math.Acos(float64(got.Real.W)); !FloatEqualThreshold(float32(angle), math.Pi/4, 1e-5) {
+			t.Errorf("DualQuatBlend(%v) rotates by %v, want Pi/4", pair, angle)
+		}
+		if !FloatEqualThreshold(got.Real.Len(), 1, 1e-6) {
+			t.Errorf("DualQuatBlend(%v) isn't normalized: %v", pair, got)
+		}
+	}
+
+	if got := DualQuatBlend([]DualQuat{a, b}, []float32{0, 1}); !got.ApproxEqualFunc(b, absEqual(1e-6)) {
+		t.Errorf("DualQuatBlend with a single influence = %v, want %v", got, b)
+	}
+	if got := DualQuatBlend(nil, nil); got != DualQuatIdent() {
+		t.Errorf("DualQuatBlend of nothing = %v, want the identity", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("DualQuatBlend with mismatched lengths didn't panic")
+		}
+	}()
+	DualQuatBlend([]DualQuat{a, b}, []float32{1})
+}
@@ -0,0 +1,116 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// eulerAxes holds the axes (0 for x, 1 for y and 2 for z) of the three
+// rotations of each RotationOrder.
+var eulerAxes = [...][3]int{
+	XYX: {0, 1, 0},
+	XYZ: {0, 1, 2},
+	XZX: {0, 2, 0},
+	XZY: {0, 2, 1},
+	YXY: {1, 0, 1},
+	YXZ: {1, 0, 2},
+	YZY: {1, 2, 1},
+	YZX: {1, 2, 0},
+	ZYZ: {2, 1, 2},
+	ZYX: {2, 1, 0},
+	ZXZ: {2, 0, 2},
+	ZXY: {2, 0, 1},
+}
+
+// eulerLockThreshold is the sine or cosine of the middle angle below which
+// Mat3.ToEuler takes it to be at gimbal lock, a few times the rounding error
+// of the scalar type. Above it, the last angle is still derived exactly from
+// the first, so the threshold only decides when the last angle is set to 0.
+var eulerLockThreshold = func() float64 {
+	if scalarBits == 32 {
+		return 4 * math.Ldexp(1, -23)
+	}
+	return 4 * math.Ldexp(1, -52)
+}()
+
+// ToEuler returns the angles that AnglesToQuat turns into the rotation q in
+// the given order, so that AnglesToQuat(q.ToEuler(order)) represents the same
+// orientation as q. See Mat3.ToEuler for their ranges and the handling of
+// gimbal lock. If the order is not a valid RotationOrder, this function will
+// panic.
+func (q Quat) ToEuler(order RotationOrder) (angle1, angle2, angle3 float32) {
+	return q.Normalize().Mat4().ToEuler(order)
+}
+
+// ToEuler returns the angles of the rotations about the axes of order whose
+// product is the rotation matrix m: for XYZ, m = Rotate3DX(angle1) *
+// Rotate3DY(angle2) * Rotate3DZ(angle3), matching AnglesToQuat. DCC tools
+// such as Blender and Maya name their rotation orders by the order in which
+// the rotations are applied, so their XYZ order is ZYX here.
+//
+// The first and last angles are in [-Pi, Pi]. The middle one is in
+// [-Pi/2, Pi/2] when the order has three different axes and in [0, Pi] when
+// the first and last axes are the same. At gimbal lock, where the middle angle
+// makes the first and last rotations about the same axis, only their sum (or
+// difference) is defined: the last angle is then 0 and the first one takes the
+// whole rotation. If the order is not a valid RotationOrder, this function
+// will panic.
+func (m Mat3) ToEuler(order RotationOrder) (angle1, angle2, angle3 float32) {
+	if order < 0 || int(order) >= len(eulerAxes) {
+		panic("Unsupported rotation order")
+	}
+	axes := eulerAxes[order]
+	i, j := axes[0], axes[1]
+	k := 3 - i - j
+	at := func(row, col int) float64 { return float64(m.At(row, col)) }
+
+	// e is 1 for the cyclic orders of the axes, like x,y,z, and -1 for the
+	// others, like z,y,x.
+	e := 1.0
+	if (j-i+3)%3 != 1 {
+		e = -1
+	}
+
+	// Near gimbal lock the entries giving the first and last angles are
+	// small and mostly rounding error, so only the first angle is taken from
+	// them. The last one is then that of the rotation left once the first is
+	// undone, whose entries stay well conditioned, so that the angles still
+	// give back m.
+	var a, b float64
+	if axes[2] == i {
+		sb := math.Hypot(at(i, j), at(i, k))
+		b = math.Atan2(sb, at(i, i))
+		if sb > eulerLockThreshold {
+			a = math.Atan2(at(j, i), -e*at(k, i))
+		} else {
+			return float32(math.Atan2(e*at(k, j), at(j, j))), float32(b), 0
+		}
+	} else {
+		cb := math.Hypot(at(i, i), at(i, j))
+		b = math.Atan2(e*at(i, k), cb)
+		if cb > eulerLockThreshold {
+			a = math.Atan2(-e*at(j, k), at(k, k))
+		} else {
+			return float32(math.Atan2(e*at(k, j), at(j, j))), float32(b), 0
+		}
+	}
+
+	// rest is the rotation about the middle axis, then about the last one.
+	first := [...]func(float32) Mat3{Rotate3DX, Rotate3DY, Rotate3DZ}[i](float32(a))
+	rest := first.Transpose().Mul3(m)
+	var c float64
+	if axes[2] == i {
+		c = math.Atan2(-e*float64(rest.At(j, k)), float64(rest.At(j, j)))
+	} else {
+		c = math.Atan2(e*float64(rest.At(j, i)), float64(rest.At(j, j)))
+	}
+
+	return float32(a), float32(b), float32(c)
+}
+
+// ToEuler returns the angles of the rotation in the upper 3x3 matrix of m, as
+// with Mat3.ToEuler. That matrix must be a pure rotation, without any scale.
+func (m Mat4) ToEuler(order RotationOrder) (angle1, angle2, angle3 float32) {
+	return m.Mat3().ToEuler(order)
+}
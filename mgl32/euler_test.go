@@ -0,0 +1,126 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var allRotationOrders = []RotationOrder{XYX, XYZ, XZX, XZY, YXY, YXZ, YZY, YZX, ZYZ, ZYX, ZXZ, ZXY}
+
+func TestQuatToEulerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	angles := [][3]float32{
+		{0.3, -0.7, 1.2},
+		{-2.5, 0.4, 3},
+		{1, 1.4, -0.2},
+		{0, 0, 0},
+	}
+
+	for _, order := range allRotationOrders {
+		proper := eulerAxes[order][0] == eulerAxes[order][2]
+		for _, a := range angles {
+			if proper {
+				// The middle angle is in [0, Pi] for these orders.
+				a[1] = Abs(a[1])
+			}
+			q := AnglesToQuat(a[0], a[1], a[2], order)
+			a1, a2, a3 := q.ToEuler(order)
+			if proper && a[1] == 0 {
+				// Gimbal lock, the first angle takes the sum.
+				a[0], a[2] = a[0]+a[2], 0
+			}
+			got := Vec3{a1, a2, a3}
+			if !got.ApproxFuncEqual(Vec3(a), absEqual(1e-5)) {
+				t.Errorf("order %d: ToEuler(AnglesToQuat(%v)) = %v", order, a, got)
+			}
+		}
+	}
+}
+
+func TestMat3ToEulerMatrixOrder(t *testing.T) {
+	t.Parallel()
+
+	m := Rotate3DX(0.5).Mul3(Rotate3DY(-0.3)).Mul3(Rotate3DZ(1.1))
+	if a1, a2, a3 := m.ToEuler(XYZ); !(Vec3{a1, a2, a3}).ApproxEqualThreshold(Vec3{0.5, -0.3, 1.1}, 1e-5) {
+		t.Errorf("ToEuler(XYZ) = %v, %v, %v, want 0.5, -0.3, 1.1", a1, a2, a3)
+	}
+
+	m4 := HomogRotate3DZ(-2).Mul4(HomogRotate3DX(1)).Mul4(HomogRotate3DZ(0.25))
+	if a1, a2, a3 := m4.ToEuler(ZXZ); !(Vec3{a1, a2, a3}).ApproxEqualThreshold(Vec3{-2, 1, 0.25}, 1e-5) {
+		t.Errorf("Mat4 ToEuler(ZXZ) = %v, %v, %v, want -2, 1, 0.25", a1, a2, a3)
+	}
+}
+
+func TestToEulerGimbalLock(t *testing.T) {
+	t.Parallel()
+
+	for _, order := range allRotationOrders {
+		middle := []float32{math.Pi / 2, -math.Pi / 2}
+		if eulerAxes[order][0] == eulerAxes[order][2] {
+			middle = []float32{0, math.Pi}
+		}
+		for _, b := range middle {
+			q := AnglesToQuat(0.4, b, 0.9, order)
+			a1, a2, a3 := q.ToEuler(order)
+			if a3 != 0 || !FloatEqualThreshold(a2, b, 1e-3) {
+				t.Errorf("order %d, middle angle %v: ToEuler = %v, %v, %v, want a 0 last angle", order, b, a1, a2, a3)
+			}
+			if back := AnglesToQuat(a1, a2, a3, order); !back.OrientationEqualThreshold(q, 1e-5) {
+				t.Errorf("order %d, middle angle %v: ToEuler = %v, %v, %v doesn't give back %v", order, b, a1, a2, a3, q)
+			}
+		}
+	}
+}
+
+func TestToEulerNearGimbalLock(t *testing.T) {
+	t.Parallel()
+
+	// The angle between the rotations, close to the precision of the scalar.
+	tolerance := 1e-5
+	if scalarBits == 64 {
+		tolerance = 1e-12
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, order := range allRotationOrders {
+		proper := eulerAxes[order][0] == eulerAxes[order][2]
+		for n := 0; n < 200; n++ {
+			// Within about 1e-3 of either gimbal lock, on either side.
+			b := float32(r.NormFloat64() * math.Pow(10, -3-6*r.Float64()))
+			switch {
+			case proper && n%2 == 0:
+				b = Abs(b)
+			case proper:
+				b = math.Pi - Abs(b)
+			case n%2 == 0:
+				b += math.Pi / 2
+			default:
+				b -= math.Pi / 2
+			}
+			q := AnglesToQuat(float32(r.Float64()*6-3), b, float32(r.Float64()*6-3), order)
+			a1, a2, a3 := q.ToEuler(order)
+			if back := AnglesToQuat(a1, a2, a3, order); rotationBetween(back, q) > tolerance {
+				t.Errorf("order %d: ToEuler(%v) = %v, %v, %v gives back %v", order, q, a1, a2, a3, back)
+			}
+		}
+	}
+
+	for _, c := range []struct {
+		Order  RotationOrder
+		Angles Vec3
+	}{
+		{XYZ, Vec3{2.2168, 1.5708002, 2.7785}},
+		{XYX, Vec3{0.3, math.Pi + 9e-7, -1.2}},
+	} {
+		q := AnglesToQuat(c.Angles[0], c.Angles[1], c.Angles[2], c.Order)
+		if a1, a2, a3 := q.ToEuler(c.Order); rotationBetween(AnglesToQuat(a1, a2, a3, c.Order), q) > tolerance {
+			t.Errorf("order %d: ToEuler(AnglesToQuat(%v)) = %v, %v, %v", c.Order, c.Angles, a1, a2, a3)
+		}
+	}
+}
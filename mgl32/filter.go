@@ -0,0 +1,152 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// The filters in this file are functions of the distance x between a sample
+// and the position being reconstructed, in units of the sample spacing. They
+// are separable: a 2D kernel is the product of the filter along x and y.
+
+// FilterGaussian evaluates the Gaussian exp(-x^2 / (2 sigma^2)). It is not
+// normalized; FilterKernel and FilterWeights normalize the weights they
+// return. A radius of 3*sigma holds all but 0.3% of its weight.
+func FilterGaussian(x, sigma float32) float32 {
+	xs := float64(x) / float64(sigma)
+	return float32(math.Exp(-0.5 * xs * xs))
+}
+
+// FilterMitchell evaluates the Mitchell-Netravali cubic filter with
+// parameters b and c, which is zero beyond a radius of 2. b = c = 1/3 is the
+// compromise recommended by Mitchell and Netravali, b = 0, c = 0.5 is the
+// Catmull-Rom spline and b = 1, c = 0 the cubic B-spline.
+func FilterMitchell(x, b, c float32) float32 {
+	ax := math.Abs(float64(x))
+	B, C := float64(b), float64(c)
+	switch {
+	case ax < 1:
+		return float32(((12-9*B-6*C)*ax*ax*ax + (-18+12*B+6*C)*ax*ax + (6 - 2*B)) / 6)
+	case ax < 2:
+		return float32(((-B-6*C)*ax*ax*ax + (6*B+30*C)*ax*ax + (-12*B-48*C)*ax + (8*B + 24*C)) / 6)
+	default:
+		return 0
+	}
+}
+
+// FilterLanczos evaluates the Lanczos filter of radius a, sinc(x) *
+// sinc(x/a) for |x| < a and 0 beyond. a = 2 or 3 are the usual choices.
+func FilterLanczos(x float32, a int) float32 {
+	ax := math.Abs(float64(x))
+	if ax >= float64(a) {
+		return 0
+	}
+	return float32(sinc(ax) * sinc(ax/float64(a)))
+}
+
+// sinc returns the normalized sinc function sin(pi x)/(pi x).
+func sinc(x float64) float64 {
+	if x < 1e-4 {
+		px := math.Pi * x
+		return 1 - px*px/6
+	}
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
+
+// FilterKernel returns the 2*radius+1 weights of filter at the taps -radius to
+// radius, normalized to sum to 1, as for convolving an image. For example, a
+// 7 tap Gaussian blur is
+//
+//	FilterKernel(func(x float32) float32 { return FilterGaussian(x, 1) }, 3)
+func FilterKernel(filter func(x float32) float32, radius int) []float32 {
+	weights := make([]float32, 2*radius+1)
+	for i := range weights {
+		weights[i] = filter(float32(i - radius))
+	}
+	normalizeWeights(weights)
+	return weights
+}
+
+// FilterWeights returns the weights of the source samples contributing to
+// a destination sample at position center, for resampling by the filter of
+// the given radius: the weights of the samples at integer positions first,
+// first+1, ..., normalized to sum to 1. With source samples at pixel centers
+// i+0.5, destination pixel j of an image resized by the factor
+// dstSize/srcSize is at center = (j+0.5)*srcSize/dstSize - 0.5.
+//
+// When minifying, scale is the ratio srcSize/dstSize > 1, which widens the
+// filter to avoid aliasing; otherwise it should be 1.
+func FilterWeights(filter func(x float32) float32, radius, center, scale float32) (first int, weights []float32) {
+	if scale < 1 {
+		scale = 1
+	}
+	support := float64(radius) * float64(scale)
+	first = int(math.Ceil(float64(center) - support))
+	last := int(math.Floor(float64(center) + support))
+
+	weights = make([]float32, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		weights = append(weights, filter((float32(i)-center)/scale))
+	}
+
+	// Trim the zero weights at the ends of the support.
+	for len(weights) > 0 && weights[0] == 0 {
+		weights = weights[1:]
+		first++
+	}
+	for len(weights) > 0 && weights[len(weights)-1] == 0 {
+		weights = weights[:len(weights)-1]
+	}
+
+	normalizeWeights(weights)
+	return first, weights
+}
+
+// normalizeWeights scales the weights to sum to 1, unless they sum to 0.
+func normalizeWeights(weights []float32) {
+	var sum float64
+	for _, w := range weights {
+		sum += float64(w)
+	}
+	if sum == 0 {
+		return
+	}
+	for i := range weights {
+		weights[i] = float32(float64(weights[i]) / sum)
+	}
+}
+
+// QuincunxOffsets returns the quincunx sample pattern, the center of a pixel
+// and its four corners, as offsets from the pixel center in pixels. The
+// corners are shared with the neighboring pixels, which is why quincunx
+// resolves weight the center by 1/2 and each corner by 1/8.
+func QuincunxOffsets() []Vec2 {
+	return []Vec2{{0, 0}, {-0.5, -0.5}, {0.5, -0.5}, {-0.5, 0.5}, {0.5, 0.5}}
+}
+
+// RotatedGridOffsets returns the n*n sample offsets, from the pixel center in
+// pixels, of an n by n grid rotated by atan(1/n) and scaled to fit the pixel.
+// No two samples share a row or column of the n*n finer grid, so near
+// horizontal and vertical edges get n*n levels of coverage instead of n.
+// RotatedGridOffsets(2) is the classic 4x RGSS pattern
+// {1/8, 3/8}, {3/8, -1/8}, {-1/8, -3/8}, {-3/8, 1/8}, in another order.
+func RotatedGridOffsets(n int) []Vec2 {
+	if n < 1 {
+		return nil
+	}
+
+	nn := float32(n * n)
+	offsets := make([]Vec2, 0, n*n)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			offsets = append(offsets, Vec2{
+				(float32(n*i-j+n-1)+0.5)/nn - 0.5,
+				(float32(i+n*j)+0.5)/nn - 0.5,
+			})
+		}
+	}
+	return offsets
+}
@@ -0,0 +1,136 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		got  float32
+		want float32
+	}{
+		{"FilterGaussian(0, 2)", FilterGaussian(0, 2), 1},
+		{"FilterGaussian(2, 2)", FilterGaussian(2, 2), float32(math.Exp(-0.5))},
+		{"FilterMitchell(0, 1/3, 1/3)", FilterMitchell(0, 1.0/3, 1.0/3), 8.0 / 9},
+		{"FilterMitchell(1, 1/3, 1/3)", FilterMitchell(1, 1.0/3, 1.0/3), 1.0 / 18},
+		{"FilterMitchell(-2, 1/3, 1/3)", FilterMitchell(-2, 1.0/3, 1.0/3), 0},
+		{"FilterMitchell(0, 0, 0.5)", FilterMitchell(0, 0, 0.5), 1},
+		{"FilterMitchell(1, 0, 0.5)", FilterMitchell(1, 0, 0.5), 0},
+		{"FilterMitchell(0.5, 0, 0.5)", FilterMitchell(0.5, 0, 0.5), 0.5625},
+		{"FilterLanczos(0, 3)", FilterLanczos(0, 3), 1},
+		{"FilterLanczos(1, 3)", FilterLanczos(1, 3), 0},
+		{"FilterLanczos(-2, 2)", FilterLanczos(-2, 2), 0},
+		{"FilterLanczos(0.5, 2)", FilterLanczos(0.5, 2), float32(2 / math.Pi * math.Sqrt2 / (math.Pi / 2))},
+	}
+
+	for _, test := range tests {
+		if Abs(test.got-test.want) > 1e-6 {
+			t.Errorf("%s = %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestFilterKernel(t *testing.T) {
+	t.Parallel()
+
+	k := FilterKernel(func(x float32) float32 { return FilterGaussian(x, 1) }, 3)
+	if len(k) != 7 {
+		t.Fatalf("FilterKernel returned %d weights, want 7", len(k))
+	}
+	var sum float32
+	for i, w := range k {
+		sum += w
+		if w != k[len(k)-1-i] {
+			t.Errorf("FilterKernel is not symmetric: %v", k)
+		}
+	}
+	if !FloatEqualThreshold(sum, 1, 1e-6) || k[3] < k[2] || k[2] < k[1] {
+		t.Errorf("FilterKernel = %v", k)
+	}
+}
+
+func TestFilterWeights(t *testing.T) {
+	t.Parallel()
+
+	mitchell := func(x float32) float32 { return FilterMitchell(x, 1.0/3, 1.0/3) }
+	tests := []struct {
+		center, scale float32
+		first, n      int
+	}{
+		{2, 1, 1, 3},   // the taps at 0 and 4 have zero weight
+		{2.5, 1, 1, 4}, // 1, 2, 3, 4
+		{2.5, 2, -1, 8},
+		{10.25, 0.5, 9, 4}, // scales below 1 don't shrink the filter
+	}
+
+	for _, test := range tests {
+		first, w := FilterWeights(mitchell, 2, test.center, test.scale)
+		if first != test.first || len(w) != test.n {
+			t.Errorf("FilterWeights(%v, %v) starts at %d with %d weights, want %d and %d", test.center, test.scale, first, len(w), test.first, test.n)
+		}
+		var sum, mean float32
+		for i, wi := range w {
+			sum += wi
+			mean += wi * float32(first+i)
+		}
+		if !FloatEqualThreshold(sum, 1, 1e-6) {
+			t.Errorf("FilterWeights(%v, %v) sum to %v", test.center, test.scale, sum)
+		}
+		if !FloatEqualThreshold(mean, test.center, 1e-5) {
+			t.Errorf("FilterWeights(%v, %v) are centered on %v", test.center, test.scale, mean)
+		}
+	}
+
+	// Lanczos interpolates: at a sample, the other weights vanish.
+	first, w := FilterWeights(func(x float32) float32 { return FilterLanczos(x, 3) }, 3, 4, 1)
+	for i, wi := range w {
+		want := float32(0)
+		if first+i == 4 {
+			want = 1
+		}
+		if Abs(wi-want) > 1e-6 {
+			t.Errorf("FilterWeights of Lanczos at sample 4 = %d, %v", first, w)
+			break
+		}
+	}
+}
+
+func TestSampleOffsets(t *testing.T) {
+	t.Parallel()
+
+	if q := QuincunxOffsets(); len(q) != 5 || q[0] != (Vec2{}) {
+		t.Errorf("QuincunxOffsets() = %v", q)
+	}
+
+	rgss := RotatedGridOffsets(2)
+	want := map[Vec2]bool{{1.0 / 8, 3.0 / 8}: true, {3.0 / 8, -1.0 / 8}: true, {-1.0 / 8, -3.0 / 8}: true, {-3.0 / 8, 1.0 / 8}: true}
+	for _, o := range rgss {
+		if !want[o] {
+			t.Errorf("RotatedGridOffsets(2) = %v, want the RGSS pattern", rgss)
+			break
+		}
+	}
+
+	// Every sample of larger patterns lies in its own row and column.
+	for _, n := range []int{1, 3, 4} {
+		offsets := RotatedGridOffsets(n)
+		xs, ys := map[float32]bool{}, map[float32]bool{}
+		for _, o := range offsets {
+			if o[0] <= -0.5 || o[0] >= 0.5 || o[1] <= -0.5 || o[1] >= 0.5 {
+				t.Errorf("RotatedGridOffsets(%d) has %v outside the pixel", n, o)
+			}
+			xs[o[0]], ys[o[1]] = true, true
+		}
+		if len(offsets) != n*n || len(xs) != n*n || len(ys) != n*n {
+			t.Errorf("RotatedGridOffsets(%d) = %v", n, offsets)
+		}
+	}
+}
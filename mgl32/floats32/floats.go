@@ -0,0 +1,163 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package floats[32|64] implements elementwise and reduction operations over
+// raw float slices. It is the kernel layer shared by the arbitrary size types
+// of the corresponding mgl package (VecN and MatMxN), and is meant for any code
+// keeping its data in structure of arrays form.
+//
+// The kernels are written in pure Go, unrolled so that the compiler can
+// eliminate bounds checks and keep several independent operations in flight;
+// they are the place to add assembly implementations for specific
+// architectures without affecting callers.
+//
+// Functions taking several slices panic if their lengths differ. The
+// destination of the elementwise functions may be the same slice as one of
+// their operands.
+package floats32
+
+import (
+	"math"
+)
+
+func checkLen(a, b int) {
+	if a != b {
+		panic("mismatched slice lengths")
+	}
+}
+
+// Add sets dst[i] = a[i] + b[i].
+func Add(dst, a, b []float32) {
+	checkLen(len(dst), len(a))
+	checkLen(len(dst), len(b))
+	a, b = a[:len(dst)], b[:len(dst)]
+
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = a[i] + b[i]
+		dst[i+1] = a[i+1] + b[i+1]
+		dst[i+2] = a[i+2] + b[i+2]
+		dst[i+3] = a[i+3] + b[i+3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = a[i] + b[i]
+	}
+}
+
+// Sub sets dst[i] = a[i] - b[i].
+func Sub(dst, a, b []float32) {
+	checkLen(len(dst), len(a))
+	checkLen(len(dst), len(b))
+	a, b = a[:len(dst)], b[:len(dst)]
+
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = a[i] - b[i]
+		dst[i+1] = a[i+1] - b[i+1]
+		dst[i+2] = a[i+2] - b[i+2]
+		dst[i+3] = a[i+3] - b[i+3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = a[i] - b[i]
+	}
+}
+
+// Mul sets dst[i] = a[i] * b[i], the elementwise (Hadamard) product.
+func Mul(dst, a, b []float32) {
+	checkLen(len(dst), len(a))
+	checkLen(len(dst), len(b))
+	a, b = a[:len(dst)], b[:len(dst)]
+
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = a[i] * b[i]
+		dst[i+1] = a[i+1] * b[i+1]
+		dst[i+2] = a[i+2] * b[i+2]
+		dst[i+3] = a[i+3] * b[i+3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = a[i] * b[i]
+	}
+}
+
+// Scale sets dst[i] = c * a[i].
+func Scale(dst, a []float32, c float32) {
+	checkLen(len(dst), len(a))
+	a = a[:len(dst)]
+
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = c * a[i]
+		dst[i+1] = c * a[i+1]
+		dst[i+2] = c * a[i+2]
+		dst[i+3] = c * a[i+3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = c * a[i]
+	}
+}
+
+// AXPY adds alpha*x to y, that is y[i] += alpha * x[i], like the BLAS
+// routine of the same name.
+func AXPY(alpha float32, x, y []float32) {
+	checkLen(len(x), len(y))
+	x = x[:len(y)]
+
+	i := 0
+	for ; i+4 <= len(y); i += 4 {
+		y[i] += alpha * x[i]
+		y[i+1] += alpha * x[i+1]
+		y[i+2] += alpha * x[i+2]
+		y[i+3] += alpha * x[i+3]
+	}
+	for ; i < len(y); i++ {
+		y[i] += alpha * x[i]
+	}
+}
+
+// Dot returns the dot product of a and b, the sum of a[i] * b[i].
+//
+// The products are summed in several independent accumulators, so the result
+// can differ in the last bits from a plain sequential sum.
+func Dot(a, b []float32) float32 {
+	checkLen(len(a), len(b))
+	b = b[:len(a)]
+
+	var s0, s1, s2, s3 float32
+	i := 0
+	for ; i+4 <= len(a); i += 4 {
+		s0 += a[i] * b[i]
+		s1 += a[i+1] * b[i+1]
+		s2 += a[i+2] * b[i+2]
+		s3 += a[i+3] * b[i+3]
+	}
+	for ; i < len(a); i++ {
+		s0 += a[i] * b[i]
+	}
+	return (s0 + s1) + (s2 + s3)
+}
+
+// Min returns the smallest element of a, or NaN if a is empty. NaN elements
+// are ignored unless all elements are NaN.
+func Min(a []float32) float32 {
+	min := float32(math.NaN())
+	for _, x := range a {
+		if x < min || min != min {
+			min = x
+		}
+	}
+	return min
+}
+
+// Max returns the largest element of a, or NaN if a is empty. NaN elements
+// are ignored unless all elements are NaN.
+func Max(a []float32) float32 {
+	max := float32(math.NaN())
+	for _, x := range a {
+		if x > max || max != max {
+			max = x
+		}
+	}
+	return max
+}
@@ -0,0 +1,111 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats32
+
+import (
+	"math"
+	"testing"
+)
+
+func equal(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestElementwise(t *testing.T) {
+	t.Parallel()
+
+	// Seven elements, to exercise both the unrolled loop and the tail.
+	a := []float32{1, 2, 3, 4, 5, 6, 7}
+	b := []float32{7, 6, 5, 4, 3, 2, 1}
+	dst := make([]float32, len(a))
+
+	tests := []struct {
+		Name string
+		Op   func()
+		Want []float32
+	}{
+		{"Add", func() { Add(dst, a, b) }, []float32{8, 8, 8, 8, 8, 8, 8}},
+		{"Sub", func() { Sub(dst, a, b) }, []float32{-6, -4, -2, 0, 2, 4, 6}},
+		{"Mul", func() { Mul(dst, a, b) }, []float32{7, 12, 15, 16, 15, 12, 7}},
+		{"Scale", func() { Scale(dst, a, 2) }, []float32{2, 4, 6, 8, 10, 12, 14}},
+	}
+
+	for _, c := range tests {
+		c.Op()
+		if !equal(dst, c.Want) {
+			t.Errorf("%s = %v, expected %v", c.Name, dst, c.Want)
+		}
+	}
+
+	// In place
+	y := []float32{1, 1, 1, 1, 1, 1, 1}
+	AXPY(0.5, a, y)
+	if want := []float32{1.5, 2, 2.5, 3, 3.5, 4, 4.5}; !equal(y, want) {
+		t.Errorf("AXPY = %v, expected %v", y, want)
+	}
+	Add(y, y, y)
+	if want := []float32{3, 4, 5, 6, 7, 8, 9}; !equal(y, want) {
+		t.Errorf("Add in place = %v, expected %v", y, want)
+	}
+}
+
+func TestReductions(t *testing.T) {
+	t.Parallel()
+
+	a := []float32{1, 2, 3, 4, 5, 6, 7}
+	b := []float32{7, 6, 5, 4, 3, 2, 1}
+	if d := Dot(a, b); d != 84 {
+		t.Errorf("Dot = %v, expected 84", d)
+	}
+
+	nan := float32(math.NaN())
+	tests := []struct {
+		In       []float32
+		Min, Max float32
+	}{
+		{[]float32{3, -1, 4, 1, -5}, -5, 4},
+		{[]float32{nan, 2, nan, 1}, 1, 2},
+		{[]float32{42}, 42, 42},
+	}
+	for _, c := range tests {
+		if min, max := Min(c.In), Max(c.In); min != c.Min || max != c.Max {
+			t.Errorf("Min, Max of %v = %v, %v, expected %v, %v", c.In, min, max, c.Min, c.Max)
+		}
+	}
+	if min, max := Min(nil), Max(nil); min == min || max == max {
+		t.Errorf("Min, Max of an empty slice = %v, %v, expected NaN", min, max)
+	}
+}
+
+func TestMismatchedLengths(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Add with mismatched lengths did not panic")
+		}
+	}()
+	Add(make([]float32, 3), make([]float32, 3), make([]float32, 2))
+}
+
+func BenchmarkDot(b *testing.B) {
+	x, y := make([]float32, 1024), make([]float32, 1024)
+	for i := range x {
+		x[i], y[i] = float32(i), float32(len(x)-i)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = Dot(x, y)
+	}
+}
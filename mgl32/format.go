@@ -0,0 +1,189 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Matrix is implemented by every matrix type of this package, including
+// *MatMxN.
+type Matrix interface {
+	NumRows() int
+	NumCols() int
+	At(row, col int) float32
+}
+
+// Alignment is the alignment of the columns printed by FormatMatrix.
+type Alignment int
+
+// Column alignments.
+const (
+	AlignRight Alignment = iota
+	AlignLeft
+	// AlignDecimal lines up the decimal points of a column.
+	AlignDecimal
+)
+
+// FormatOptions controls the layout of FormatMatrix.
+type FormatOptions struct {
+	// ColumnMajor prints each column of the matrix on a line, in the order
+	// of its elements in memory, instead of each row.
+	ColumnMajor bool
+	// Precision is the number of digits after the decimal point. If it is
+	// negative, each element uses the fewest digits that represent it
+	// exactly.
+	Precision int
+	// Scientific prints the elements in scientific notation, -1.234560e+03.
+	Scientific bool
+	// Align is the alignment of the elements within their column.
+	Align Alignment
+}
+
+// DefaultFormat lays out matrices like their String methods: a row per line,
+// six digits after the decimal point, aligned right.
+var DefaultFormat = FormatOptions{Precision: 6}
+
+var scalarBits = reflect.TypeOf(float32(0)).Bits()
+
+// FormatMatrix returns m laid out as text according to opts, one line per row
+// (or column), each ending with a newline.
+func FormatMatrix(m Matrix, opts FormatOptions) string {
+	return formatTable(matrixCells(m, opts, nil), opts.Align)
+}
+
+// FormatMatrixDiff returns got laid out like FormatMatrix, with every element
+// differing from the same element of want by more than epsilon marked with a
+// '*', followed by a line for each such element giving its row and column,
+// both values and their difference. It is meant for reporting test failures.
+// If the matrices don't have the same size, both are printed in full.
+func FormatMatrixDiff(want, got Matrix, epsilon float32, opts FormatOptions) string {
+	if want.NumRows() != got.NumRows() || want.NumCols() != got.NumCols() {
+		return fmt.Sprintf("size mismatch: want %dx%d, got %dx%d\nwant:\n%sgot:\n%s",
+			want.NumRows(), want.NumCols(), got.NumRows(), got.NumCols(),
+			FormatMatrix(want, opts), FormatMatrix(got, opts))
+	}
+
+	differs := func(row, col int) bool {
+		a, b := want.At(row, col), got.At(row, col)
+		return a != b && !(Abs(a-b) <= epsilon)
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString(formatTable(matrixCells(got, opts, differs), opts.Align))
+	for row := 0; row < got.NumRows(); row++ {
+		for col := 0; col < got.NumCols(); col++ {
+			if differs(row, col) {
+				a, b := want.At(row, col), got.At(row, col)
+				fmt.Fprintf(buf, "(%d, %d): want %s, got %s, diff %s\n", row, col,
+					formatScalar(a, opts), formatScalar(b, opts), formatScalar(b-a, opts))
+			}
+		}
+	}
+	return buf.String()
+}
+
+// matrixCells formats the elements of m into lines of cells, prefixed with a
+// '*' where marked returns true, if it isn't nil.
+func matrixCells(m Matrix, opts FormatOptions, marked func(row, col int) bool) [][]string {
+	lines, cells := m.NumRows(), m.NumCols()
+	if opts.ColumnMajor {
+		lines, cells = cells, lines
+	}
+
+	table := make([][]string, lines)
+	for i := range table {
+		table[i] = make([]string, cells)
+		for j := range table[i] {
+			row, col := i, j
+			if opts.ColumnMajor {
+				row, col = j, i
+			}
+			s := formatScalar(m.At(row, col), opts)
+			if marked != nil {
+				if marked(row, col) {
+					s = "*" + s
+				} else {
+					s = " " + s
+				}
+			}
+			table[i][j] = s
+		}
+	}
+	return table
+}
+
+func formatScalar(v float32, opts FormatOptions) string {
+	verb := byte('f')
+	if opts.Scientific {
+		verb = 'e'
+	}
+	if math.IsInf(float64(v), 0) || math.IsNaN(float64(v)) {
+		return fmt.Sprint(v)
+	}
+	return strconv.FormatFloat(float64(v), verb, opts.Precision, scalarBits)
+}
+
+// formatTable lays out the cells in columns separated by a space.
+func formatTable(table [][]string, align Alignment) string {
+	if len(table) == 0 {
+		return ""
+	}
+
+	// The widths of the columns, and for AlignDecimal of the parts on either
+	// side of the point.
+	n := len(table[0])
+	width, intWidth, fracWidth := make([]int, n), make([]int, n), make([]int, n)
+	for _, line := range table {
+		for j, s := range line {
+			if len(s) > width[j] {
+				width[j] = len(s)
+			}
+			i, f := splitDecimal(s)
+			if len(i) > intWidth[j] {
+				intWidth[j] = len(i)
+			}
+			if len(f) > fracWidth[j] {
+				fracWidth[j] = len(f)
+			}
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	for _, line := range table {
+		for j, s := range line {
+			if j > 0 {
+				buf.WriteByte(' ')
+			}
+			i, f := splitDecimal(s)
+			switch align {
+			case AlignLeft:
+				s += strings.Repeat(" ", width[j]-len(s))
+			case AlignDecimal:
+				s = strings.Repeat(" ", intWidth[j]-len(i)) + s + strings.Repeat(" ", fracWidth[j]-len(f))
+			default:
+				s = strings.Repeat(" ", width[j]-len(s)) + s
+			}
+			buf.WriteString(s)
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// splitDecimal splits the formatted number s before its decimal point, or
+// before its exponent if it has none.
+func splitDecimal(s string) (intPart, fracPart string) {
+	i := strings.IndexAny(s, ".e")
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], s[i:]
+}
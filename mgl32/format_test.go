@@ -0,0 +1,87 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestFormatMatrix(t *testing.T) {
+	t.Parallel()
+
+	m := Mat2x3{1, -20.5, 0.25, 3, 100, 0.125}
+	tests := []struct {
+		opts FormatOptions
+		want string
+	}{
+		{DefaultFormat, "" +
+			"  1.000000 0.250000 100.000000\n" +
+			"-20.500000 3.000000   0.125000\n"},
+		{FormatOptions{Precision: 1}, "" +
+			"  1.0 0.2 100.0\n" +
+			"-20.5 3.0   0.1\n"},
+		{FormatOptions{Precision: -1, Align: AlignDecimal}, "" +
+			"  1   0.25 100    \n" +
+			"-20.5 3      0.125\n"},
+		{FormatOptions{Precision: -1, Align: AlignLeft}, "" +
+			"1     0.25 100  \n" +
+			"-20.5 3    0.125\n"},
+		{FormatOptions{Precision: -1, ColumnMajor: true}, "" +
+			"   1 -20.5\n" +
+			"0.25     3\n" +
+			" 100 0.125\n"},
+		{FormatOptions{Precision: 2, Scientific: true}, "" +
+			" 1.00e+00 2.50e-01 1.00e+02\n" +
+			"-2.05e+01 3.00e+00 1.25e-01\n"},
+	}
+
+	for _, test := range tests {
+		if got := FormatMatrix(m, test.opts); got != test.want {
+			t.Errorf("FormatMatrix(%+v) =\n%s, want\n%s", test.opts, got, test.want)
+		}
+	}
+
+	// All matrix types work, including MatMxN.
+	if got, want := FormatMatrix(NewMatrixFromData([]float32{1, 2, 3, 4}, 2, 2), FormatOptions{}), "1 3\n2 4\n"; got != want {
+		t.Errorf("FormatMatrix(MatMxN) = %q, want %q", got, want)
+	}
+	if got := FormatMatrix(Ident4(), DefaultFormat); strings.Count(got, "\n") != 4 {
+		t.Errorf("FormatMatrix(Ident4()) = %q", got)
+	}
+	inf := Mat2{float32(math.Inf(1)), 0, 0, 1}
+	if got, want := FormatMatrix(inf, FormatOptions{}), "+Inf 0\n   0 1\n"; got != want {
+		t.Errorf("FormatMatrix with Inf = %q, want %q", got, want)
+	}
+}
+
+func TestFormatMatrixDiff(t *testing.T) {
+	t.Parallel()
+
+	want := Mat2{1, 2, 3, 4}
+	got := Mat2{1, 2.5, 3.0001, 4}
+	diff := FormatMatrixDiff(want, got, 1e-3, FormatOptions{Precision: 2})
+	wantDiff := "" +
+		" 1.00  3.00\n" +
+		"*2.50  4.00\n" +
+		"(1, 0): want 2.00, got 2.50, diff 0.50\n"
+	if diff != wantDiff {
+		t.Errorf("FormatMatrixDiff =\n%s, want\n%s", diff, wantDiff)
+	}
+
+	if diff := FormatMatrixDiff(want, want, 0, DefaultFormat); strings.Contains(diff, "*") || strings.Contains(diff, "want") {
+		t.Errorf("FormatMatrixDiff of equal matrices =\n%s", diff)
+	}
+
+	nan := Mat2{float32(math.NaN()), 2, 3, 4}
+	if diff := FormatMatrixDiff(want, nan, 1, DefaultFormat); !strings.Contains(diff, "*NaN") {
+		t.Errorf("FormatMatrixDiff doesn't mark NaN:\n%s", diff)
+	}
+
+	if diff := FormatMatrixDiff(want, Ident3(), 0, DefaultFormat); !strings.HasPrefix(diff, "size mismatch: want 2x2, got 3x3\n") {
+		t.Errorf("FormatMatrixDiff of different sizes =\n%s", diff)
+	}
+}
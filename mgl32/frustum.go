@@ -0,0 +1,99 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// FrustumPlanes holds the six planes bounding a view frustum, in the order
+// left, right, bottom, top, near and far. The planes are normalized and face
+// inwards, so a point is inside the frustum when its PlaneDistance to every
+// plane is non-negative. It's named this way because Frustum is the
+// projection matrix function.
+type FrustumPlanes [6]Vec4
+
+// Indices of the planes of FrustumPlanes.
+const (
+	FrustumLeft = iota
+	FrustumRight
+	FrustumBottom
+	FrustumTop
+	FrustumNear
+	FrustumFar
+)
+
+// FrustumPlanesFromMatrix extracts the planes of the frustum of the
+// projection times the view matrix viewProj, in world space, with the method
+// of Gribb and Hartmann, "Fast Extraction of Viewing Frustum Planes from the
+// World-View-Projection Matrix". Each plane is a sum or difference of the
+// rows of viewProj, which is column-major. Pass a projection alone to get the
+// planes in eye space, or a full model-view-projection for object space.
+//
+// The clip volume is the OpenGL one, with -w <= z <= w. Use
+// ClipConventions.FrustumPlanes for matrices of other conventions.
+func FrustumPlanesFromMatrix(viewProj Mat4) FrustumPlanes {
+	x, y, z, w := viewProj.Row(0), viewProj.Row(1), viewProj.Row(2), viewProj.Row(3)
+	return FrustumPlanes{
+		NormalizePlane(w.Add(x)),
+		NormalizePlane(w.Sub(x)),
+		NormalizePlane(w.Add(y)),
+		NormalizePlane(w.Sub(y)),
+		NormalizePlane(w.Add(z)),
+		NormalizePlane(w.Sub(z)),
+	}
+}
+
+// FrustumPlanes extracts the planes of the frustum of viewProj as
+// FrustumPlanesFromMatrix does, for a projection following the conventions c.
+// With ZeroToOneDepth the near plane is z = 0 rather than z = -w, and with
+// YDown the bottom and top planes are those of the bottom and top of the
+// screen. With reversed depth, the near and far planes are swapped.
+func (c ClipConventions) FrustumPlanes(viewProj Mat4) FrustumPlanes {
+	f := FrustumPlanesFromMatrix(viewProj)
+	if c.ZeroToOneDepth {
+		f[FrustumNear] = NormalizePlane(viewProj.Row(2))
+	}
+	if c.YDown {
+		f[FrustumBottom], f[FrustumTop] = f[FrustumTop], f[FrustumBottom]
+	}
+	return f
+}
+
+// ContainsPoint returns whether p is inside the frustum or on its boundary.
+func (f FrustumPlanes) ContainsPoint(p Vec3) bool {
+	for _, plane := range f {
+		if PlaneDistance(plane, p) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectsSphere returns whether the sphere may intersect the frustum. It
+// is conservative: a sphere outside the frustum but near one of its edges or
+// corners may be reported as intersecting, which is fine for culling.
+func (f FrustumPlanes) IntersectsSphere(s Sphere) bool {
+	for _, plane := range f {
+		if PlaneDistance(plane, s.Center) < -s.Radius {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectsAABB returns whether the box may intersect the frustum, by
+// checking, for each plane, the corner of the box furthest along its normal.
+// Like IntersectsSphere, it is conservative near the edges of the frustum.
+func (f FrustumPlanes) IntersectsAABB(box AABB) bool {
+	for _, plane := range f {
+		p := box.Min
+		for i := 0; i < 3; i++ {
+			if plane[i] > 0 {
+				p[i] = box.Max[i]
+			}
+		}
+		if PlaneDistance(plane, p) < 0 {
+			return false
+		}
+	}
+	return true
+}
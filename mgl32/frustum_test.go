@@ -0,0 +1,141 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFrustumPlanesFromMatrix(t *testing.T) {
+	t.Parallel()
+
+	// Eye space planes of a symmetric 90 degree frustum.
+	f := FrustumPlanesFromMatrix(Perspective(math.Pi/2, 1, 1, 10))
+	s := float32(math.Sqrt2 / 2)
+	want := FrustumPlanes{
+		{s, 0, -s, 0},
+		{-s, 0, -s, 0},
+		{0, s, -s, 0},
+		{0, -s, -s, 0},
+		{0, 0, -1, -1},
+		{0, 0, 1, 10},
+	}
+	for i := range want {
+		if !f[i].ApproxFuncEqual(want[i], absEqual(1e-5)) {
+			t.Errorf("plane %d = %v, want %v", i, f[i], want[i])
+		}
+	}
+}
+
+func TestClipConventionsFrustumPlanes(t *testing.T) {
+	t.Parallel()
+
+	// The eye space frustum is the same whatever the clip conventions, except
+	// for the direction of view space z.
+	gl := FrustumPlanesFromMatrix(Perspective(1, 1, 1, 10))
+	flipZ := gl
+	for i := range flipZ {
+		flipZ[i][2] = -flipZ[i][2]
+	}
+	tests := []struct {
+		Description string
+		C           ClipConventions
+		Want        FrustumPlanes
+		Forward     float32
+	}{
+		{"OpenGL", GLConventions, gl, -1},
+		{"Vulkan", VulkanConventions, gl, -1},
+		{"D3D", D3DConventions, flipZ, 1},
+	}
+
+	for _, c := range tests {
+		f := c.C.FrustumPlanes(c.C.Perspective(1, 1, 1, 10))
+		for i := range c.Want {
+			if !f[i].ApproxFuncEqual(c.Want[i], absEqual(1e-5)) {
+				t.Errorf("%s: plane %d = %v, want %v", c.Description, i, f[i], c.Want[i])
+			}
+		}
+
+		points := []struct {
+			P      Vec3
+			Inside bool
+		}{
+			{Vec3{0, 0, 0.8 * c.Forward}, false},
+			{Vec3{0, 0, 1.1 * c.Forward}, true},
+			{Vec3{0, 0, 9.9 * c.Forward}, true},
+			{Vec3{0, 0, 10.1 * c.Forward}, false},
+			{Vec3{0, 0.3, 1.1 * c.Forward}, true},
+			{Vec3{0, 0.7, 1.1 * c.Forward}, false},
+		}
+		for _, p := range points {
+			if got := f.ContainsPoint(p.P); got != p.Inside {
+				t.Errorf("%s: ContainsPoint(%v) = %v, want %v", c.Description, p.P, got, p.Inside)
+			}
+		}
+	}
+}
+
+func TestFrustumPlanesCulling(t *testing.T) {
+	t.Parallel()
+
+	// Looking down -x from (10, 0, 0), so world x = 9 is the near plane and
+	// x = -10 the far one.
+	view := LookAtV(Vec3{10, 0, 0}, Vec3{}, Vec3{0, 1, 0})
+	f := FrustumPlanesFromMatrix(Perspective(math.Pi/2, 1, 1, 20).Mul4(view))
+
+	points := []struct {
+		Description string
+		P           Vec3
+		Inside      bool
+	}{
+		{"Center", Vec3{}, true},
+		{"Near the near plane", Vec3{8.9, 0, 0}, true},
+		{"Before the near plane", Vec3{9.1, 0, 0}, false},
+		{"Beyond the far plane", Vec3{-10.1, 0, 0}, false},
+		{"Inside the side planes", Vec3{0, 9.9, 0}, true},
+		{"Outside the side planes", Vec3{0, 0, 10.1}, false},
+		{"Behind the eye", Vec3{20, 0, 0}, false},
+	}
+	for _, c := range points {
+		if got := f.ContainsPoint(c.P); got != c.Inside {
+			t.Errorf("%s: ContainsPoint(%v) = %v, want %v", c.Description, c.P, got, c.Inside)
+		}
+	}
+
+	spheres := []struct {
+		Description string
+		S           Sphere
+		Intersects  bool
+	}{
+		{"Inside", Sphere{Vec3{}, 1}, true},
+		{"Containing the frustum", Sphere{Vec3{}, 100}, true},
+		{"Straddling a side plane", Sphere{Vec3{0, 10.5, 0}, 1}, true},
+		{"Outside a side plane", Sphere{Vec3{0, 12, 0}, 1}, false},
+		{"Behind the eye", Sphere{Vec3{15, 0, 0}, 2}, false},
+	}
+	for _, c := range spheres {
+		if got := f.IntersectsSphere(c.S); got != c.Intersects {
+			t.Errorf("%s: IntersectsSphere(%v) = %v, want %v", c.Description, c.S, got, c.Intersects)
+		}
+	}
+
+	boxes := []struct {
+		Description string
+		Box         AABB
+		Intersects  bool
+	}{
+		{"Inside", AABB{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}, true},
+		{"Containing the frustum", AABB{Vec3{-50, -50, -50}, Vec3{50, 50, 50}}, true},
+		{"Straddling the far plane", AABB{Vec3{-11, -1, -1}, Vec3{-9, 1, 1}}, true},
+		{"Beyond the far plane", AABB{Vec3{-13, -1, -1}, Vec3{-11, 1, 1}}, false},
+		{"Outside a side plane", AABB{Vec3{-1, -1, 12}, Vec3{1, 1, 13}}, false},
+	}
+	for _, c := range boxes {
+		if got := f.IntersectsAABB(c.Box); got != c.Intersects {
+			t.Errorf("%s: IntersectsAABB(%v) = %v, want %v", c.Description, c.Box, got, c.Intersects)
+		}
+	}
+}
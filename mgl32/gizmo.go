@@ -0,0 +1,121 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// These functions do the math of transform gizmos in editors: picking their
+// handles with the ray under the mouse (see UnProject for building one), and
+// turning mouse drags into translations and rotations. A drag is described by
+// the ray at the start of the drag and the current one; the deltas returned
+// are to be applied to the transform as it was at the start.
+
+// IntersectPlane intersects the ray with the plane through origin with the
+// given normal. It fails if the ray is parallel to the plane or points away from it.
+func (r Ray) IntersectPlane(origin, normal Vec3) (t float32, ok bool) {
+	denom := r.Dir.Dot(normal)
+	if denom == 0 {
+		return 0, false
+	}
+	t = origin.Sub(r.Origin).Dot(normal) / denom
+	return t, t >= 0
+}
+
+// ClosestOnAxis returns the parameter s of the point origin + s*axis on the
+// infinite line of the axis that is closest to the line of the ray. It fails
+// if the ray is parallel to the axis, in which case every point is as close.
+func (r Ray) ClosestOnAxis(origin, axis Vec3) (s float32, ok bool) {
+	c, _, ok := ClosestPointsLineLine(origin, axis, r.Origin, r.Dir)
+	if !ok {
+		return 0, false
+	}
+	return c.Sub(origin).Dot(axis) / axis.LenSqr(), true
+}
+
+// ClosestPointOnCircle returns the point p of the circle with the given center,
+// normal and radius, such as a rotation handle, that is closest to the ray as
+// seen from the ray's origin, and the distance from p to the ray's line, which
+// can be compared to a picking tolerance.
+//
+// The point is where the ray meets the circle's plane, projected onto the
+// circle. When the ray is close to parallel to the plane, the circle is seen
+// edge-on and the point of the circle closest to the ray's line is returned
+// instead, the one nearer the ray's origin if there are two.
+func (r Ray) ClosestPointOnCircle(center, normal Vec3, radius float32) (p Vec3, dist float32) {
+	n, dir := normal.Normalize(), r.Dir.Normalize()
+
+	if t, ok := r.IntersectPlane(center, n); ok && Abs(dir.Dot(n)) > 1e-3 {
+		d := r.At(t).Sub(center)
+		if l := d.Len(); l > 0 {
+			p = center.Add(d.Mul(radius / l))
+		} else {
+			// Looking at the center: any point of the circle is as close.
+			p = center.Add(anyPerpendicular(n).Mul(radius))
+		}
+	} else {
+		// Work in the plane, where the distance to the ray's line is
+		// smallest where it is for the line's projection.
+		o := r.Origin.Sub(n.Mul(r.Origin.Sub(center).Dot(n)))
+		pdir := dir.Sub(n.Mul(dir.Dot(n))).Normalize()
+		q := o.Add(pdir.Mul(center.Sub(o).Dot(pdir)))
+		d := q.Sub(center)
+		if h := d.Len(); h < radius {
+			p = q.Sub(pdir.Mul(float32(math.Sqrt(float64(radius*radius - h*h)))))
+		} else {
+			p = center.Add(d.Mul(radius / h))
+		}
+	}
+
+	toP := p.Sub(r.Origin)
+	return p, toP.Sub(dir.Mul(toP.Dot(dir))).Len()
+}
+
+// AxisDragDelta returns the translation along the axis through origin that
+// follows the mouse, dragged from the ray start to the ray current. It fails if
+// either ray is parallel to the axis, which gizmos usually avoid by hiding handles
+// pointing at the camera.
+func AxisDragDelta(start, current Ray, origin, axis Vec3) (delta Vec3, ok bool) {
+	s0, ok0 := start.ClosestOnAxis(origin, axis)
+	s1, ok1 := current.ClosestOnAxis(origin, axis)
+	if !ok0 || !ok1 {
+		return Vec3{}, false
+	}
+	return axis.Mul(s1 - s0), true
+}
+
+// PlaneDragDelta returns the translation in the plane through origin with the
+// given normal that follows the mouse, dragged from the ray start to the ray
+// current. It fails if either ray doesn't meet the plane.
+func PlaneDragDelta(start, current Ray, origin, normal Vec3) (delta Vec3, ok bool) {
+	t0, ok0 := start.IntersectPlane(origin, normal)
+	t1, ok1 := current.IntersectPlane(origin, normal)
+	if !ok0 || !ok1 {
+		return Vec3{}, false
+	}
+	return current.At(t1).Sub(start.At(t0)), true
+}
+
+// RotationDragAngle returns the angle in radians, counterclockwise about axis,
+// of the rotation about the axis through center that follows the mouse,
+// dragged from the ray start to the ray current. The angle is in [-Pi, Pi]; to
+// allow drags of more than half a turn, accumulate the angles between
+// successive rays instead. It fails if either ray doesn't meet the plane of
+// the rotation, or meets it at the center.
+func RotationDragAngle(start, current Ray, center, axis Vec3) (angle float32, ok bool) {
+	t0, ok0 := start.IntersectPlane(center, axis)
+	t1, ok1 := current.IntersectPlane(center, axis)
+	if !ok0 || !ok1 {
+		return 0, false
+	}
+
+	a, b := start.At(t0).Sub(center), current.At(t1).Sub(center)
+	if a.LenSqr() == 0 || b.LenSqr() == 0 {
+		return 0, false
+	}
+	sin := float64(a.Cross(b).Dot(axis.Normalize()))
+	return float32(math.Atan2(sin, float64(a.Dot(b)))), true
+}
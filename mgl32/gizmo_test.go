@@ -0,0 +1,130 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRayIntersectPlane(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r  Ray
+		t  float32
+		ok bool
+	}{
+		{Ray{Vec3{0, 5, 0}, Vec3{0, -1, 0}}, 4, true},
+		{Ray{Vec3{3, 5, 2}, Vec3{1, -2, 0}}, 2, true},
+		{Ray{Vec3{0, 5, 0}, Vec3{0, 1, 0}}, -4, false},
+		{Ray{Vec3{0, 5, 0}, Vec3{1, 0, 0}}, 0, false},
+	}
+
+	for _, c := range tests {
+		tt, ok := c.r.IntersectPlane(Vec3{0, 1, 0}, Vec3{0, 1, 0})
+		if ok != c.ok || ok && !FloatEqual(tt, c.t) {
+			t.Errorf("%v.IntersectPlane = %v, %v; want %v, %v", c.r, tt, ok, c.t, c.ok)
+		}
+	}
+}
+
+func TestClosestPointOnCircle(t *testing.T) {
+	t.Parallel()
+
+	approx := func(a, b float32) bool { return Abs(a-b) < 1e-4 }
+	center, normal := Vec3{1, 0, 0}, Vec3{0, 0, 2}
+	tests := []struct {
+		r    Ray
+		p    Vec3
+		dist float32
+	}{
+		// Looking down at the circle's plane, outside and inside the circle.
+		{Ray{Vec3{4, 0, 5}, Vec3{0, 0, -1}}, Vec3{3, 0, 0}, 1},
+		{Ray{Vec3{1, 1, 5}, Vec3{0, 0, -1}}, Vec3{1, 2, 0}, 1},
+		// Seeing the circle edge-on.
+		{Ray{Vec3{1, -10, 0.5}, Vec3{0, 1, 0}}, Vec3{1, -2, 0}, 0.5},
+		{Ray{Vec3{2, -10, 1}, Vec3{0, 1, 0}}, Vec3{2, -float32(math.Sqrt(3)), 0}, 1},
+		{Ray{Vec3{5, -10, 1}, Vec3{0, 1, 0}}, Vec3{3, 0, 0}, float32(math.Sqrt(5))},
+	}
+
+	for _, c := range tests {
+		p, dist := c.r.ClosestPointOnCircle(center, normal, 2)
+		if !p.ApproxFuncEqual(c.p, approx) || !approx(dist, c.dist) {
+			t.Errorf("%v.ClosestPointOnCircle = %v, %v; want %v, %v", c.r, p, dist, c.p, c.dist)
+		}
+	}
+
+	// Through the center, the point is still on the circle.
+	p, _ := Ray{Vec3{1, 0, 5}, Vec3{0, 0, -1}}.ClosestPointOnCircle(center, normal, 2)
+	if d := p.Sub(center); !approx(d.Len(), 2) || !approx(d.Z(), 0) {
+		t.Errorf("ClosestPointOnCircle through the center = %v, not on the circle", p)
+	}
+}
+
+func TestAxisDragDelta(t *testing.T) {
+	t.Parallel()
+
+	// Camera at z = 10 looking at the x axis handle of a gizmo at (1, 2, 0).
+	origin, axis := Vec3{1, 2, 0}, Vec3{1, 0, 0}
+	eye := Vec3{0, 0, 10}
+	start := Ray{eye, Vec3{1, 2, 0}.Sub(eye)}
+	current := Ray{eye, Vec3{4, 2, 0}.Sub(eye)}
+
+	delta, ok := AxisDragDelta(start, current, origin, axis)
+	if !ok || !delta.ApproxFuncEqual(Vec3{3, 0, 0}, func(a, b float32) bool { return Abs(a-b) < 1e-4 }) {
+		t.Errorf("AxisDragDelta = %v, %v; want {3, 0, 0}, true", delta, ok)
+	}
+
+	// The axis pointing at the camera can't be dragged.
+	if _, ok := AxisDragDelta(Ray{eye, Vec3{0, 0, -1}}, current, Vec3{}, Vec3{0, 0, 1}); ok {
+		t.Errorf("AxisDragDelta along the view direction succeeded")
+	}
+}
+
+func TestPlaneDragDelta(t *testing.T) {
+	t.Parallel()
+
+	eye := Vec3{0, 10, 10}
+	start := Ray{eye, Vec3{1, 0, 1}.Sub(eye)}
+	current := Ray{eye, Vec3{-2, 0, 3}.Sub(eye)}
+
+	delta, ok := PlaneDragDelta(start, current, Vec3{5, 0, 5}, Vec3{0, 1, 0})
+	if !ok || !delta.ApproxFuncEqual(Vec3{-3, 0, 2}, func(a, b float32) bool { return Abs(a-b) < 1e-4 }) {
+		t.Errorf("PlaneDragDelta = %v, %v; want {-3, 0, 2}, true", delta, ok)
+	}
+
+	if _, ok := PlaneDragDelta(start, Ray{eye, Vec3{0, 1, 0}}, Vec3{}, Vec3{0, 1, 0}); ok {
+		t.Errorf("PlaneDragDelta with a ray pointing away from the plane succeeded")
+	}
+}
+
+func TestRotationDragAngle(t *testing.T) {
+	t.Parallel()
+
+	center, axis := Vec3{1, 1, 0}, Vec3{0, 0, 1}
+	eye := Vec3{1, 1, 10}
+	tests := []struct {
+		from, to Vec3
+		angle    float32
+	}{
+		{Vec3{2, 1, 0}, Vec3{1, 3, 0}, math.Pi / 2},
+		{Vec3{2, 1, 0}, Vec3{1, 0, 0}, -math.Pi / 2},
+		{Vec3{2, 2, 0}, Vec3{0, 0, 0}, math.Pi},
+		{Vec3{3, 1, 0}, Vec3{2, 2, 0}, math.Pi / 4},
+	}
+
+	for _, c := range tests {
+		angle, ok := RotationDragAngle(Ray{eye, c.from.Sub(eye)}, Ray{eye, c.to.Sub(eye)}, center, axis)
+		if !ok || Abs(Abs(angle)-Abs(c.angle)) > 1e-4 || c.angle != math.Pi && Abs(angle-c.angle) > 1e-4 {
+			t.Errorf("RotationDragAngle from %v to %v = %v, %v; want %v, true", c.from, c.to, angle, ok, c.angle)
+		}
+		// Rotating about the opposite axis turns the other way.
+		angle, _ = RotationDragAngle(Ray{eye, c.from.Sub(eye)}, Ray{eye, c.to.Sub(eye)}, center, axis.Mul(-1))
+		if c.angle != math.Pi && Abs(angle+c.angle) > 1e-4 {
+			t.Errorf("RotationDragAngle about -axis from %v to %v = %v; want %v", c.from, c.to, angle, -c.angle)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// GridPoints2D returns nx*ny points evenly spaced over the rectangle from min
+// to max, including its corners, in rows of increasing x. If nx or ny is 1,
+// the points are centered on that axis; if either is less than 1, the result
+// is empty.
+func GridPoints2D(min, max Vec2, nx, ny int) []Vec2 {
+	if nx < 1 || ny < 1 {
+		return nil
+	}
+
+	points := make([]Vec2, 0, nx*ny)
+	for j := 0; j < ny; j++ {
+		y := gridCoord(min[1], max[1], j, ny)
+		for i := 0; i < nx; i++ {
+			points = append(points, Vec2{gridCoord(min[0], max[0], i, nx), y})
+		}
+	}
+	return points
+}
+
+// GridPoints3D returns nx*ny*nz points evenly spaced over the box from min to
+// max, including its corners, with x varying fastest and z slowest. If nx, ny
+// or nz is 1, the points are centered on that axis; if any is less than 1,
+// the result is empty.
+func GridPoints3D(min, max Vec3, nx, ny, nz int) []Vec3 {
+	if nx < 1 || ny < 1 || nz < 1 {
+		return nil
+	}
+
+	points := make([]Vec3, 0, nx*ny*nz)
+	for k := 0; k < nz; k++ {
+		z := gridCoord(min[2], max[2], k, nz)
+		for j := 0; j < ny; j++ {
+			y := gridCoord(min[1], max[1], j, ny)
+			for i := 0; i < nx; i++ {
+				points = append(points, Vec3{gridCoord(min[0], max[0], i, nx), y, z})
+			}
+		}
+	}
+	return points
+}
+
+// gridCoord returns the i-th of n evenly spaced values from min to max.
+func gridCoord(min, max float32, i, n int) float32 {
+	if n == 1 {
+		return (min + max) / 2
+	}
+	return min + (max-min)*float32(i)/float32(n-1)
+}
+
+// Grid is a regular 3D lattice of cells placed in the world by an affine
+// transform. In grid space, the cell with index c spans [c, c+1) on each axis;
+// ToWorld maps grid space to world space and FromWorld is its inverse, so
+// cells may be scaled, rotated and sheared boxes in the world.
+type Grid struct {
+	ToWorld, FromWorld Mat4
+}
+
+// NewGrid returns the grid placed by the affine transform toWorld, which must
+// be invertible.
+func NewGrid(toWorld Mat4) Grid {
+	return Grid{toWorld, toWorld.Inv()}
+}
+
+// NewAxisAlignedGrid returns the grid with cells of size cellSize and cell 0
+// starting at origin in the world.
+func NewAxisAlignedGrid(origin, cellSize Vec3) Grid {
+	return NewGrid(Translate3D(origin[0], origin[1], origin[2]).Mul4(Scale3D(cellSize[0], cellSize[1], cellSize[2])))
+}
+
+// GridPosition returns the world position p in grid space.
+func (g Grid) GridPosition(p Vec3) Vec3 {
+	return TransformCoordinate(p, g.FromWorld)
+}
+
+// Cell returns the index of the cell containing the world position p.
+func (g Grid) Cell(p Vec3) Vec3i {
+	return floorVec3i(g.GridPosition(p))
+}
+
+// CellCorner returns the world position of the minimum corner of cell c.
+func (g Grid) CellCorner(c Vec3i) Vec3 {
+	return TransformCoordinate(c.Vec3(), g.ToWorld)
+}
+
+// CellCenter returns the world position of the center of cell c.
+func (g Grid) CellCenter(c Vec3i) Vec3 {
+	return TransformCoordinate(c.Vec3().Add(Vec3{0.5, 0.5, 0.5}), g.ToWorld)
+}
+
+// Traverse visits, in order, the cells crossed by the ray r up to the
+// distance maxT along it, using the 3D-DDA of Amanatides and Woo, "A Fast
+// Voxel Traversal Algorithm for Ray Tracing". The visit function is called
+// with each cell and the range of t over which the ray is inside it, and
+// stops the traversal by returning false, which it must eventually do if
+// maxT is infinite. The t values are those of r, in world space.
+//
+// When the ray passes exactly through an edge or corner of cells, the cells
+// it only touches may be visited for a zero-length range.
+func (g Grid) Traverse(r Ray, maxT float32, visit func(cell Vec3i, tEnter, tExit float32) bool) {
+	origin := g.GridPosition(r.Origin)
+	dir := g.FromWorld.Mul4x1(r.Dir.Vec4(0)).Vec3()
+	ddaTraverse(origin, dir, float64(maxT), visit)
+}
+
+// floorVec3i returns the integer vector of the floors of the elements of v.
+func floorVec3i(v Vec3) Vec3i {
+	return Vec3i{
+		int32(math.Floor(float64(v[0]))),
+		int32(math.Floor(float64(v[1]))),
+		int32(math.Floor(float64(v[2]))),
+	}
+}
+
+// ddaTraverse walks the unit cells crossed by origin + t*dir for t in
+// [0, maxT], as described for Grid.Traverse.
+func ddaTraverse(origin, dir Vec3, maxT float64, visit func(cell Vec3i, tEnter, tExit float32) bool) {
+	cell := floorVec3i(origin)
+
+	var step Vec3i
+	var next, delta [3]float64
+	for i := range dir {
+		d, o := float64(dir[i]), float64(origin[i])
+		switch {
+		case d > 0:
+			step[i], next[i], delta[i] = 1, (float64(cell[i])+1-o)/d, 1/d
+		case d < 0:
+			step[i], next[i], delta[i] = -1, (float64(cell[i])-o)/d, -1/d
+		default:
+			next[i], delta[i] = math.Inf(1), math.Inf(1)
+		}
+	}
+
+	t := 0.0
+	for {
+		axis := 0
+		if next[1] < next[axis] {
+			axis = 1
+		}
+		if next[2] < next[axis] {
+			axis = 2
+		}
+
+		exit := math.Min(next[axis], maxT)
+		if !visit(cell, float32(t), float32(exit)) || exit >= maxT {
+			return
+		}
+
+		cell[axis] += step[axis]
+		t = next[axis]
+		next[axis] += delta[axis]
+	}
+}
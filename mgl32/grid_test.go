@@ -0,0 +1,147 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGridPoints(t *testing.T) {
+	t.Parallel()
+
+	got2 := GridPoints2D(Vec2{0, 0}, Vec2{2, 1}, 3, 2)
+	want2 := []Vec2{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {1, 1}, {2, 1}}
+	if !reflect.DeepEqual(got2, want2) {
+		t.Errorf("GridPoints2D = %v, want %v", got2, want2)
+	}
+
+	got3 := GridPoints3D(Vec3{0, 0, 0}, Vec3{1, 1, 4}, 2, 1, 3)
+	want3 := []Vec3{{0, 0.5, 0}, {1, 0.5, 0}, {0, 0.5, 2}, {1, 0.5, 2}, {0, 0.5, 4}, {1, 0.5, 4}}
+	if !reflect.DeepEqual(got3, want3) {
+		t.Errorf("GridPoints3D = %v, want %v", got3, want3)
+	}
+
+	if got := GridPoints3D(Vec3{}, Vec3{1, 1, 1}, 2, 0, 2); len(got) != 0 {
+		t.Errorf("GridPoints3D with no points along y = %v", got)
+	}
+}
+
+func TestGridCells(t *testing.T) {
+	t.Parallel()
+
+	g := NewAxisAlignedGrid(Vec3{-1, 0, 10}, Vec3{2, 0.5, 1})
+	tests := []struct {
+		p    Vec3
+		cell Vec3i
+	}{
+		{Vec3{-1, 0, 10}, Vec3i{0, 0, 0}},
+		{Vec3{0.9, 0.4, 10.5}, Vec3i{0, 0, 0}},
+		{Vec3{1.5, 1.2, 9.5}, Vec3i{1, 2, -1}},
+		{Vec3{-3.5, -0.1, 12}, Vec3i{-2, -1, 2}},
+	}
+
+	for _, test := range tests {
+		if got := g.Cell(test.p); got != test.cell {
+			t.Errorf("Cell(%v) = %v, want %v", test.p, got, test.cell)
+		}
+	}
+
+	c := Vec3i{1, 2, -1}
+	if got, want := g.CellCorner(c), (Vec3{1, 1, 9}); !got.ApproxEqual(want) {
+		t.Errorf("CellCorner(%v) = %v, want %v", c, got, want)
+	}
+	if got, want := g.CellCenter(c), (Vec3{2, 1.25, 9.5}); !got.ApproxEqual(want) {
+		t.Errorf("CellCenter(%v) = %v, want %v", c, got, want)
+	}
+
+	// A rotated grid maps cells back and forth.
+	rg := NewGrid(HomogRotate3DZ(0.6).Mul4(Scale3D(0.5, 0.5, 0.5)))
+	for _, c := range []Vec3i{{0, 0, 0}, {3, -2, 1}, {-5, 7, -3}} {
+		if got := rg.Cell(rg.CellCenter(c)); got != c {
+			t.Errorf("Cell(CellCenter(%v)) = %v", c, got)
+		}
+	}
+}
+
+type gridVisit struct {
+	cell        Vec3i
+	enter, exit float32
+}
+
+func TestGridTraverse(t *testing.T) {
+	t.Parallel()
+
+	g := NewAxisAlignedGrid(Vec3{}, Vec3{1, 1, 1})
+	tests := []struct {
+		name string
+		ray  Ray
+		maxT float32
+		want []gridVisit
+	}{
+		{
+			"along x",
+			Ray{Vec3{0.5, 0.5, 0.5}, Vec3{1, 0, 0}},
+			3,
+			[]gridVisit{{Vec3i{0, 0, 0}, 0, 0.5}, {Vec3i{1, 0, 0}, 0.5, 1.5}, {Vec3i{2, 0, 0}, 1.5, 2.5}, {Vec3i{3, 0, 0}, 2.5, 3}},
+		},
+		{
+			"backwards in y and z",
+			Ray{Vec3{0.5, 0.25, 0.5}, Vec3{0, -1, -0.5}},
+			1,
+			[]gridVisit{{Vec3i{0, 0, 0}, 0, 0.25}, {Vec3i{0, -1, 0}, 0.25, 1}},
+		},
+		{
+			"diagonal",
+			Ray{Vec3{0.1, 0.6, 0}, Vec3{1, 1, 0}},
+			1,
+			[]gridVisit{{Vec3i{0, 0, 0}, 0, 0.4}, {Vec3i{0, 1, 0}, 0.4, 0.9}, {Vec3i{1, 1, 0}, 0.9, 1}},
+		},
+		{
+			"standing still",
+			Ray{Vec3{-0.5, 2.5, 1}, Vec3{}},
+			float32(math.Inf(1)),
+			[]gridVisit{{Vec3i{-1, 2, 1}, 0, float32(math.Inf(1))}},
+		},
+	}
+
+	for _, test := range tests {
+		var got []gridVisit
+		g.Traverse(test.ray, test.maxT, func(cell Vec3i, enter, exit float32) bool {
+			got = append(got, gridVisit{cell, enter, exit})
+			return len(got) < 100
+		})
+
+		ok := len(got) == len(test.want)
+		for i := 0; ok && i < len(got); i++ {
+			ok = got[i].cell == test.want[i].cell && FloatEqualThreshold(got[i].enter, test.want[i].enter, 1e-6) && FloatEqualThreshold(got[i].exit, test.want[i].exit, 1e-6)
+		}
+		if !ok {
+			t.Errorf("%s: Traverse visited %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	// World space t of a scaled grid.
+	sg := NewAxisAlignedGrid(Vec3{0, 0, 0}, Vec3{2, 2, 2})
+	var exits []float32
+	sg.Traverse(Ray{Vec3{1, 1, 1}, Vec3{0, 0, 1}}, 4, func(cell Vec3i, enter, exit float32) bool {
+		exits = append(exits, exit)
+		return true
+	})
+	if want := []float32{1, 3, 4}; !reflect.DeepEqual(exits, want) {
+		t.Errorf("Traverse of a scaled grid exits cells at %v, want %v", exits, want)
+	}
+
+	// Stopping early.
+	n := 0
+	g.Traverse(Ray{Vec3{}, Vec3{1, 2, 3}}, float32(math.Inf(1)), func(Vec3i, float32, float32) bool {
+		n++
+		return n < 5
+	})
+	if n != 5 {
+		t.Errorf("Traverse visited %d cells after being stopped at 5", n)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// FloatToHalf converts v to the bits of an IEEE 754 half precision float,
+// the GL_HALF_FLOAT format, rounding to the nearest representable value with
+// ties to even. Values too large for a half become infinities and NaNs stay
+// NaNs.
+func FloatToHalf(v float32) uint16 {
+	return uint16(packFloat(float64(v), 5, 10))
+}
+
+// packFloat returns the bits of v rounded to the IEEE 754 binary format with
+// the given numbers of exponent and mantissa bits, ties to even. NaNs are made
+// quiet, keeping the high bits of their payload.
+func packFloat(v float64, expBits, mantBits uint) uint64 {
+	b := math.Float64bits(v)
+	sign := b >> 63 << (expBits + mantBits)
+	exp := int(b >> 52 & 0x7ff)
+	mant := b & (1<<52 - 1)
+	maxExp := uint64(1)<<expBits - 1
+
+	if exp == 0x7ff {
+		if mant != 0 {
+			return sign | maxExp<<mantBits | 1<<(mantBits-1) | mant>>(52-mantBits)
+		}
+		return sign | maxExp<<mantBits
+	}
+
+	e := exp - 1023 + (1<<(expBits-1) - 1)
+	switch {
+	case e >= int(maxExp):
+		return sign | maxExp<<mantBits
+	case e <= 0:
+		// A subnormal, whose mantissa is v in units of the smallest one.
+		if e < -int(mantBits) {
+			return sign
+		}
+		return sign | roundShiftEven(mant|1<<52, uint(53-int(mantBits)-e))
+	default:
+		// Rounding up may carry into the exponent, up to infinity, which is
+		// the correct result.
+		return sign | roundShiftEven(uint64(e)<<52|mant, 52-mantBits)
+	}
+}
+
+// roundShiftEven returns x >> s rounded to the nearest integer, ties to even.
+func roundShiftEven(x uint64, s uint) uint64 {
+	r, rem, half := x>>s, x&(1<<s-1), uint64(1)<<(s-1)
+	if rem > half || rem == half && r&1 == 1 {
+		r++
+	}
+	return r
+}
+
+// HalfToFloat converts the bits of an IEEE 754 half precision float, as
+// produced by FloatToHalf, to a float. The conversion is exact.
+func HalfToFloat(h uint16) float32 {
+	exp, mant := int(h>>10&0x1f), float64(h&0x3ff)
+
+	var v float64
+	switch exp {
+	case 0x1f:
+		v = math.Inf(1)
+		if mant != 0 {
+			v = math.NaN()
+		}
+	case 0:
+		v = math.Ldexp(mant, -24)
+	default:
+		v = math.Ldexp(1024+mant, exp-25)
+	}
+	if h&0x8000 != 0 {
+		v = -v
+	}
+	return float32(v)
+}
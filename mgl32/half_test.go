@@ -0,0 +1,69 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatToHalf(t *testing.T) {
+	t.Parallel()
+
+	// Exact is whether the half is exactly V, so HalfToFloat returns it.
+	tests := []struct {
+		Description string
+		V           float32
+		Half        uint16
+		Exact       bool
+	}{
+		{"Zero", 0, 0x0000, true},
+		{"Negative zero", float32(math.Copysign(0, -1)), 0x8000, true},
+		{"One", 1, 0x3c00, true},
+		{"Minus two", -2, 0xc000, true},
+		{"Third", 1.0 / 3, 0x3555, false},
+		{"Largest", 65504, 0x7bff, true},
+		{"Rounding to the largest", 65519, 0x7bff, false},
+		{"Overflow", 65520, 0x7c00, false},
+		{"Smallest normal", float32(math.Ldexp(1, -14)), 0x0400, true},
+		{"Smallest subnormal", float32(math.Ldexp(1, -24)), 0x0001, true},
+		{"Half the smallest subnormal, tie to even", float32(math.Ldexp(1, -25)), 0x0000, false},
+		{"Above half the smallest subnormal", float32(math.Ldexp(1.5, -25)), 0x0001, false},
+		{"Tie to even, down", 1 + float32(math.Ldexp(1, -11)), 0x3c00, false},
+		{"Tie to even, up", 1 + float32(math.Ldexp(3, -11)), 0x3c02, false},
+		{"Infinity", InfPos, 0x7c00, true},
+		{"Negative infinity", InfNeg, 0xfc00, true},
+	}
+
+	for _, c := range tests {
+		if got := FloatToHalf(c.V); got != c.Half {
+			t.Errorf("%s: FloatToHalf(%v) = %#04x, want %#04x", c.Description, c.V, got, c.Half)
+		}
+		if got := HalfToFloat(c.Half); c.Exact && (got != c.V || math.Signbit(float64(got)) != math.Signbit(float64(c.V))) {
+			t.Errorf("%s: HalfToFloat(%#04x) = %v, want %v", c.Description, c.Half, got, c.V)
+		}
+	}
+
+	if h := FloatToHalf(NaN); h&0x7c00 != 0x7c00 || h&0x3ff == 0 {
+		t.Errorf("FloatToHalf(NaN) = %#04x, not a NaN", h)
+	}
+	if v := HalfToFloat(0x7e00); v == v {
+		t.Errorf("HalfToFloat(0x7e00) = %v, want NaN", v)
+	}
+}
+
+func TestHalfRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Every finite half converts to a float and back unchanged.
+	for h := 0; h < 0x10000; h++ {
+		if h&0x7c00 == 0x7c00 {
+			continue
+		}
+		if got := FloatToHalf(HalfToFloat(uint16(h))); got != uint16(h) {
+			t.Fatalf("FloatToHalf(HalfToFloat(%#04x)) = %#04x", h, got)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Heightfield is a regular grid of Width by Height heights, stored row by row
+// in Heights, as loaded from a terrain height map. Sample (i, j) is the point
+// {i, Heights[j*Width+i], j}, so the terrain spans [0, Width-1] along x and
+// [0, Height-1] along z with y up; transform the results to place it in the
+// world.
+//
+// Between samples, the terrain is the triangle mesh of Mesh, with each cell
+// split along the diagonal from (i, j+1) to (i+1, j). Raycast intersects that
+// mesh, while Sample and Normal interpolate bilinearly, which is smoother but
+// may differ from the mesh by a fraction of the height difference in a cell.
+type Heightfield struct {
+	Width, Height int
+	Heights       []float32
+}
+
+// NewHeightfield returns a flat heightfield of the given size.
+func NewHeightfield(width, height int) *Heightfield {
+	return &Heightfield{width, height, make([]float32, width*height)}
+}
+
+// At returns the height of sample (i, j), clamping i and j to the grid.
+func (hf *Heightfield) At(i, j int) float32 {
+	return hf.Heights[clampInt(j, 0, hf.Height-1)*hf.Width+clampInt(i, 0, hf.Width-1)]
+}
+
+// clampInt returns x clamped to [lo, hi].
+func clampInt(x, lo, hi int) int {
+	if x < lo {
+		return lo
+	}
+	if x > hi {
+		return hi
+	}
+	return x
+}
+
+// cell returns the cell containing (x, z), clamped to the grid, and the
+// position in it.
+func (hf *Heightfield) cell(x, z float32) (i, j int, u, v float32) {
+	x = Clamp(x, 0, float32(hf.Width-1))
+	z = Clamp(z, 0, float32(hf.Height-1))
+	i = clampInt(int(x), 0, hf.Width-2)
+	j = clampInt(int(z), 0, hf.Height-2)
+	if hf.Width < 2 {
+		i = 0
+	}
+	if hf.Height < 2 {
+		j = 0
+	}
+	return i, j, x - float32(i), z - float32(j)
+}
+
+// Sample returns the bilinearly interpolated height at (x, z). Positions
+// outside of the grid are clamped to its edges.
+func (hf *Heightfield) Sample(x, z float32) float32 {
+	i, j, u, v := hf.cell(x, z)
+	h0 := hf.At(i, j) + (hf.At(i+1, j)-hf.At(i, j))*u
+	h1 := hf.At(i, j+1) + (hf.At(i+1, j+1)-hf.At(i, j+1))*u
+	return h0 + (h1-h0)*v
+}
+
+// VertexNormal returns the normal of the terrain at sample (i, j), from the
+// central differences of the heights around it.
+func (hf *Heightfield) VertexNormal(i, j int) Vec3 {
+	dx := (hf.At(i+1, j) - hf.At(i-1, j)) / float32(clampInt(i+1, 0, hf.Width-1)-clampInt(i-1, 0, hf.Width-1))
+	dz := (hf.At(i, j+1) - hf.At(i, j-1)) / float32(clampInt(j+1, 0, hf.Height-1)-clampInt(j-1, 0, hf.Height-1))
+	if math.IsNaN(float64(dx)) {
+		dx = 0
+	}
+	if math.IsNaN(float64(dz)) {
+		dz = 0
+	}
+	return Vec3{-dx, 1, -dz}.Normalize()
+}
+
+// Normal returns the normal of the terrain at (x, z), bilinearly interpolated
+// from the VertexNormal of the samples around it.
+func (hf *Heightfield) Normal(x, z float32) Vec3 {
+	i, j, u, v := hf.cell(x, z)
+	n0 := hf.VertexNormal(i, j).Mul(1 - u).Add(hf.VertexNormal(i+1, j).Mul(u))
+	n1 := hf.VertexNormal(i, j+1).Mul(1 - u).Add(hf.VertexNormal(i+1, j+1).Mul(u))
+	return n0.Mul(1 - v).Add(n1.Mul(v)).Normalize()
+}
+
+// vertex returns the position of sample (i, j).
+func (hf *Heightfield) vertex(i, j int) Vec3 {
+	return Vec3{float32(i), hf.At(i, j), float32(j)}
+}
+
+// Raycast returns the distance along the ray r to its first hit on the
+// terrain mesh with 0 <= t <= maxT, walking the cells under the ray with a
+// 2D DDA. Pass InfPos as maxT for an unbounded ray. Heightfields smaller than
+// 2 by 2 samples are never hit.
+func (hf *Heightfield) Raycast(r Ray, maxT float32) (t float32, ok bool) {
+	if hf.Width < 2 || hf.Height < 2 {
+		return 0, false
+	}
+
+	// Clip the ray to the footprint of the terrain.
+	footprint := AABB{Vec3{0, InfNeg, 0}, Vec3{float32(hf.Width - 1), InfPos, float32(hf.Height - 1)}}
+	t0, in := rayAABB(r.Origin, r.Dir, footprint)
+	if !in || t0 > maxT {
+		return 0, false
+	}
+	start := r.At(t0)
+	flat := Vec3{r.Dir[0], 0, r.Dir[2]}
+
+	best, hit := maxT, false
+	ddaTraverse(Vec3{start[0], 0, start[2]}, flat, float64(maxT-t0), func(cell Vec3i, tEnter, _ float32) bool {
+		i, j := int(cell[0]), int(cell[2])
+		if i < 0 || j < 0 || i >= hf.Width-1 || j >= hf.Height-1 {
+			// The ray is leaving the terrain, unless it starts on its far
+			// edges.
+			return tEnter == 0
+		}
+
+		v00, v10, v01, v11 := hf.vertex(i, j), hf.vertex(i+1, j), hf.vertex(i, j+1), hf.vertex(i+1, j+1)
+		for _, tri := range [2]meshBVHTri{
+			{v00, v01.Sub(v00), v10.Sub(v00), 0},
+			{v10, v01.Sub(v10), v11.Sub(v10), 0},
+		} {
+			if tc, _, _, ok := tri.intersectRay(r); ok && tc <= best {
+				best, hit = tc, true
+			}
+		}
+		// Any hit is in this cell, so it is the nearest one.
+		return !hit
+	})
+
+	return best, hit
+}
+
+// Mesh returns the terrain as a triangle mesh, with a vertex per sample in the
+// order of Heights and two triangles per cell, winding counterclockwise seen
+// from above.
+func (hf *Heightfield) Mesh() (vertices []Vec3, indices []uint32) {
+	vertices = make([]Vec3, 0, hf.Width*hf.Height)
+	for j := 0; j < hf.Height; j++ {
+		for i := 0; i < hf.Width; i++ {
+			vertices = append(vertices, hf.vertex(i, j))
+		}
+	}
+
+	if hf.Width < 2 || hf.Height < 2 {
+		return vertices, nil
+	}
+	indices = make([]uint32, 0, 6*(hf.Width-1)*(hf.Height-1))
+	for j := 0; j < hf.Height-1; j++ {
+		for i := 0; i < hf.Width-1; i++ {
+			v00 := uint32(j*hf.Width + i)
+			v10, v01, v11 := v00+1, v00+uint32(hf.Width), v00+uint32(hf.Width)+1
+			indices = append(indices, v00, v01, v10, v10, v01, v11)
+		}
+	}
+	return vertices, indices
+}
@@ -0,0 +1,113 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestHeightfieldSample(t *testing.T) {
+	t.Parallel()
+
+	// A slope rising by 0.5 per unit along x.
+	hf := NewHeightfield(5, 4)
+	for j := 0; j < hf.Height; j++ {
+		for i := 0; i < hf.Width; i++ {
+			hf.Heights[j*hf.Width+i] = 0.5 * float32(i)
+		}
+	}
+
+	tests := []struct {
+		x, z, h float32
+	}{
+		{0, 0, 0},
+		{2.5, 1.25, 1.25},
+		{4, 3, 2},
+		{-1, 1, 0}, // clamped
+		{10, 7, 2}, // clamped
+	}
+	for _, test := range tests {
+		if h := hf.Sample(test.x, test.z); !FloatEqual(h, test.h) {
+			t.Errorf("Sample(%v, %v) = %v, want %v", test.x, test.z, h, test.h)
+		}
+	}
+
+	want := Vec3{-0.5, 1, 0}.Normalize()
+	for _, p := range []Vec2{{1, 1}, {2.5, 0.5}, {0, 3}, {4, 0}} {
+		if n := hf.Normal(p[0], p[1]); !n.ApproxEqualThreshold(want, 1e-6) {
+			t.Errorf("Normal(%v, %v) = %v, want %v", p[0], p[1], n, want)
+		}
+	}
+
+	// A bump between samples.
+	hf.Heights[1*hf.Width+1] = 3
+	if h := hf.Sample(1.5, 1); !FloatEqual(h, 2) {
+		t.Errorf("Sample between a bump and a slope = %v, want 2", h)
+	}
+	if n := hf.VertexNormal(2, 1); n[0] <= 0 {
+		t.Errorf("VertexNormal next to a bump = %v, want it to lean away", n)
+	}
+}
+
+func TestHeightfieldMesh(t *testing.T) {
+	t.Parallel()
+
+	hf := NewHeightfield(3, 2)
+	copy(hf.Heights, []float32{0, 1, 2, 3, 4, 5})
+	vertices, indices := hf.Mesh()
+	if len(vertices) != 6 || len(indices) != 12 {
+		t.Fatalf("Mesh has %d vertices and %d indices, want 6 and 12", len(vertices), len(indices))
+	}
+	if vertices[4] != (Vec3{1, 4, 1}) {
+		t.Errorf("Mesh vertex 4 = %v, want {1, 4, 1}", vertices[4])
+	}
+	for i := 0; i < len(indices); i += 3 {
+		a, b, c := vertices[indices[i]], vertices[indices[i+1]], vertices[indices[i+2]]
+		if n := b.Sub(a).Cross(c.Sub(a)); n[1] <= 0 {
+			t.Errorf("Mesh triangle %d faces down: %v", i/3, n)
+		}
+	}
+}
+
+func TestHeightfieldRaycast(t *testing.T) {
+	t.Parallel()
+
+	hf := NewHeightfield(4, 4)
+	for i := range hf.Heights {
+		hf.Heights[i] = 1
+	}
+	if tc, ok := hf.Raycast(Ray{Vec3{2.3, 10, 1.7}, Vec3{0, -1, 0}}, InfPos); !ok || !FloatEqual(tc, 9) {
+		t.Errorf("vertical Raycast = %v, %v, want 9", tc, ok)
+	}
+	if _, ok := hf.Raycast(Ray{Vec3{2.3, 10, 1.7}, Vec3{0, -1, 0}}, 8); ok {
+		t.Errorf("Raycast hit beyond maxT")
+	}
+	if _, ok := hf.Raycast(Ray{Vec3{5, 10, 1.7}, Vec3{0, -1, 0}}, InfPos); ok {
+		t.Errorf("Raycast hit outside of the terrain")
+	}
+	if tc, ok := hf.Raycast(Ray{Vec3{-2, 3, 1.5}, Vec3{1, -0.5, 0}}, InfPos); !ok || !FloatEqual(tc, 4) {
+		t.Errorf("Raycast from outside = %v, %v, want 4", tc, ok)
+	}
+
+	// Random terrain agrees with raycasting its mesh.
+	r := rand.New(rand.NewSource(7))
+	hf = NewHeightfield(17, 11)
+	for i := range hf.Heights {
+		hf.Heights[i] = r.Float32() * 3
+	}
+	bvh := NewMeshBVH(hf.Mesh())
+	for i := 0; i < 300; i++ {
+		ray := Ray{
+			Vec3{r.Float32()*24 - 4, r.Float32()*6 + 1, r.Float32()*18 - 4},
+			Vec3{r.Float32()*2 - 1, -r.Float32(), r.Float32()*2 - 1},
+		}
+		want, wantOk := bvh.Raycast(ray, InfPos)
+		got, ok := hf.Raycast(ray, InfPos)
+		if ok != wantOk || ok && !FloatEqualThreshold(got, want.T, 1e-4) {
+			t.Errorf("Raycast(%v) = %v, %v, want %v, %v", ray, got, ok, want.T, wantOk)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// HomographyFromCorrespondences computes the homography (projective
+// transformation of the plane) mapping each point src[i] to dst[i], using the
+// direct linear transform. The result is normalized so that its bottom right
+// element is 1, and is applied to points with ApplyHomography.
+//
+// If three of the source or destination points are collinear, no homography
+// exists and the zero matrix is returned, as with Mat3.Inv.
+func HomographyFromCorrespondences(src, dst [4]Vec2) Mat3 {
+	// With h33 = 1, each correspondence (x,y) -> (u,v) gives two linear
+	// equations in the remaining eight elements, in row major order:
+	//   x*h11 + y*h12 + h13 - u*x*h31 - u*y*h32 = u
+	//   x*h21 + y*h22 + h23 - v*x*h31 - v*y*h32 = v
+	var a [8][9]float64
+	for i := 0; i < 4; i++ {
+		x, y := float64(src[i][0]), float64(src[i][1])
+		u, v := float64(dst[i][0]), float64(dst[i][1])
+		a[2*i] = [9]float64{x, y, 1, 0, 0, 0, -u * x, -u * y, u}
+		a[2*i+1] = [9]float64{0, 0, 0, x, y, 1, -v * x, -v * y, v}
+	}
+
+	h, ok := solveLinear8(&a)
+	if !ok {
+		return Mat3{}
+	}
+	return Mat3FromRows(
+		Vec3{float32(h[0]), float32(h[1]), float32(h[2])},
+		Vec3{float32(h[3]), float32(h[4]), float32(h[5])},
+		Vec3{float32(h[6]), float32(h[7]), 1},
+	)
+}
+
+// ApplyHomography transforms the point p by the homography h, including the
+// perspective divide. Points mapped to infinity have infinite or NaN
+// coordinates.
+func ApplyHomography(h Mat3, p Vec2) Vec2 {
+	v := h.Mul3x1(p.Vec3(1))
+	return Vec2{v[0] / v[2], v[1] / v[2]}
+}
+
+// solveLinear8 solves the 8x8 system whose augmented matrix is a, by Gaussian
+// elimination with partial pivoting. It returns false if the system is
+// (close to) singular.
+func solveLinear8(a *[8][9]float64) (x [8]float64, ok bool) {
+	var scale float64
+	for i := range a {
+		for j := 0; j < 8; j++ {
+			scale = math.Max(scale, math.Abs(a[i][j]))
+		}
+	}
+	if scale == 0 {
+		return x, false
+	}
+
+	for col := 0; col < 8; col++ {
+		pivot := col
+		for row := col + 1; row < 8; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) <= 1e-12*scale {
+			return x, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+
+		for row := col + 1; row < 8; row++ {
+			f := a[row][col] / a[col][col]
+			for k := col; k < 9; k++ {
+				a[row][k] -= f * a[col][k]
+			}
+		}
+	}
+
+	for row := 7; row >= 0; row-- {
+		sum := a[row][8]
+		for k := row + 1; k < 8; k++ {
+			sum -= a[row][k] * x[k]
+		}
+		x[row] = sum / a[row][row]
+	}
+	return x, true
+}
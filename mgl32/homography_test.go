@@ -0,0 +1,50 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestHomographyFromCorrespondences(t *testing.T) {
+	t.Parallel()
+
+	// The unit square to a general quadrilateral
+	src := [4]Vec2{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+	dst := [4]Vec2{{10, 20}, {110, 30}, {90, 140}, {5, 100}}
+
+	h := HomographyFromCorrespondences(src, dst)
+	for i := range src {
+		if p := ApplyHomography(h, src[i]); !p.ApproxEqualThreshold(dst[i], 1e-4) {
+			t.Errorf("Homography maps %v to %v, expected %v", src[i], p, dst[i])
+		}
+	}
+
+	// Straight lines stay straight: the center of the square goes to the
+	// intersection of the diagonals of the quadrilateral.
+	center := ApplyHomography(h, Vec2{0.5, 0.5})
+	d1, d2 := dst[2].Sub(dst[0]), dst[3].Sub(dst[1])
+	if cross := d1[0]*center.Sub(dst[0])[1] - d1[1]*center.Sub(dst[0])[0]; Abs(cross) > 1e-2 {
+		t.Errorf("Center %v is not on the first diagonal", center)
+	}
+	if cross := d2[0]*center.Sub(dst[1])[1] - d2[1]*center.Sub(dst[1])[0]; Abs(cross) > 1e-2 {
+		t.Errorf("Center %v is not on the second diagonal", center)
+	}
+
+	// An affine map is recovered exactly.
+	affine := Mat3{2, 0.5, 0, -1, 3, 0, 4, 5, 1}
+	for i := range src {
+		dst[i] = ApplyHomography(affine, src[i])
+	}
+	if h := HomographyFromCorrespondences(src, dst); !h.ApproxFuncEqual(affine, func(a, b float32) bool { return Abs(a-b) < 1e-5 }) {
+		t.Errorf("Homography of an affine map = %v, expected %v", h, affine)
+	}
+
+	// Collinear points have no homography.
+	collinear := [4]Vec2{{0, 0}, {1, 1}, {2, 2}, {0, 1}}
+	if h := HomographyFromCorrespondences(collinear, dst); h != (Mat3{}) {
+		t.Errorf("Homography from collinear points = %v, expected zero", h)
+	}
+}
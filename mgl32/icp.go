@@ -0,0 +1,57 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"errors"
+	"math"
+)
+
+// ICP registers the point cloud src against the points in dst with the basic
+// point-to-point Iterative Closest Point algorithm, starting from the initial
+// transform (use Ident4 if there is no better guess).
+//
+// Every iteration matches each transformed source point with its closest point
+// in dst and then solves for the rigid transform that best aligns the matches
+// with AlignPoints. Iteration stops once the root mean square distance between
+// the matches improves by less than tolerance, or after maxIterations
+// iterations.
+//
+// The returned transform maps src onto dst, rmsd is the root mean square
+// distance of the last set of matches. An error is returned if either point set is
+// empty.
+//
+// ICP only converges to a local minimum, so the initial transform needs to be
+// reasonably close to the real one.
+func ICP(src []Vec3, dst *KDTree3, initial Mat4, maxIterations int, tolerance float32) (m Mat4, rmsd float32, err error) {
+	if len(src) == 0 || dst == nil || dst.Len() == 0 {
+		return Mat4{}, 0, errors.New("cannot run ICP on empty point sets")
+	}
+
+	m = initial
+	matched := make([]Vec3, len(src))
+	prev := InfPos
+
+	for i := 0; i < maxIterations; i++ {
+		var sum float32
+		for j, p := range src {
+			index, distSqr := dst.Nearest(TransformCoordinate(p, m))
+			matched[j] = dst.Point(index)
+			sum += distSqr
+		}
+		rmsd = float32(math.Sqrt(float64(sum / float32(len(src)))))
+
+		if prev-rmsd < tolerance {
+			break
+		}
+		prev = rmsd
+
+		if m, err = AlignPoints(src, matched); err != nil {
+			return Mat4{}, 0, err
+		}
+	}
+
+	return m, rmsd, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestICP(t *testing.T) {
+	t.Parallel()
+
+	// A lumpy grid, so the alignment is well defined.
+	var dst []Vec3
+	for x := 0; x < 10; x++ {
+		for y := 0; y < 10; y++ {
+			fx, fy := float32(x)*0.3, float32(y)*0.3
+			dst = append(dst, Vec3{fx, fy, 0.2 * fx * fx * fy})
+		}
+	}
+
+	expected := Translate3D(0.05, -0.04, 0.02).Mul4(HomogRotate3D(0.04, Vec3{0.3, 1, 0.2}.Normalize()))
+	inv := expected.Inv()
+	src := make([]Vec3, len(dst))
+	for i, p := range dst {
+		src[i] = TransformCoordinate(p, inv)
+	}
+
+	m, rmsd, err := ICP(src, NewKDTree3(dst), Ident4(), 50, 1e-6)
+	if err != nil {
+		t.Fatalf("ICP returned error: %v", err)
+	}
+	if rmsd > 1e-3 {
+		t.Errorf("ICP converged with an rmsd of %v", rmsd)
+	}
+	if !m.ApproxFuncEqual(expected, func(a, b float32) bool { return Abs(a-b) < 1e-3 }) {
+		t.Errorf("ICP found %v, expected %v", m, expected)
+	}
+
+	if _, _, err := ICP(nil, NewKDTree3(dst), Ident4(), 10, 0); err == nil {
+		t.Errorf("Expected an error for an empty source point set")
+	}
+}
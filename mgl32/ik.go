@@ -0,0 +1,139 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// The iterative IK solvers work on a chain of joints given by their local
+// transforms: chain[0] is relative to the world (or whatever space the
+// target is in) and each joint is the child of the previous one. The end
+// effector is the origin of the last joint. The solvers adjust the rotations
+// of all joints but the last in place; translations are left alone, so bone
+// lengths are preserved. Scales, if any, must be uniform.
+//
+// After each joint rotation is updated, limit, if not nil, is called with the
+// index of the joint and its new local rotation, and returns the rotation to
+// use instead, e.g. with ConstrainToCone and ConstrainTwist. The solvers stop
+// after the given number of iterations or once the end effector is within
+// tolerance of the target, and report whether it is.
+
+// A JointLimit constrains the local rotation of joint i of an IK chain.
+type JointLimit func(i int, rotation Quat) Quat
+
+// SolveCCD poses the chain with cyclic coordinate descent: from the joint
+// nearest the end effector to the root, each joint in turn rotates to point
+// the end effector at the target. It converges quickly for short chains and
+// tends to curl long ones.
+func SolveCCD(chain []Transform, target Vec3, iterations int, tolerance float32, limit JointLimit) bool {
+	if len(chain) < 2 {
+		return len(chain) == 1 && chain[0].Translation.Sub(target).Len() <= tolerance
+	}
+
+	world := make([]Mat4, len(chain))
+	for iter := 0; iter < iterations; iter++ {
+		chainWorld(world, chain)
+		if chainTip(world).Sub(target).Len() <= tolerance {
+			return true
+		}
+
+		for j := len(chain) - 2; j >= 0; j-- {
+			chainWorld(world, chain)
+			p := world[j].Col(3).Vec3()
+			rotateJoint(chain, j, QuatBetweenVectors(chainTip(world).Sub(p), target.Sub(p)), limit)
+		}
+	}
+
+	chainWorld(world, chain)
+	return chainTip(world).Sub(target).Len() <= tolerance
+}
+
+// SolveFABRIK poses the chain with forward and backward reaching inverse
+// kinematics (Aristidou and Lasenby, 2011): each iteration moves the joint
+// positions from the end effector to the target and back from the root,
+// keeping the bone lengths, then rotates the joints to match. It converges
+// with smoother, more natural poses than SolveCCD.
+func SolveFABRIK(chain []Transform, target Vec3, iterations int, tolerance float32, limit JointLimit) bool {
+	if len(chain) < 2 {
+		return len(chain) == 1 && chain[0].Translation.Sub(target).Len() <= tolerance
+	}
+
+	n := len(chain)
+	world := make([]Mat4, n)
+	pos := make([]Vec3, n)
+	lengths := make([]float32, n-1)
+
+	for iter := 0; iter < iterations; iter++ {
+		chainWorld(world, chain)
+		if chainTip(world).Sub(target).Len() <= tolerance {
+			return true
+		}
+
+		for i := range pos {
+			pos[i] = world[i].Col(3).Vec3()
+		}
+		for i := range lengths {
+			lengths[i] = pos[i+1].Sub(pos[i]).Len()
+		}
+		root := pos[0]
+
+		// Backward, from the end effector at the target.
+		pos[n-1] = target
+		for i := n - 2; i >= 0; i-- {
+			pos[i] = fabrikReach(pos[i+1], pos[i], lengths[i])
+		}
+		// Forward, from the root back at its place.
+		pos[0] = root
+		for i := 1; i < n; i++ {
+			pos[i] = fabrikReach(pos[i-1], pos[i], lengths[i-1])
+		}
+
+		for j := 0; j < n-1; j++ {
+			chainWorld(world, chain)
+			p := world[j].Col(3).Vec3()
+			rotateJoint(chain, j, QuatBetweenVectors(world[j+1].Col(3).Vec3().Sub(p), pos[j+1].Sub(p)), limit)
+		}
+	}
+
+	chainWorld(world, chain)
+	return chainTip(world).Sub(target).Len() <= tolerance
+}
+
+// fabrikReach returns the point at distance length from fixed towards p.
+func fabrikReach(fixed, p Vec3, length float32) Vec3 {
+	d := p.Sub(fixed)
+	l := d.Len()
+	if l == 0 {
+		return fixed
+	}
+	return fixed.Add(d.Mul(length / l))
+}
+
+// chainWorld stores in world the world transforms of the joints of chain.
+func chainWorld(world []Mat4, chain []Transform) {
+	for i, t := range chain {
+		if i == 0 {
+			world[i] = t.Mat4()
+		} else {
+			world[i] = world[i-1].Mul4(t.Mat4())
+		}
+	}
+}
+
+func chainTip(world []Mat4) Vec3 {
+	return world[len(world)-1].Col(3).Vec3()
+}
+
+// rotateJoint applies the world space rotation delta to joint j of chain,
+// followed by its limit.
+func rotateJoint(chain []Transform, j int, delta Quat, limit JointLimit) {
+	parent := QuatIdent()
+	for i := 0; i < j; i++ {
+		parent = parent.Mul(chain[i].Rotation)
+	}
+
+	r := parent.Inverse().Mul(delta).Mul(parent).Mul(chain[j].Rotation).Normalize()
+	if limit != nil {
+		r = limit(j, r)
+	}
+	chain[j].Rotation = r
+}
@@ -0,0 +1,80 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+// ikChain returns a straight chain of n joints one unit apart along x,
+// starting at root.
+func ikChain(root Vec3, n int) []Transform {
+	chain := make([]Transform, n)
+	for i := range chain {
+		chain[i] = TransformIdent()
+		chain[i].Translation = Vec3{1, 0, 0}
+	}
+	chain[0].Translation = root
+	return chain
+}
+
+func TestIKSolvers(t *testing.T) {
+	t.Parallel()
+
+	solvers := []struct {
+		name  string
+		solve func([]Transform, Vec3, int, float32, JointLimit) bool
+	}{
+		{"SolveCCD", SolveCCD},
+		{"SolveFABRIK", SolveFABRIK},
+	}
+	root := Vec3{1, 2, 3}
+
+	for _, s := range solvers {
+		for _, target := range []Vec3{{3, 4, 3}, {1, 2, 6}, {-1, 2, 3.5}, {2.5, 0.5, 2}} {
+			chain := ikChain(root, 5)
+			if !s.solve(chain, target, 100, 1e-3, nil) {
+				t.Errorf("%s(%v) didn't reach the target", s.name, target)
+			}
+
+			world := make([]Mat4, len(chain))
+			chainWorld(world, chain)
+			if tip := chainTip(world); tip.Sub(target).Len() > 1e-3 {
+				t.Errorf("%s(%v) left the end effector at %v", s.name, target, tip)
+			}
+			if p := world[0].Col(3).Vec3(); !p.ApproxFuncEqual(root, absEqual(1e-5)) {
+				t.Errorf("%s(%v) moved the root to %v", s.name, target, p)
+			}
+			for i := 1; i < len(world); i++ {
+				if l := world[i].Col(3).Vec3().Sub(world[i-1].Col(3).Vec3()).Len(); !FloatEqualThreshold(l, 1, 1e-4) {
+					t.Errorf("%s(%v) changed bone %d to length %v", s.name, target, i, l)
+				}
+			}
+		}
+
+		// Out of reach, the chain stretches towards the target.
+		chain := ikChain(root, 3)
+		target := root.Add(Vec3{0, 10, 0})
+		if s.solve(chain, target, 50, 1e-3, nil) {
+			t.Errorf("%s(%v) reports reaching an unreachable target", s.name, target)
+		}
+		world := make([]Mat4, len(chain))
+		chainWorld(world, chain)
+		if tip, want := chainTip(world), root.Add(Vec3{0, 2, 0}); !tip.ApproxFuncEqual(want, absEqual(1e-2)) {
+			t.Errorf("%s(%v) stretched the end effector to %v, want %v", s.name, target, tip, want)
+		}
+
+		// A cone limit keeps every bone within 45 degrees of its parent.
+		chain = ikChain(root, 4)
+		limit := func(i int, r Quat) Quat { return ConstrainToCone(r, Vec3{1, 0, 0}, math.Pi/4) }
+		s.solve(chain, root.Add(Vec3{0, 0, 2}), 100, 1e-3, limit)
+		for i, j := range chain[:len(chain)-1] {
+			if d := j.Rotation.Rotate(Vec3{1, 0, 0}).Dot(Vec3{1, 0, 0}); d < float32(math.Cos(math.Pi/4))-1e-4 {
+				t.Errorf("%s with a cone limit bent joint %d beyond it: %v", s.name, i, j.Rotation)
+			}
+		}
+	}
+}
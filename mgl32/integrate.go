@@ -0,0 +1,99 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// PointState is the state of a point mass: its position and velocity.
+type PointState struct {
+	Position, Velocity Vec3
+}
+
+// RotationState is the rotational state of a body: its orientation and its
+// angular velocity in world space, in radians per unit of time.
+type RotationState struct {
+	Orientation     Quat
+	AngularVelocity Vec3
+}
+
+// IntegrateVerlet returns the position after pos of a point that was at
+// prevPos one step of dt earlier, under the acceleration accel: the position
+// (Stormer) Verlet step 2*pos - prevPos + accel*dt^2. The velocity is implicit
+// in the two positions, which makes this the usual choice for cloth, ropes
+// and other constraint based particle systems: moving a particle to satisfy a
+// constraint updates its velocity for free.
+func IntegrateVerlet(pos, prevPos, accel Vec3, dt float32) Vec3 {
+	return pos.Mul(2).Sub(prevPos).Add(accel.Mul(dt * dt))
+}
+
+// IntegrateVelocityVerlet advances s by dt under the position dependent
+// acceleration accel, with the velocity Verlet method. It is second order,
+// evaluates accel twice per step, and as a symplectic method keeps the energy
+// of orbits and springs from drifting over long simulations.
+func IntegrateVelocityVerlet(s PointState, accel func(pos Vec3) Vec3, dt float32) PointState {
+	a0 := accel(s.Position)
+	pos := s.Position.Add(s.Velocity.Mul(dt)).Add(a0.Mul(dt * dt / 2))
+	a1 := accel(pos)
+	return PointState{pos, s.Velocity.Add(a0.Add(a1).Mul(dt / 2))}
+}
+
+// IntegrateRK4 advances s from time t to t+dt under the acceleration accel,
+// which may depend on the state and time (drag, springs, thrust...), with the
+// classic fourth order Runge-Kutta method. It evaluates accel four times per
+// step, but is much more accurate than the other integrators for a given dt.
+func IntegrateRK4(s PointState, accel func(s PointState, t float32) Vec3, t, dt float32) PointState {
+	deriv := func(s PointState, t float32) PointState {
+		return PointState{s.Velocity, accel(s, t)}
+	}
+	step := func(s, d PointState, h float32) PointState {
+		return PointState{s.Position.Add(d.Position.Mul(h)), s.Velocity.Add(d.Velocity.Mul(h))}
+	}
+
+	k1 := deriv(s, t)
+	k2 := deriv(step(s, k1, dt/2), t+dt/2)
+	k3 := deriv(step(s, k2, dt/2), t+dt/2)
+	k4 := deriv(step(s, k3, dt), t+dt)
+
+	return PointState{
+		s.Position.Add(k1.Position.Add(k2.Position.Mul(2)).Add(k3.Position.Mul(2)).Add(k4.Position).Mul(dt / 6)),
+		s.Velocity.Add(k1.Velocity.Add(k2.Velocity.Mul(2)).Add(k3.Velocity.Mul(2)).Add(k4.Velocity).Mul(dt / 6)),
+	}
+}
+
+// IntegrateRK4Rotation advances s from time t to t+dt under the angular
+// acceleration accel, which may depend on the state and time, with the
+// fourth order Runge-Kutta method applied to the quaternion derivative
+// omega*q/2. The orientation is normalized after the step, and in the states
+// passed to accel.
+func IntegrateRK4Rotation(s RotationState, accel func(s RotationState, t float32) Vec3, t, dt float32) RotationState {
+	type deriv struct {
+		q Quat
+		w Vec3
+	}
+	eval := func(s RotationState, t float32) deriv {
+		return deriv{Quat{0, s.AngularVelocity}.Mul(s.Orientation).Scale(0.5), accel(s, t)}
+	}
+	step := func(s RotationState, d deriv, h float32) RotationState {
+		return RotationState{s.Orientation.Add(d.q.Scale(h)).Normalize(), s.AngularVelocity.Add(d.w.Mul(h))}
+	}
+
+	k1 := eval(s, t)
+	k2 := eval(step(s, k1, dt/2), t+dt/2)
+	k3 := eval(step(s, k2, dt/2), t+dt/2)
+	k4 := eval(step(s, k3, dt), t+dt)
+
+	return RotationState{
+		s.Orientation.Add(k1.q.Add(k2.q.Scale(2)).Add(k3.q.Scale(2)).Add(k4.q).Scale(dt / 6)).Normalize(),
+		s.AngularVelocity.Add(k1.w.Add(k2.w.Mul(2)).Add(k3.w.Mul(2)).Add(k4.w).Mul(dt / 6)),
+	}
+}
+
+// IntegrateAngularVelocity advances the orientation q by dt under the constant
+// angular velocity omega, given in world space in radians per unit of time,
+// with the exponential map: exp(omega*dt/2) * q. Unlike adding the derivative
+// omega*q/2 times dt, this is exact and keeps q a rotation however large the
+// step. For an angular velocity in the body's own frame, multiply on the other
+// side instead: q.Mul(QuatExp(omega.Mul(dt / 2))).
+func IntegrateAngularVelocity(q Quat, omega Vec3, dt float32) Quat {
+	return QuatExp(omega.Mul(dt / 2)).Mul(q).Normalize()
+}
@@ -0,0 +1,115 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntegrateVerlet(t *testing.T) {
+	t.Parallel()
+
+	// Under constant acceleration, Verlet is exact.
+	g := Vec3{0, -9.81, 0}
+	v0 := Vec3{3, 5, -1}
+	const dt = 0.1
+	prev, pos := Vec3{}, v0.Mul(dt).Add(g.Mul(dt*dt/2))
+	for i := 2; i <= 20; i++ {
+		prev, pos = pos, IntegrateVerlet(pos, prev, g, dt)
+	}
+	if want := BallisticPosition(Vec3{}, v0, g, 20*dt); !pos.ApproxFuncEqual(want, absEqual(1e-4)) {
+		t.Errorf("IntegrateVerlet reached %v, want %v", pos, want)
+	}
+}
+
+// spring is the acceleration of a unit mass on a unit spring to the origin.
+func spring(p Vec3) Vec3 {
+	return p.Mul(-1)
+}
+
+func TestIntegrateVelocityVerlet(t *testing.T) {
+	t.Parallel()
+
+	// A full period of the spring keeps the energy and returns to the start.
+	s := PointState{Vec3{1, 0, 0}, Vec3{0, 1, 0}}
+	const n = 1000
+	dt := float32(2 * math.Pi / n)
+	for i := 0; i < n; i++ {
+		s = IntegrateVelocityVerlet(s, spring, dt)
+		if e := s.Position.LenSqr() + s.Velocity.LenSqr(); Abs(e-2) > 1e-4 {
+			t.Fatalf("energy drifted to %v after %d steps", e/2, i)
+		}
+	}
+	if !s.Position.ApproxFuncEqual(Vec3{1, 0, 0}, absEqual(1e-3)) {
+		t.Errorf("IntegrateVelocityVerlet ended a period at %v", s.Position)
+	}
+}
+
+func TestIntegrateRK4(t *testing.T) {
+	t.Parallel()
+
+	// A damped, driven oscillator x'' = -x - x' + cos(t) with the steady
+	// state solution x = sin(t).
+	accel := func(s PointState, t float32) Vec3 {
+		return s.Position.Mul(-1).Sub(s.Velocity).Add(Vec3{float32(math.Cos(float64(t))), 0, 0})
+	}
+	s := PointState{Vec3{0, 0, 0}, Vec3{1, 0, 0}}
+	const dt = 0.05
+	var tm float32
+	for i := 0; i < 200; i++ {
+		s = IntegrateRK4(s, accel, tm, dt)
+		tm += dt
+	}
+	if want := float32(math.Sin(float64(tm))); Abs(s.Position[0]-want) > 1e-5 {
+		t.Errorf("IntegrateRK4 reached %v at t=%v, want %v", s.Position[0], tm, want)
+	}
+}
+
+func TestIntegrateRK4Rotation(t *testing.T) {
+	t.Parallel()
+
+	// Constant spin matches the exact rotation.
+	s := RotationState{QuatRotate(0.3, Vec3{1, 0, 0}), Vec3{0.5, -1, 2}}
+	noTorque := func(RotationState, float32) Vec3 { return Vec3{} }
+	for i := 0; i < 100; i++ {
+		s = IntegrateRK4Rotation(s, noTorque, 0, 0.02)
+	}
+	if want := ExtrapolateQuat(QuatRotate(0.3, Vec3{1, 0, 0}), Vec3{0.5, -1, 2}, 2); s.Orientation.BoxMinus(want).Len() > 1e-4 {
+		t.Errorf("IntegrateRK4Rotation reached %v, want %v", s.Orientation, want)
+	}
+
+	// Constant angular acceleration about a fixed axis.
+	axis := Vec3{0, 0, 1}
+	s = RotationState{QuatIdent(), Vec3{}}
+	for i := 0; i < 100; i++ {
+		s = IntegrateRK4Rotation(s, func(RotationState, float32) Vec3 { return axis }, 0, 0.01)
+	}
+	if want := QuatRotate(0.5, axis); s.Orientation.BoxMinus(want).Len() > 1e-4 || !s.AngularVelocity.ApproxEqualThreshold(axis, 1e-5) {
+		t.Errorf("IntegrateRK4Rotation under constant acceleration reached %v, %v", s.Orientation, s.AngularVelocity)
+	}
+}
+
+func TestIntegrateAngularVelocity(t *testing.T) {
+	t.Parallel()
+
+	start := QuatRotate(0.4, Vec3{1, 0, 0})
+	omega := Vec3{0, 3, 4} // 5 radians per second about (0, 0.6, 0.8)
+
+	q := start
+	for i := 0; i < 100; i++ {
+		q = IntegrateAngularVelocity(q, omega, 0.01)
+	}
+	want := QuatRotate(5, omega.Normalize()).Mul(start)
+	if !q.OrientationEqualThreshold(want, 1e-5) {
+		t.Errorf("IntegrateAngularVelocity in 100 steps reached %v, want %v", q, want)
+	}
+	if big := IntegrateAngularVelocity(start, omega, 1); !big.OrientationEqualThreshold(want, 1e-5) || !FloatEqualThreshold(big.Len(), 1, 1e-6) {
+		t.Errorf("IntegrateAngularVelocity in one step reached %v, want %v", big, want)
+	}
+	if back := AngularVelocity(start, IntegrateAngularVelocity(start, omega, 0.2), 0.2); !back.ApproxEqualThreshold(omega, 1e-4) {
+		t.Errorf("AngularVelocity of the step = %v, want %v", back, omega)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// InterpolateVec3 linearly interpolates between the state of a vector at the
+// previous and current simulation ticks. Alpha is the fraction of a tick
+// elapsed since curr was computed, usually accumulator/dt in a fixed-timestep
+// loop, so alpha = 0 yields prev and alpha = 1 yields curr.
+func InterpolateVec3(prev, curr Vec3, alpha float32) Vec3 {
+	return prev.Add(curr.Sub(prev).Mul(alpha))
+}
+
+// InterpolateQuat interpolates between the orientation at the previous and
+// current simulation ticks along the shortest arc, with alpha as for
+// InterpolateVec3.
+func InterpolateQuat(prev, curr Quat, alpha float32) Quat {
+	return QuatSlerp(prev, curr, alpha)
+}
+
+// InterpolateTransform blends between the state of a body at the previous and
+// current simulation ticks for rendering. Translation and scale are
+// interpolated linearly and rotation along the shortest arc, with alpha as
+// for InterpolateVec3.
+func InterpolateTransform(prev, curr Transform, alpha float32) Transform {
+	return Transform{
+		Translation: InterpolateVec3(prev.Translation, curr.Translation, alpha),
+		Rotation:    InterpolateQuat(prev.Rotation, curr.Rotation, alpha),
+		Scale:       InterpolateVec3(prev.Scale, curr.Scale, alpha),
+	}
+}
+
+// ExtrapolateVec3 predicts the position dt seconds after p for a body moving
+// with constant velocity v.
+func ExtrapolateVec3(p, v Vec3, dt float32) Vec3 {
+	return p.Add(v.Mul(dt))
+}
+
+// ExtrapolateQuat predicts the orientation dt seconds after q for a body
+// spinning with constant angular velocity omega, given in world space in
+// radians per second. The rotation is integrated exactly, as in
+// IntegrateAngularVelocity, rather than with the usual first order
+// approximation, so large steps don't denormalize the result.
+func ExtrapolateQuat(q Quat, omega Vec3, dt float32) Quat {
+	if omega.Len()*dt == 0 {
+		return q
+	}
+	return IntegrateAngularVelocity(q, omega, dt)
+}
+
+// AngularVelocity returns the constant world space angular velocity, in
+// radians per unit of time, that takes orientation prev to curr in dt. The
+// shortest rotation between the two is used. It is the inverse of
+// ExtrapolateQuat.
+func AngularVelocity(prev, curr Quat, dt float32) Vec3 {
+	delta := curr.Mul(prev.Inverse()).Normalize()
+	if delta.W < 0 {
+		delta = delta.Scale(-1)
+	}
+	s := delta.V.Len()
+	if s == 0 {
+		return Vec3{}
+	}
+	angle := 2 * float32(math.Atan2(float64(s), float64(delta.W)))
+	return delta.V.Mul(angle / (s * dt))
+}
+
+// ExtrapolateTransform predicts the state of a body dt seconds after t when
+// it moves with constant linear velocity linVel and spins with constant world
+// space angular velocity angVel, as in ExtrapolateVec3 and ExtrapolateQuat.
+// The scale is kept. This is the dead reckoning of a networked entity between
+// updates.
+func ExtrapolateTransform(t Transform, linVel, angVel Vec3, dt float32) Transform {
+	return Transform{
+		Translation: ExtrapolateVec3(t.Translation, linVel, dt),
+		Rotation:    ExtrapolateQuat(t.Rotation, angVel, dt),
+		Scale:       t.Scale,
+	}
+}
+
+// ProjectiveVelocityBlend smooths the correction of a dead reckoned position
+// when an update arrives, using the projective velocity blending of Murphy's
+// "Believable Dead Reckoning for Networked Games". p0 and v0 are the position
+// and velocity shown when the update arrived, and p1, v1 and a1 the position,
+// velocity and acceleration it carries. It returns the position and velocity
+// to show t seconds later: over blendTime seconds, the projection from p0 with
+// a velocity blending from v0 to v1 is blended into the projection of the
+// update, so the entity neither jumps nor changes speed abruptly. After
+// blendTime, this is the projection of the update.
+//
+// When the next update arrives, the returned values are its p0 and v0.
+func ProjectiveVelocityBlend(p0, v0, p1, v1, a1 Vec3, t, blendTime float32) (pos, vel Vec3) {
+	alpha := float32(1)
+	if blendTime > 0 {
+		alpha = Clamp(t/blendTime, 0, 1)
+	}
+
+	vb := v0.Add(v1.Sub(v0).Mul(alpha))
+	accel := a1.Mul(t * t / 2)
+	fromShown := p0.Add(vb.Mul(t)).Add(accel)
+	fromUpdate := p1.Add(v1.Mul(t)).Add(accel)
+
+	return InterpolateVec3(fromShown, fromUpdate, alpha), vb.Add(a1.Mul(t))
+}
+
+// ProjectiveOrientationBlend is the rotational counterpart of
+// ProjectiveVelocityBlend: q0 and omega0 are the orientation and world space
+// angular velocity shown when an update with orientation q1 and angular
+// velocity omega1 arrived. It returns the orientation and angular velocity to
+// show t seconds later.
+func ProjectiveOrientationBlend(q0 Quat, omega0 Vec3, q1 Quat, omega1 Vec3, t, blendTime float32) (rot Quat, omega Vec3) {
+	alpha := float32(1)
+	if blendTime > 0 {
+		alpha = Clamp(t/blendTime, 0, 1)
+	}
+
+	ob := omega0.Add(omega1.Sub(omega0).Mul(alpha))
+	fromShown := ExtrapolateQuat(q0, ob, t)
+	fromUpdate := ExtrapolateQuat(q1, omega1, t)
+
+	return InterpolateQuat(fromShown, fromUpdate, alpha), ob
+}
@@ -0,0 +1,145 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInterpolateTransform(t *testing.T) {
+	t.Parallel()
+
+	prev := Transform{Vec3{0, 0, 0}, QuatIdent(), Vec3{1, 1, 1}}
+	curr := Transform{Vec3{2, 4, -2}, QuatRotate(math.Pi/2, Vec3{0, 1, 0}), Vec3{3, 1, 1}}
+
+	tests := []struct {
+		Alpha float32
+		Want  Transform
+	}{
+		{0, prev},
+		{1, curr},
+		{0.5, Transform{Vec3{1, 2, -1}, QuatRotate(math.Pi/4, Vec3{0, 1, 0}), Vec3{2, 1, 1}}},
+	}
+
+	for _, c := range tests {
+		got := InterpolateTransform(prev, curr, c.Alpha)
+		if !got.Translation.ApproxEqualThreshold(c.Want.Translation, 1e-4) ||
+			!got.Rotation.OrientationEqualThreshold(c.Want.Rotation, 1e-4) ||
+			!got.Scale.ApproxEqualThreshold(c.Want.Scale, 1e-4) {
+			t.Errorf("InterpolateTransform(%v) = %v, expected %v", c.Alpha, got, c.Want)
+		}
+	}
+}
+
+func TestExtrapolateQuat(t *testing.T) {
+	t.Parallel()
+
+	q := QuatRotate(0.3, Vec3{1, 0, 0})
+	omega := Vec3{0, 0, 2}
+
+	got := ExtrapolateQuat(q, omega, 0.25)
+	want := QuatRotate(0.5, Vec3{0, 0, 1}).Mul(q)
+	if !got.OrientationEqualThreshold(want, 1e-4) {
+		t.Errorf("ExtrapolateQuat = %v, expected %v", got, want)
+	}
+
+	if got := ExtrapolateQuat(q, Vec3{}, 1); got != q {
+		t.Errorf("ExtrapolateQuat with zero angular velocity changed the orientation: %v", got)
+	}
+
+	// A full turn and a bit must not lose its length.
+	if l := ExtrapolateQuat(q, omega, 4).Len(); Abs(l-1) > 1e-4 {
+		t.Errorf("ExtrapolateQuat returned a quaternion of length %v", l)
+	}
+}
+
+func TestAngularVelocity(t *testing.T) {
+	t.Parallel()
+
+	prev := QuatRotate(0.4, Vec3{0, 1, 0})
+	omega := Vec3{1, -2, 0.5}
+	curr := ExtrapolateQuat(prev, omega, 0.1)
+
+	if got := AngularVelocity(prev, curr, 0.1); !got.ApproxEqualThreshold(omega, 1e-3) {
+		t.Errorf("AngularVelocity = %v, expected %v", got, omega)
+	}
+
+	// The sign of the quaternion must not matter.
+	if got := AngularVelocity(prev, curr.Scale(-1), 0.1); !got.ApproxEqualThreshold(omega, 1e-3) {
+		t.Errorf("AngularVelocity with negated quaternion = %v, expected %v", got, omega)
+	}
+
+	if got := AngularVelocity(prev, prev, 0.1); got != (Vec3{}) {
+		t.Errorf("AngularVelocity between equal orientations = %v, expected zero", got)
+	}
+}
+
+func TestExtrapolateTransform(t *testing.T) {
+	t.Parallel()
+
+	tr := Transform{Vec3{1, 2, 3}, QuatRotate(0.4, Vec3{0, 1, 0}), Vec3{2, 2, 2}}
+	got := ExtrapolateTransform(tr, Vec3{1, 0, -2}, Vec3{0, 0.5, 0}, 2)
+	want := Transform{Vec3{3, 2, -1}, QuatRotate(1.4, Vec3{0, 1, 0}), Vec3{2, 2, 2}}
+	if !got.Translation.ApproxEqualThreshold(want.Translation, 1e-4) ||
+		!got.Rotation.OrientationEqualThreshold(want.Rotation, 1e-4) ||
+		got.Scale != want.Scale {
+		t.Errorf("ExtrapolateTransform = %v, expected %v", got, want)
+	}
+}
+
+func TestProjectiveVelocityBlend(t *testing.T) {
+	t.Parallel()
+
+	p0, v0 := Vec3{0, 0, 0}, Vec3{1, 0, 0}
+	p1, v1, a1 := Vec3{0.5, 1, 0}, Vec3{0, 2, 0}, Vec3{0, 0, -1}
+	const blendTime = 0.5
+
+	// No jump when the update arrives.
+	if pos, vel := ProjectiveVelocityBlend(p0, v0, p1, v1, a1, 0, blendTime); pos != p0 || vel != v0 {
+		t.Errorf("ProjectiveVelocityBlend at 0 = %v, %v; expected %v, %v", pos, vel, p0, v0)
+	}
+
+	// Once the blend is done, the update's projection is followed.
+	for _, tt := range []float32{blendTime, 1, 3} {
+		pos, vel := ProjectiveVelocityBlend(p0, v0, p1, v1, a1, tt, blendTime)
+		want := p1.Add(v1.Mul(tt)).Add(a1.Mul(tt * tt / 2))
+		if !pos.ApproxEqualThreshold(want, 1e-5) || !vel.ApproxEqualThreshold(v1.Add(a1.Mul(tt)), 1e-5) {
+			t.Errorf("ProjectiveVelocityBlend at %v = %v, %v; expected %v, %v", tt, pos, vel, want, v1.Add(a1.Mul(tt)))
+		}
+	}
+
+	// The path in between is continuous.
+	prev, _ := ProjectiveVelocityBlend(p0, v0, p1, v1, a1, 0, blendTime)
+	for i := 1; i <= 100; i++ {
+		pos, _ := ProjectiveVelocityBlend(p0, v0, p1, v1, a1, float32(i)*0.01, blendTime)
+		if d := pos.Sub(prev).Len(); d > 0.05 {
+			t.Errorf("ProjectiveVelocityBlend jumps by %v at %v", d, float32(i)*0.01)
+		}
+		prev = pos
+	}
+
+	// Without blending, the update is snapped to.
+	if pos, _ := ProjectiveVelocityBlend(p0, v0, p1, v1, a1, 0, 0); pos != p1 {
+		t.Errorf("ProjectiveVelocityBlend with no blend time = %v, expected %v", pos, p1)
+	}
+}
+
+func TestProjectiveOrientationBlend(t *testing.T) {
+	t.Parallel()
+
+	q0, omega0 := QuatIdent(), Vec3{0, 1, 0}
+	q1, omega1 := QuatRotate(0.5, Vec3{1, 0, 0}), Vec3{0, 0, 1}
+	const blendTime = 0.25
+
+	if rot, omega := ProjectiveOrientationBlend(q0, omega0, q1, omega1, 0, blendTime); !rot.OrientationEqualThreshold(q0, 1e-5) || omega != omega0 {
+		t.Errorf("ProjectiveOrientationBlend at 0 = %v, %v; expected %v, %v", rot, omega, q0, omega0)
+	}
+
+	rot, omega := ProjectiveOrientationBlend(q0, omega0, q1, omega1, 1, blendTime)
+	if want := ExtrapolateQuat(q1, omega1, 1); !rot.OrientationEqualThreshold(want, 1e-5) || omega != omega1 {
+		t.Errorf("ProjectiveOrientationBlend after blending = %v, %v; expected %v, %v", rot, omega, want, omega1)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// The Jacobians of the exponential maps of SO(3) and SE(3) relate small
+// perturbations of a rotation vector or twist to increments of the rotation
+// or transform it parametrizes. For a small delta, to first order
+//
+//	QuatFromRotationVector(phi + delta) = QuatFromRotationVector(SO3LeftJacobian(phi) * delta) * QuatFromRotationVector(phi)
+//	QuatFromRotationVector(phi + delta) = QuatFromRotationVector(phi) * QuatFromRotationVector(SO3RightJacobian(phi) * delta)
+//
+// and likewise for twists and Twist.Exp. The right Jacobian goes with the
+// local perturbations of Quat.BoxPlus. The inverses map increments back to
+// perturbations of the parameters, as needed by Gauss-Newton style solvers
+// for pose graphs and bundle adjustment.
+
+// SO3LeftJacobian returns the left Jacobian of the rotation vector phi,
+// I + (1-cos t)/t^2 [phi]x + (t-sin t)/t^3 [phi]x^2 with t = |phi|.
+func SO3LeftJacobian(phi Vec3) Mat3 {
+	_, b, c := so3Coeffs(float64(phi.Dot(phi)))
+	return so3Mat3(CrossMat3(phi), b, c)
+}
+
+// SO3RightJacobian returns the right Jacobian of the rotation vector phi,
+// which is SO3LeftJacobian(-phi).
+func SO3RightJacobian(phi Vec3) Mat3 {
+	return SO3LeftJacobian(phi.Mul(-1))
+}
+
+// SO3LeftJacobianInv returns the inverse of SO3LeftJacobian(phi). It is
+// singular at |phi| = 2*Pi, outside the range of Quat.ToRotationVector.
+func SO3LeftJacobianInv(phi Vec3) Mat3 {
+	theta2 := float64(phi.Dot(phi))
+
+	var d float64
+	if theta2 < 1e-6 {
+		d = 1.0/12 + theta2/720
+	} else {
+		a, b, _ := so3Coeffs(theta2)
+		d = (1 - a/(2*b)) / theta2
+	}
+
+	return so3Mat3(CrossMat3(phi), -0.5, d)
+}
+
+// SO3RightJacobianInv returns the inverse of SO3RightJacobian(phi), which is
+// SO3LeftJacobianInv(-phi).
+func SO3RightJacobianInv(phi Vec3) Mat3 {
+	return SO3LeftJacobianInv(phi.Mul(-1))
+}
+
+// se3Q returns the lower left block of the left Jacobian of the twist t.
+func se3Q(t Twist) Mat3 {
+	w, v := CrossMat3(t.Angular), CrossMat3(t.Linear)
+	theta2 := float64(t.Angular.Dot(t.Angular))
+
+	var c1, c2, c3 float64
+	if theta2 < 1e-4 {
+		c1 = 1.0/6 - theta2/120
+		c2 = 1.0/24 - theta2/720
+		c3 = 1.0/120 - theta2/2520
+	} else {
+		theta := math.Sqrt(theta2)
+		sin, cos := math.Sincos(theta)
+		c1 = (theta - sin) / (theta2 * theta)
+		c2 = (theta2 + 2*cos - 2) / (2 * theta2 * theta2)
+		c3 = (2*theta - 3*sin + theta*cos) / (2 * theta2 * theta2 * theta)
+	}
+
+	wv, vw := w.Mul3(v), v.Mul3(w)
+	wvw := wv.Mul3(w)
+	ww := w.Mul3(w)
+
+	return v.Mul(0.5).
+		Add(wv.Add(vw).Add(wvw).Mul(float32(c1))).
+		Add(w.Mul3(wv).Add(vw.Mul3(w)).Sub(wvw.Mul(3)).Mul(float32(c2))).
+		Add(wvw.Mul3(w).Add(ww.Mul3(vw)).Mul(float32(c3)))
+}
+
+// se3Jacobian stores the 6x6 matrix [[j, 0], [q, j]] in dst.
+func se3Jacobian(dst *MatMxN, j, q Mat3) *MatMxN {
+	dst = dst.Reshape(6, 6)
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			dst.Set(r, c, j.At(r, c))
+			dst.Set(r, c+3, 0)
+			dst.Set(r+3, c, q.At(r, c))
+			dst.Set(r+3, c+3, j.At(r, c))
+		}
+	}
+	return dst
+}
+
+// SE3LeftJacobian stores in dst the 6x6 left Jacobian of the twist t, in the
+// angular-first order of Twist.VecN, reallocating as necessary.
+func SE3LeftJacobian(dst *MatMxN, t Twist) *MatMxN {
+	return se3Jacobian(dst, SO3LeftJacobian(t.Angular), se3Q(t))
+}
+
+// SE3RightJacobian stores in dst the 6x6 right Jacobian of the twist t, which
+// is SE3LeftJacobian of -t, reallocating as necessary.
+func SE3RightJacobian(dst *MatMxN, t Twist) *MatMxN {
+	return SE3LeftJacobian(dst, t.Mul(-1))
+}
+
+// SE3LeftJacobianInv stores in dst the inverse of SE3LeftJacobian(t),
+// reallocating as necessary.
+func SE3LeftJacobianInv(dst *MatMxN, t Twist) *MatMxN {
+	jinv := SO3LeftJacobianInv(t.Angular)
+	return se3Jacobian(dst, jinv, jinv.Mul3(se3Q(t)).Mul3(jinv).Mul(-1))
+}
+
+// SE3RightJacobianInv stores in dst the inverse of SE3RightJacobian(t),
+// reallocating as necessary.
+func SE3RightJacobianInv(dst *MatMxN, t Twist) *MatMxN {
+	return SE3LeftJacobianInv(dst, t.Mul(-1))
+}
@@ -0,0 +1,78 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "testing"
+
+var jacobianPerturbations = []Vec3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {0.3, -0.5, 0.8}}
+
+func TestSO3Jacobians(t *testing.T) {
+	t.Parallel()
+
+	const h = 1e-3
+	for _, tw := range twistTests {
+		phi := tw.Angular
+		q := QuatFromRotationVector(phi)
+		jl, jr := SO3LeftJacobian(phi), SO3RightJacobian(phi)
+
+		for _, d := range jacobianPerturbations {
+			qd := QuatFromRotationVector(phi.Add(d.Mul(h)))
+			if got, want := qd.Mul(q.Conjugate()).ToRotationVector().Mul(1/h), jl.Mul3x1(d); !got.ApproxFuncEqual(want, absEqual(5e-3)) {
+				t.Errorf("SO3LeftJacobian(%v) * %v = %v, want %v", phi, d, want, got)
+			}
+			if got, want := qd.BoxMinus(q).Mul(1/h), jr.Mul3x1(d); !got.ApproxFuncEqual(want, absEqual(5e-3)) {
+				t.Errorf("SO3RightJacobian(%v) * %v = %v, want %v", phi, d, want, got)
+			}
+		}
+
+		if got := jl.Mul3(SO3LeftJacobianInv(phi)); !got.ApproxFuncEqual(Ident3(), absEqual(1e-5)) {
+			t.Errorf("SO3LeftJacobian(%v) * SO3LeftJacobianInv = %v", phi, got)
+		}
+		if got := jr.Mul3(SO3RightJacobianInv(phi)); !got.ApproxFuncEqual(Ident3(), absEqual(1e-5)) {
+			t.Errorf("SO3RightJacobian(%v) * SO3RightJacobianInv = %v", phi, got)
+		}
+		if got, want := q.Mat4().Mat3().Mul3(jr), jl; !got.ApproxFuncEqual(want, absEqual(1e-5)) {
+			t.Errorf("R * SO3RightJacobian(%v) = %v, want %v", phi, got, want)
+		}
+	}
+}
+
+func TestSE3Jacobians(t *testing.T) {
+	t.Parallel()
+
+	const h = 1e-3
+	var ident *MatMxN
+	ident = IdentN(ident, 6)
+	for _, tw := range twistTests {
+		m := tw.Exp()
+		jl, jr := SE3LeftJacobian(nil, tw), SE3RightJacobian(nil, tw)
+
+		for _, w := range jacobianPerturbations {
+			for _, v := range jacobianPerturbations {
+				d := Twist{w, v}
+				md := tw.Add(d.Mul(h)).Exp()
+
+				got := Mat4ToTwist(md.Mul4(m.Inv())).Mul(1 / h)
+				want := TwistFromVecN(jl.MulNx1(nil, d.VecN(nil)))
+				if !got.Angular.ApproxFuncEqual(want.Angular, absEqual(5e-3)) || !got.Linear.ApproxFuncEqual(want.Linear, absEqual(1e-2)) {
+					t.Errorf("SE3LeftJacobian(%v) * %v = %v, want %v", tw, d, want, got)
+				}
+
+				got = Mat4ToTwist(m.Inv().Mul4(md)).Mul(1 / h)
+				want = TwistFromVecN(jr.MulNx1(nil, d.VecN(nil)))
+				if !got.Angular.ApproxFuncEqual(want.Angular, absEqual(5e-3)) || !got.Linear.ApproxFuncEqual(want.Linear, absEqual(1e-2)) {
+					t.Errorf("SE3RightJacobian(%v) * %v = %v, want %v", tw, d, want, got)
+				}
+			}
+		}
+
+		if got := jl.MulMxN(nil, SE3LeftJacobianInv(nil, tw)); !got.ApproxEqualFunc(ident, absEqual(1e-4)) {
+			t.Errorf("SE3LeftJacobian(%v) * SE3LeftJacobianInv = %v", tw, got.Raw())
+		}
+		if got := jr.MulMxN(nil, SE3RightJacobianInv(nil, tw)); !got.ApproxEqualFunc(ident, absEqual(1e-4)) {
+			t.Errorf("SE3RightJacobian(%v) * SE3RightJacobianInv = %v", tw, got.Raw())
+		}
+	}
+}
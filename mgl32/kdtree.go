@@ -0,0 +1,95 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"sort"
+)
+
+// KDTree3 is a static kd-tree over a set of 3D points, answering nearest
+// neighbor queries in (on average) logarithmic time.
+//
+// The tree is stored implicitly as a permutation of the point indices, with
+// the median of every range being the splitting node, so it needs no
+// allocations beyond a copy of the points and a slice of indices.
+type KDTree3 struct {
+	points []Vec3
+	order  []int
+}
+
+// NewKDTree3 builds a kd-tree over a copy of the given points. Indices returned
+// by the tree's queries refer to the position in this slice.
+func NewKDTree3(points []Vec3) *KDTree3 {
+	tree := &KDTree3{
+		points: append([]Vec3(nil), points...),
+		order:  make([]int, len(points)),
+	}
+	for i := range tree.order {
+		tree.order[i] = i
+	}
+	tree.build(0, len(points), 0)
+
+	return tree
+}
+
+func (tree *KDTree3) build(lo, hi, axis int) {
+	if hi-lo <= 1 {
+		return
+	}
+
+	nodes := tree.order[lo:hi]
+	sort.Slice(nodes, func(i, j int) bool {
+		return tree.points[nodes[i]][axis] < tree.points[nodes[j]][axis]
+	})
+
+	mid := (lo + hi) / 2
+	tree.build(lo, mid, (axis+1)%3)
+	tree.build(mid+1, hi, (axis+1)%3)
+}
+
+// Len returns the number of points in the tree.
+func (tree *KDTree3) Len() int {
+	return len(tree.points)
+}
+
+// Point returns the point with the given index.
+func (tree *KDTree3) Point(i int) Vec3 {
+	return tree.points[i]
+}
+
+// Nearest returns the index of the point closest to p, along with its squared
+// distance to p. If the tree is empty, the index is -1.
+func (tree *KDTree3) Nearest(p Vec3) (index int, distSqr float32) {
+	index, distSqr = -1, InfPos
+	tree.nearest(0, len(tree.order), 0, p, &index, &distSqr)
+
+	return index, distSqr
+}
+
+func (tree *KDTree3) nearest(lo, hi, axis int, p Vec3, best *int, bestDist *float32) {
+	if lo >= hi {
+		return
+	}
+
+	mid := (lo + hi) / 2
+	node := tree.order[mid]
+	if d := tree.points[node].Sub(p).LenSqr(); d < *bestDist {
+		*best, *bestDist = node, d
+	}
+
+	next := (axis + 1) % 3
+	diff := p[axis] - tree.points[node][axis]
+	if diff < 0 {
+		tree.nearest(lo, mid, next, p, best, bestDist)
+		if diff*diff < *bestDist {
+			tree.nearest(mid+1, hi, next, p, best, bestDist)
+		}
+	} else {
+		tree.nearest(mid+1, hi, next, p, best, bestDist)
+		if diff*diff < *bestDist {
+			tree.nearest(lo, mid, next, p, best, bestDist)
+		}
+	}
+}
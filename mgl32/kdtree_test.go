@@ -0,0 +1,41 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestKDTree3Nearest(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(7))
+	points := make([]Vec3, 500)
+	for i := range points {
+		points[i] = Vec3{r.Float32()*10 - 5, r.Float32()*10 - 5, r.Float32()*10 - 5}
+	}
+	tree := NewKDTree3(points)
+
+	for i := 0; i < 200; i++ {
+		p := Vec3{r.Float32()*12 - 6, r.Float32()*12 - 6, r.Float32()*12 - 6}
+
+		best, bestDist := -1, InfPos
+		for j, q := range points {
+			if d := q.Sub(p).LenSqr(); d < bestDist {
+				best, bestDist = j, d
+			}
+		}
+
+		index, dist := tree.Nearest(p)
+		if index != best || dist != bestDist {
+			t.Errorf("Nearest(%v) = %d (dist %v), brute force found %d (dist %v)", p, index, dist, best, bestDist)
+		}
+	}
+
+	if index, _ := NewKDTree3(nil).Nearest(Vec3{}); index != -1 {
+		t.Errorf("Nearest on an empty tree should return -1, got %d", index)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// ForwardKinematics stores in dst the world transforms of the joints of a
+// hierarchy, given the local transform of each joint relative to its parent,
+// and returns it. Parents[i] is the index of the parent of joint i, or a
+// negative number for a root, whose world transform is its local one.
+//
+// The joints must be in topological order, every parent before its children,
+// so that a single pass suffices. Dst is reallocated if it is too short.
+// ForwardKinematics panics if a joint's parent doesn't come before it, or if
+// parents and local don't have the same length.
+func ForwardKinematics(dst []Mat4, parents []int, local []Transform) []Mat4 {
+	if len(parents) != len(local) {
+		panic("Mismatched joint hierarchy lengths")
+	}
+	if len(dst) < len(local) {
+		dst = make([]Mat4, len(local))
+	}
+	dst = dst[:len(local)]
+
+	for i, t := range local {
+		p := parents[i]
+		switch {
+		case p < 0:
+			dst[i] = t.Mat4()
+		case p < i:
+			dst[i] = dst[p].Mul4(t.Mat4())
+		default:
+			panic("Joint hierarchy is not in topological order")
+		}
+	}
+	return dst
+}
+
+// SolveTwoBoneIK solves the inverse kinematics of a chain of two bones, such
+// as an arm or a leg, from the world positions of its root, middle and tip
+// joints (shoulder, elbow, wrist). It returns the world space rotations to
+// apply to the root and middle joints so that the tip reaches target, with
+// the chain bending towards the direction poleVector: the root joint's new
+// world rotation is rootRot.Mul(oldRootRotation) and the middle joint's is
+// midRot.Mul(rootRot).Mul(oldMidRotation).
+//
+// The bone lengths are preserved. If the target is out of reach, the chain is
+// stretched straight towards it, and if it's too close, folded. If the pole
+// vector is parallel to the direction to the target, the chain keeps bending
+// in its current plane.
+func SolveTwoBoneIK(root, mid, tip, target, poleVector Vec3) (rootRot, midRot Quat) {
+	upper, lower := mid.Sub(root), tip.Sub(mid)
+	a, b := float64(upper.Len()), float64(lower.Len())
+
+	d := target.Sub(root)
+	c := math.Max(math.Abs(a-b), math.Min(a+b, float64(d.Len())))
+	if d.LenSqr() == 0 {
+		d = upper
+	}
+	d = d.Normalize()
+
+	// The direction of the bend, perpendicular to d.
+	u := ProjectOntoPlane(poleVector, d)
+	if u.LenSqr() < 1e-12 {
+		u = ProjectOntoPlane(upper, d)
+	}
+	if u.LenSqr() < 1e-12 {
+		u = anyPerpendicular(d)
+	}
+	u = u.Normalize()
+
+	var cosA float64
+	if a > 0 && c > 0 {
+		cosA = math.Max(-1, math.Min(1, (a*a+c*c-b*b)/(2*a*c)))
+	}
+	sinA := math.Sqrt(1 - cosA*cosA)
+	newUpper := d.Mul(float32(a * cosA)).Add(u.Mul(float32(a * sinA)))
+	newLower := d.Mul(float32(c)).Sub(newUpper)
+
+	// The bones may have to swing almost all the way around, where
+	// QuatBetweenVectors snaps to a guessed axis, so the rotations are built
+	// about the normal of the bend plane instead.
+	normal := d.Cross(u)
+	rootRot = rotationFromTo(upper, newUpper, normal)
+	midRot = rotationFromTo(rootRot.Rotate(lower), newLower, normal)
+	return rootRot, midRot
+}
+
+// rotationFromTo returns the shortest rotation turning the direction of from
+// into that of to, which is about normal when they are opposite.
+func rotationFromTo(from, to, normal Vec3) Quat {
+	axis := from.Cross(to)
+	angle := math.Atan2(float64(axis.Len()), float64(from.Dot(to)))
+	if axis.LenSqr() <= 1e-12*from.LenSqr()*to.LenSqr() {
+		axis = normal
+	}
+	if axis.LenSqr() == 0 {
+		return QuatIdent()
+	}
+	return QuatRotate(float32(angle), axis.Normalize())
+}
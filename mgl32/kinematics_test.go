@@ -0,0 +1,111 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestForwardKinematics(t *testing.T) {
+	t.Parallel()
+
+	joint := func(tr Vec3, r Quat) Transform {
+		return Transform{Translation: tr, Rotation: r, Scale: Vec3{1, 1, 1}}
+	}
+
+	// An arm along x with a quarter turn at the elbow, and a second root.
+	parents := []int{-1, 0, 1, 2, -1}
+	local := []Transform{
+		joint(Vec3{0, 1, 0}, QuatIdent()),
+		joint(Vec3{1, 0, 0}, QuatRotate(math.Pi/2, Vec3{0, 0, 1})),
+		joint(Vec3{1, 0, 0}, QuatIdent()),
+		{Translation: Vec3{2, 0, 0}, Rotation: QuatIdent(), Scale: Vec3{2, 2, 2}},
+		joint(Vec3{-5, 0, 0}, QuatIdent()),
+	}
+	want := []Vec3{{0, 1, 0}, {1, 1, 0}, {1, 2, 0}, {1, 4, 0}, {-5, 0, 0}}
+
+	world := ForwardKinematics(nil, parents, local)
+	for i := range want {
+		if got := world[i].Col(3).Vec3(); !got.ApproxFuncEqual(want[i], absEqual(1e-5)) {
+			t.Errorf("ForwardKinematics joint %d is at %v, want %v", i, got, want[i])
+		}
+	}
+	if got, want := TransformCoordinate(Vec3{1, 0, 0}, world[3]), (Vec3{1, 6, 0}); !got.ApproxFuncEqual(want, absEqual(1e-5)) {
+		t.Errorf("joint 3 maps {1, 0, 0} to %v, want %v", got, want)
+	}
+
+	// Reusing dst.
+	if got := ForwardKinematics(world, parents[:2], local[:2]); &got[0] != &world[0] || len(got) != 2 {
+		t.Errorf("ForwardKinematics reallocated dst")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("ForwardKinematics with a child before its parent doesn't panic")
+		}
+	}()
+	ForwardKinematics(nil, []int{1, -1}, local[:2])
+}
+
+func TestSolveTwoBoneIK(t *testing.T) {
+	t.Parallel()
+
+	approx := absEqual(1e-4)
+	root, mid, tip := Vec3{0, 0, 0}, Vec3{2, 0, 0}, Vec3{2, 0, 1.5}
+
+	tests := []struct {
+		target, pole Vec3
+		reach        Vec3
+	}{
+		{Vec3{1, 1, 1}, Vec3{0, 0, 1}, Vec3{1, 1, 1}},
+		{Vec3{0, 2.5, 0}, Vec3{1, 0, 0}, Vec3{0, 2.5, 0}},
+		{Vec3{-1, -1, 2}, Vec3{0, 1, 0}, Vec3{-1, -1, 2}},
+		// Out of reach: stretched towards the target.
+		{Vec3{0, 10, 0}, Vec3{1, 0, 0}, Vec3{0, 3.5, 0}},
+		// Too close: folded.
+		{Vec3{0, 0.1, 0}, Vec3{1, 0, 0}, Vec3{0, 0.5, 0}},
+		// The pole is along the target direction.
+		{Vec3{3, 0, 0}, Vec3{1, 0, 0}, Vec3{3, 0, 0}},
+	}
+
+	for _, test := range tests {
+		rootRot, midRot := SolveTwoBoneIK(root, mid, tip, test.target, test.pole)
+		newMid := root.Add(rootRot.Rotate(mid.Sub(root)))
+		newTip := newMid.Add(midRot.Mul(rootRot).Rotate(tip.Sub(mid)))
+
+		if !newTip.ApproxFuncEqual(test.reach, approx) {
+			t.Errorf("SolveTwoBoneIK(%v, %v) moves the tip to %v, want %v", test.target, test.pole, newTip, test.reach)
+		}
+		if upper, lower := newMid.Sub(root).Len(), newTip.Sub(newMid).Len(); !approx(upper, 2) || !approx(lower, 1.5) {
+			t.Errorf("SolveTwoBoneIK(%v, %v) changed the bone lengths to %v, %v", test.target, test.pole, upper, lower)
+		}
+
+		// The middle joint bends towards the pole.
+		d := test.target.Normalize()
+		if off := ProjectOntoPlane(newMid, d); off.LenSqr() > 1e-6 && ProjectOntoPlane(test.pole, d).LenSqr() > 1e-6 && off.Dot(test.pole) <= 0 {
+			t.Errorf("SolveTwoBoneIK(%v, %v) bends the chain away from the pole: %v", test.target, test.pole, newMid)
+		}
+	}
+
+	// The upper bone swings almost all the way around, to bend towards the
+	// pole at newMid.
+	for _, eps := range []float64{0.003, 0.02, 0.04} {
+		newMid := Vec3{float32(-2 * math.Cos(eps)), float32(2 * math.Sin(eps)), 0}
+		target := newMid.Add(Vec3{0, 0, 1.5})
+		rootRot, midRot := SolveTwoBoneIK(root, mid, tip, target, newMid)
+		gotMid := root.Add(rootRot.Rotate(mid.Sub(root)))
+		gotTip := gotMid.Add(midRot.Mul(rootRot).Rotate(tip.Sub(mid)))
+		if !gotTip.ApproxFuncEqual(target, approx) || !gotMid.ApproxFuncEqual(newMid, approx) {
+			t.Errorf("SolveTwoBoneIK swinging the upper bone by Pi-%v moves the joints to %v, %v, want %v, %v", eps, gotMid, gotTip, newMid, target)
+		}
+	}
+
+	// A chain already reaching its target stays put.
+	rootRot, midRot := SolveTwoBoneIK(root, mid, tip, tip, Vec3{1, 0, -1})
+	if !rootRot.OrientationEqualThreshold(QuatIdent(), 1e-4) || !midRot.OrientationEqualThreshold(QuatIdent(), 1e-4) {
+		t.Errorf("SolveTwoBoneIK towards the current tip = %v, %v, want identities", rootRot, midRot)
+	}
+}
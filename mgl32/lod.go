@@ -0,0 +1,88 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// LODCamera holds what level of detail selection needs to know about a
+// camera: its view and projection matrices, of the form produced by LookAtV
+// and Perspective, Frustum or Ortho, and the height of its viewport in
+// pixels.
+type LODCamera struct {
+	View, Projection Mat4
+	ViewportHeight   int
+}
+
+// pixelScale returns the number of pixels covered by a unit length
+// perpendicular to the view direction, at unit distance for perspective
+// projections, along with whether the projection is orthographic.
+func (c LODCamera) pixelScale() (scale float32, ortho bool) {
+	p := c.Projection
+	ortho = p[3] == 0 && p[7] == 0 && p[11] == 0 && p[15] == 1
+	return p[5] * float32(c.ViewportHeight) / 2, ortho
+}
+
+// ProjectedRadius returns the radius, in pixels, of the disk covered by the
+// sphere on screen. For perspective projections this is exact for spheres
+// centered on the view axis and slightly underestimates off-axis ones, which
+// are stretched into ellipses. If the eye is inside the sphere, the result is
+// +Inf.
+func ProjectedRadius(s Sphere, camera LODCamera) float32 {
+	scale, ortho := camera.pixelScale()
+	if ortho {
+		return s.Radius * scale
+	}
+
+	c := camera.View.Mul4x1(s.Center.Vec4(1)).Vec3()
+	d := float64(c.LenSqr()) - float64(s.Radius)*float64(s.Radius)
+	if d <= 0 {
+		return InfPos
+	}
+	return float32(float64(s.Radius*scale) / math.Sqrt(d))
+}
+
+// ProjectedRadiusAABB returns the projected radius of the bounding sphere of
+// the box, as with ProjectedRadius.
+func ProjectedRadiusAABB(box AABB, camera LODCamera) float32 {
+	return ProjectedRadius(box.boundingSphere(), camera)
+}
+
+// ScreenSpaceError returns the size, in pixels, that an error in world units
+// of geometricError within the bounds can take on screen, using the point of
+// the bounds closest to the eye. LOD selection typically refines a node until
+// its screen space error drops below a pixel threshold. If the eye is within
+// the bounds, the result is +Inf.
+func ScreenSpaceError(bounds Sphere, geometricError float32, camera LODCamera) float32 {
+	scale, ortho := camera.pixelScale()
+	if ortho {
+		return geometricError * scale
+	}
+
+	c := camera.View.Mul4x1(bounds.Center.Vec4(1)).Vec3()
+	return screenSpaceError(c.Len()-bounds.Radius, geometricError, scale)
+}
+
+// ScreenSpaceErrorAABB is the version of ScreenSpaceError for box bounds.
+func ScreenSpaceErrorAABB(bounds AABB, geometricError float32, camera LODCamera) float32 {
+	scale, ortho := camera.pixelScale()
+	if ortho {
+		return geometricError * scale
+	}
+
+	eye := camera.View.Inv().Col(3).Vec3()
+	return screenSpaceError(clampToAABB(eye, bounds).Sub(eye).Len(), geometricError, scale)
+}
+
+func screenSpaceError(dist, geometricError, scale float32) float32 {
+	if dist <= 0 {
+		return InfPos
+	}
+	return geometricError * scale / dist
+}
+
+// boundingSphere returns the smallest sphere containing the box.
+func (box AABB) boundingSphere() Sphere {
+	return Sphere{box.Center(), box.Extents().Len()}
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProjectedRadius(t *testing.T) {
+	t.Parallel()
+
+	view := LookAtV(Vec3{0, 0, 5}, Vec3{}, Vec3{0, 1, 0})
+	camera := LODCamera{view, Perspective(math.Pi/2, 1, 0.1, 100), 100}
+
+	s := Sphere{Vec3{0, 0, -5}, 1}
+	want := 50 / float32(math.Sqrt(99))
+	if got := ProjectedRadius(s, camera); !FloatEqualThreshold(got, want, 1e-5) {
+		t.Errorf("ProjectedRadius(%v) = %v, want %v", s, got, want)
+	}
+
+	// The disk matches the exact screen bounds for a sphere on the view axis.
+	bounds, _ := ProjectSphereBounds(s.Center, s.Radius, camera.View, camera.Projection, 0, 0, 100, 100)
+	if got := (bounds.Max[1] - bounds.Min[1]) / 2; !FloatEqualThreshold(got, want, 1e-4) {
+		t.Errorf("ProjectSphereBounds half height = %v, want %v", got, want)
+	}
+
+	if got := ProjectedRadius(Sphere{Vec3{0, 0, 4.5}, 1}, camera); got != InfPos {
+		t.Errorf("ProjectedRadius with the eye inside = %v, want +Inf", got)
+	}
+
+	box := AABB{Vec3{-1, -1, -6}, Vec3{1, 1, -4}}
+	want = float32(math.Sqrt(3)) * 50 / float32(math.Sqrt(97))
+	if got := ProjectedRadiusAABB(box, camera); !FloatEqualThreshold(got, want, 1e-5) {
+		t.Errorf("ProjectedRadiusAABB(%v) = %v, want %v", box, got, want)
+	}
+
+	ortho := LODCamera{view, Ortho(-10, 10, -5, 5, 0.1, 100), 100}
+	if got := ProjectedRadius(s, ortho); !FloatEqualThreshold(got, 10, 1e-6) {
+		t.Errorf("orthographic ProjectedRadius(%v) = %v, want 10", s, got)
+	}
+}
+
+func TestScreenSpaceError(t *testing.T) {
+	t.Parallel()
+
+	view := LookAtV(Vec3{0, 0, 5}, Vec3{}, Vec3{0, 1, 0})
+	camera := LODCamera{view, Perspective(math.Pi/2, 1, 0.1, 100), 100}
+	approx := absEqual(1e-5)
+
+	s := Sphere{Vec3{0, 0, -5}, 1}
+	if got, want := ScreenSpaceError(s, 0.5, camera), float32(25.0/9); !approx(got, want) {
+		t.Errorf("ScreenSpaceError(%v, 0.5) = %v, want %v", s, got, want)
+	}
+	box := AABB{Vec3{-1, -1, -6}, Vec3{1, 1, -4}}
+	if got, want := ScreenSpaceErrorAABB(box, 0.5, camera), float32(25.0/9); !approx(got, want) {
+		t.Errorf("ScreenSpaceErrorAABB(%v, 0.5) = %v, want %v", box, got, want)
+	}
+
+	// Off to the side, the closest point is a corner.
+	box = AABB{Vec3{3, 4, 3}, Vec3{5, 5, 6}}
+	if got, want := ScreenSpaceErrorAABB(box, 1, camera), float32(50.0/5); !approx(got, want) {
+		t.Errorf("ScreenSpaceErrorAABB(%v, 1) = %v, want %v", box, got, want)
+	}
+
+	// Error shrinks with distance.
+	near := ScreenSpaceError(Sphere{Vec3{0, 0, 0}, 1}, 1, camera)
+	far := ScreenSpaceError(Sphere{Vec3{0, 0, -20}, 1}, 1, camera)
+	if near <= far {
+		t.Errorf("ScreenSpaceError doesn't decrease with distance: %v <= %v", near, far)
+	}
+
+	inside := AABB{Vec3{-1, -1, 4}, Vec3{1, 1, 6}}
+	if got := ScreenSpaceErrorAABB(inside, 1, camera); got != InfPos {
+		t.Errorf("ScreenSpaceErrorAABB with the eye inside = %v, want +Inf", got)
+	}
+	if got := ScreenSpaceError(Sphere{Vec3{0, 0, 5}, 1}, 1, camera); got != InfPos {
+		t.Errorf("ScreenSpaceError with the eye inside = %v, want +Inf", got)
+	}
+
+	ortho := LODCamera{view, Ortho(-10, 10, -5, 5, 0.1, 100), 100}
+	if got := ScreenSpaceErrorAABB(box, 0.5, ortho); !approx(got, 5) {
+		t.Errorf("orthographic ScreenSpaceErrorAABB(%v, 0.5) = %v, want 5", box, got)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// A logarithmic depth buffer stores log(d/near)/log(far/near) for a point at
+// distance d in front of the camera instead of the hyperbolic depth of a
+// Perspective matrix. Precision is then spread evenly over orders of magnitude,
+// which allows near planes of centimeters with far planes at planetary
+// distances. The mapping isn't linear in view space, so no matrix produces it;
+// it is written to gl_FragDepth or to the clip z in the vertex shader instead.
+
+// LogDepthParams returns the constants of the logarithmic depth mapping for the
+// given near and far distances, such that the depth in [0, 1] of a point at
+// view distance d (the clip w of a Perspective matrix) is
+//
+//	log2(d)*scale + offset
+//
+// which costs a shader a single log2 and multiply-add.
+func LogDepthParams(near, far float32) (scale, offset float32) {
+	s := 1 / math.Log2(float64(far)/float64(near))
+	return float32(s), float32(-math.Log2(float64(near)) * s)
+}
+
+// LogDepth returns the logarithmic depth in [0, 1] of a point at view distance
+// d, where near maps to 0 and far to 1. Distances outside of [near, far] give
+// depths outside of [0, 1], and distances that aren't positive give -Inf or NaN.
+func LogDepth(d, near, far float32) float32 {
+	return float32(math.Log(float64(d)/float64(near)) / math.Log(float64(far)/float64(near)))
+}
+
+// LogDepthToDistance is the inverse of LogDepth: it returns the view distance
+// of a point with the logarithmic depth given, e.g. when reading back a depth buffer.
+func LogDepthToDistance(depth, near, far float32) float32 {
+	return float32(float64(near) * math.Pow(float64(far)/float64(near), float64(depth)))
+}
+
+// LogDepthProjection is a perspective projection whose clip z follows a
+// logarithmic depth mapping, for computing on the CPU what a vertex shader
+// writing logarithmic depth outputs.
+type LogDepthProjection struct {
+	Proj          Mat4
+	Scale, Offset float32 // As returned by LogDepthParams for the near and far planes of Proj
+	Near, Far     float32
+}
+
+// PerspectiveLogDepth returns the projection of Perspective(fovy, aspect, near, far)
+// with a logarithmic depth mapping.
+func PerspectiveLogDepth(fovy, aspect, near, far float32) LogDepthProjection {
+	scale, offset := LogDepthParams(near, far)
+	return LogDepthProjection{
+		Proj:   Perspective(fovy, aspect, near, far),
+		Scale:  scale,
+		Offset: offset,
+		Near:   near,
+		Far:    far,
+	}
+}
+
+// Clip transforms the view space point v to clip space. The x, y and w
+// elements are those of p.Proj, while z is chosen such that z/w, the NDC depth,
+// is the logarithmic depth of v remapped to [-1, 1].
+func (p LogDepthProjection) Clip(v Vec3) Vec4 {
+	clip := p.Proj.Mul4x1(v.Vec4(1))
+	clip[2] = (2*LogDepth(clip[3], p.Near, p.Far) - 1) * clip[3]
+	return clip
+}
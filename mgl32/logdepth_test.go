@@ -0,0 +1,59 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLogDepth(t *testing.T) {
+	t.Parallel()
+
+	const near, far = 0.01, 1e7
+	scale, offset := LogDepthParams(near, far)
+
+	tests := []struct {
+		d, depth float32
+	}{
+		{near, 0},
+		{far, 1},
+		{1, 2. / 9},
+		{1e3, 5. / 9},
+		{1e5, 7. / 9},
+	}
+
+	for _, c := range tests {
+		if depth := LogDepth(c.d, near, far); Abs(depth-c.depth) > 1e-5 {
+			t.Errorf("LogDepth(%v) = %v, want %v", c.d, depth, c.depth)
+		}
+		if depth := float32(math.Log2(float64(c.d)))*scale + offset; Abs(depth-c.depth) > 1e-5 {
+			t.Errorf("log2(%v)*scale + offset = %v, want %v", c.d, depth, c.depth)
+		}
+		if d := LogDepthToDistance(c.depth, near, far); !FloatEqualThreshold(d, c.d, 1e-4) {
+			t.Errorf("LogDepthToDistance(%v) = %v, want %v", c.depth, d, c.d)
+		}
+	}
+}
+
+func TestPerspectiveLogDepth(t *testing.T) {
+	t.Parallel()
+
+	const near, far = 0.1, 1e6
+	p := PerspectiveLogDepth(1, 1.5, near, far)
+	m := Perspective(1, 1.5, near, far)
+
+	for _, v := range []Vec3{{0, 0, -near}, {1, -2, -30}, {5e4, 3e3, -far}} {
+		clip := p.Clip(v)
+		want := m.Mul4x1(v.Vec4(1))
+		if clip[0] != want[0] || clip[1] != want[1] || clip[3] != want[3] {
+			t.Errorf("Clip(%v) = %v, want x, y and w of %v", v, clip, want)
+		}
+		ndc := clip[2] / clip[3]
+		if expected := 2*LogDepth(-v[2], near, far) - 1; Abs(ndc-expected) > 1e-5 {
+			t.Errorf("NDC depth of %v = %v, want %v", v, ndc, expected)
+		}
+	}
+}
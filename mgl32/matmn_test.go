@@ -5,6 +5,7 @@
 package mgl32
 
 import (
+	"math"
 	"runtime"
 	"testing"
 )
@@ -68,6 +69,22 @@ func TestMxNTransposeSquare(t *testing.T) {
 	}
 }
 
+func TestMxNTransposeInPlace(t *testing.T) {
+	for _, size := range [][2]int{{1, 1}, {3, 3}, {2, 3}, {3, 2}, {1, 5}, {4, 7}, {6, 4}} {
+		m, n := size[0], size[1]
+		data := make([]float32, m*n)
+		for i := range data {
+			data[i] = float32(i)
+		}
+		mn := NewMatrixFromData(data, m, n)
+		correct := mn.Transpose(nil)
+
+		if got := mn.Transpose(mn); got != mn || !got.ApproxEqualThreshold(correct, 1e-6) {
+			t.Errorf("In place transpose of a %dx%d matrix = %v, expected %v in the same matrix", m, n, got, correct)
+		}
+	}
+}
+
 func TestMxNAtSet(t *testing.T) {
 	m := Mat3{1, 2, 3, 4, 5, 6, 7, 8, 9}
 
@@ -116,6 +133,23 @@ func TestMxNMulMxN(t *testing.T) {
 	}
 }
 
+func TestMxNMulMxNRectangular(t *testing.T) {
+	a := NewMatrixFromData([]float32{1, 2, 3, 4, 5, 6}, 2, 3)
+	b := NewMatrixFromData([]float32{1, 0, -1, 2, 1, 0, 0, 1, 1, 1, 1, 1}, 3, 4)
+	correct := NewMatrixFromData([]float32{-4, -4, 5, 8, 8, 10, 9, 12}, 2, 4)
+
+	if result := a.MulMxN(nil, b); !result.ApproxEqualThreshold(correct, 1e-6) {
+		t.Errorf("MulMxN of 2x3 and 3x4 matrices = %v, expected %v", result, correct)
+	}
+
+	// The result may alias an operand.
+	c := NewMatrixFromData([]float32{1, 2, 3, 4}, 2, 2)
+	correct = NewMatrixFromData([]float32{7, 10, 15, 22}, 2, 2)
+	if result := c.MulMxN(c, c); !result.ApproxEqualThreshold(correct, 1e-6) {
+		t.Errorf("MulMxN in place = %v, expected %v", result, correct)
+	}
+}
+
 func TestMxNMulMxNErrorHandling(t *testing.T) {
 	mn := NewMatrix(4, 12)
 	mn2 := NewMatrix(9, 3)
@@ -196,6 +230,40 @@ func TestMxNTrace(t *testing.T) {
 	}
 }
 
+func TestMxNNorms(t *testing.T) {
+	m := NewMatrixFromData([]float32{1, -3, 4, 5, -6, 8}, 2, 3)
+
+	if n := m.NormFrobenius(); !FloatEqualThreshold(n, float32(math.Sqrt(151)), 1e-6) {
+		t.Errorf("MatMxN Frobenius norm = %v, want sqrt(151)", n)
+	}
+	if n := m.Norm1(); !FloatEqual(n, 14) {
+		t.Errorf("MatMxN 1-norm = %v, want 14", n)
+	}
+	if n := m.NormInf(); !FloatEqual(n, 16) {
+		t.Errorf("MatMxN infinity norm = %v, want 16", n)
+	}
+
+	var nilMat *MatMxN
+	if n := nilMat.NormFrobenius(); !math.IsNaN(float64(n)) {
+		t.Errorf("Frobenius norm of a nil MatMxN = %v, want NaN", n)
+	}
+}
+
+func TestMxNAddOuter(t *testing.T) {
+	m := NewMatrixFromData([]float32{1, 2, 3, 4, 5, 6}, 3, 2)
+	u := NewVecNFromData([]float32{1, 0, -1})
+	v := NewVecNFromData([]float32{2, 3})
+
+	want := NewMatrixFromData([]float32{1, 2, 3, 4, 5, 6}, 3, 2).Add(nil, u.OuterProd(nil, v).Mul(nil, 0.5))
+	if result := m.AddOuter(0.5, u, v); result != m || !m.ApproxEqualThreshold(want, 1e-6) {
+		t.Errorf("AddOuter = %v, expected %v", m, want)
+	}
+
+	if result := m.AddOuter(1, v, u); result != nil {
+		t.Errorf("AddOuter with mismatched vector sizes = %v, expected nil", result)
+	}
+}
+
 func complexOperations() {
 	m := NewMatrix(15, 20)
 	t := m.Transpose(nil)
@@ -227,3 +295,134 @@ func BenchmarkMxNWithoutPooling(b *testing.B) {
 	b.StopTimer()
 	runtime.GC()
 }
+
+func benchmarkMulMxN(b *testing.B, n int) {
+	m1, m2, dst := NewMatrix(n, n), NewMatrix(n, n), NewMatrix(n, n)
+	for i := range m1.dat {
+		m1.dat[i], m2.dat[i] = float32(i%7), float32(i%5)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m1.MulMxN(dst, m2)
+	}
+}
+
+func BenchmarkMulMxN4(b *testing.B)   { benchmarkMulMxN(b, 4) }
+func BenchmarkMulMxN32(b *testing.B)  { benchmarkMulMxN(b, 32) }
+func BenchmarkMulMxN128(b *testing.B) { benchmarkMulMxN(b, 128) }
+func BenchmarkMulMxN256(b *testing.B) { benchmarkMulMxN(b, 256) }
+func BenchmarkMulMxN512(b *testing.B) { benchmarkMulMxN(b, 512) }
+
+// allocTestMatrices returns destinations of the right size for the results of
+// the operations of TestMxNZeroAllocs.
+func allocTestMatrices() (a, b, dst, square *MatMxN, v, vdst *VecN) {
+	a = NewMatrixFromData([]float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, 3, 4)
+	b = NewMatrixFromData([]float32{12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}, 3, 4)
+	return a, b, NewMatrix(4, 4), NewMatrix(3, 3), NewVecNFromData([]float32{1, 2, 3, 4}), NewVecN(4)
+}
+
+func TestMxNZeroAllocs(t *testing.T) {
+	a, b, dst, square, v, vdst := allocTestMatrices()
+	at, wide := a.Transpose(nil), a.Transpose(nil)
+	u := NewVecNFromData([]float32{1, 2, 3})
+	v6 := NewVecN(6)
+	cov := IdentN(nil, 6)
+	m := Translate3D(1, 2, 3).Mul4(HomogRotate3DY(0.5))
+
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"Add", func() { a.Add(dst, b) }},
+		{"Sub", func() { a.Sub(dst, b) }},
+		{"Mul", func() { a.Mul(dst, 2) }},
+		{"Mul in place", func() { dst.Mul(dst, 1) }},
+		{"MulMxN", func() { at.MulMxN(dst, b) }},
+		{"MulNx1", func() { a.MulNx1(vdst, v) }},
+		{"Transpose", func() { a.Transpose(dst) }},
+		{"Transpose in place", func() { wide.Transpose(wide) }},
+		{"AddOuter", func() { a.AddOuter(0, u, v) }},
+		{"CopyMatMN", func() { CopyMatMN(dst, b) }},
+		{"IdentN", func() { IdentN(square, 3) }},
+		{"DiagN", func() { DiagN(square, u) }},
+		{"Zero", func() { dst.Zero(2, 2) }},
+		{"Reshape", func() { dst.Reshape(2, 8) }},
+		{"InferMatrix", func() { square.InferMatrix(Mat3{}) }},
+		{"Norms", func() { a.NormFrobenius(); a.Norm1(); a.NormInf(); square.Trace() }},
+		{"OuterProd", func() { u.OuterProd(dst, v) }},
+		{"VecN Add", func() { v.Add(vdst, v) }},
+		{"VecN Sub", func() { v.Sub(vdst, v) }},
+		{"VecN Mul", func() { v.Mul(vdst, 2) }},
+		{"VecN Normalize", func() { v.Normalize(v) }},
+		{"VecN Cross", func() { u.Cross(u, u) }},
+		{"VecN Resize", func() { vdst.Resize(3).Resize(4) }},
+		{"Twist VecN", func() { Twist{}.VecN(v6) }},
+		{"TransformCovariance", func() { TransformCovariance(cov, m, cov) }},
+		{"ComposeCovariance", func() { ComposeCovariance(cov, m, cov, cov) }},
+		{"Adjoint", func() { Adjoint(cov, m) }},
+		{"SE3LeftJacobian", func() { SE3LeftJacobian(cov, Twist{Vec3{0.1, 0.2, 0.3}, Vec3{1, 2, 3}}) }},
+	}
+
+	for _, test := range tests {
+		if allocs := testing.AllocsPerRun(10, test.f); allocs != 0 {
+			t.Errorf("%s with preallocated destinations: %v allocations, expected 0", test.name, allocs)
+		}
+	}
+}
+
+func TestMxNRowOps(t *testing.T) {
+	t.Parallel()
+
+	fixed := Mat3x2FromRows(Vec2{1, 2}, Vec2{3, 4}, Vec2{5, 6})
+	mat := NewMatrixFromData(fixed[:], 3, 2)
+
+	fixed.SwapRows(0, 1)
+	fixed.ScaleRow(2, 0.5)
+	fixed.AddScaledRow(1, 2, 3)
+	if mat.SwapRows(0, 1).ScaleRow(2, 0.5).AddScaledRow(1, 2, 3) != mat {
+		t.Fatalf("row operations didn't return the receiver")
+	}
+	if want := NewMatrixFromData(fixed[:], 3, 2); !mat.ApproxEqual(want) {
+		t.Errorf("row operations = %v, want %v", mat.Raw(), want.Raw())
+	}
+
+	mat.SetRow(1, NewVecNFromData([]float32{7, 8})).SetCol(0, NewVecNFromData([]float32{-1, -2, -3}))
+	if want := NewMatrixFromData([]float32{-1, -2, -3, fixed[3], 8, fixed[5]}, 3, 2); !mat.ApproxEqual(want) {
+		t.Errorf("SetRow, SetCol = %v, want %v", mat.Raw(), want.Raw())
+	}
+
+	if mat.SetRow(0, NewVecN(3)) != nil {
+		t.Errorf("SetRow with a mismatched vector didn't return nil")
+	}
+	if mat.SetCol(0, NewVecN(2)) != nil {
+		t.Errorf("SetCol with a mismatched vector didn't return nil")
+	}
+}
+
+func TestMxNMulMxNBlocked(t *testing.T) {
+	t.Parallel()
+
+	// Big enough to be tiled, with partial tiles and a column count that
+	// isn't a multiple of four.
+	m1, m2 := NewMatrix(150, 170), NewMatrix(170, 7)
+	for i := range m1.dat {
+		m1.dat[i] = float32(i%13) - 6
+	}
+	for i := range m2.dat {
+		m2.dat[i] = float32(i%11) - 5
+	}
+
+	got := m1.MulMxN(nil, m2)
+	for i := 0; i < 150; i++ {
+		for j := 0; j < 7; j++ {
+			var want float32
+			for k := 0; k < 170; k++ {
+				want += m1.At(i, k) * m2.At(k, j)
+			}
+			if got.At(i, j) != want {
+				t.Fatalf("MulMxN element (%d, %d) = %v, want %v", i, j, got.At(i, j), want)
+			}
+		}
+	}
+}
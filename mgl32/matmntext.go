@@ -0,0 +1,135 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteCSV writes the matrix to w as comma separated values, a record per
+// row, with the shortest formatting of each element that parses back to the
+// same value. This is the layout spreadsheets, numpy.loadtxt with
+// delimiter="," and MATLAB's readmatrix expect. A nil matrix writes nothing.
+func (mat *MatMxN) WriteCSV(w io.Writer) error {
+	if mat == nil {
+		return nil
+	}
+
+	cw := csv.NewWriter(w)
+	record := make([]string, mat.n)
+	for i := 0; i < mat.m; i++ {
+		for j := range record {
+			record[j] = formatElement(mat.At(i, j))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCSV reads a matrix written as comma separated values, a record per row,
+// from r and stores it in mat, which is reshaped as with Reshape and returned.
+// Spaces around the values are ignored. If mat is nil, a new matrix is
+// returned. If the records don't all have the same number of fields or a
+// value isn't a number, it returns an error.
+func (mat *MatMxN) ReadCSV(r io.Reader) (*MatMxN, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return mat.setFromRows(records)
+}
+
+// ParseMatlab parses a matrix literal in the syntax of MATLAB and
+// numpy.matrix, such as "[1 2; 3 4]", and stores it in mat, which is reshaped
+// as with Reshape and returned. Elements are separated by spaces or commas and
+// rows by semicolons or newlines; the brackets are optional. If mat is nil, a
+// new matrix is returned. If the rows don't all have the same length or an
+// element isn't a number, it returns an error.
+func (mat *MatMxN) ParseMatlab(s string) (*MatMxN, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") {
+		if !strings.HasSuffix(s, "]") {
+			return nil, fmt.Errorf("matrix literal %q has no closing bracket", s)
+		}
+		s = s[1 : len(s)-1]
+	}
+
+	var rows [][]string
+	for _, line := range strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == '\n' }) {
+		fields := strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' || r == '\r' })
+		if len(fields) > 0 {
+			rows = append(rows, fields)
+		}
+	}
+	return mat.setFromRows(rows)
+}
+
+// MatlabString returns the matrix as a MATLAB literal, such as "[1 2; 3 4]",
+// which ParseMatlab reads back and which can be pasted into MATLAB, Octave or
+// numpy.matrix. A nil matrix is "[]".
+func (mat *MatMxN) MatlabString() string {
+	if mat == nil {
+		return "[]"
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteByte('[')
+	for i := 0; i < mat.m; i++ {
+		if i > 0 {
+			buf.WriteString("; ")
+		}
+		for j := 0; j < mat.n; j++ {
+			if j > 0 {
+				buf.WriteByte(' ')
+			}
+			buf.WriteString(formatElement(mat.At(i, j)))
+		}
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// setFromRows parses the elements of a matrix given as rows of strings and
+// stores it in mat.
+func (mat *MatMxN) setFromRows(rows [][]string) (*MatMxN, error) {
+	var n int
+	if len(rows) > 0 {
+		n = len(rows[0])
+	}
+	for i, row := range rows {
+		if len(row) != n {
+			return nil, fmt.Errorf("row %d of the matrix has %d elements, expected %d", i, len(row), n)
+		}
+	}
+
+	// Parse into a separate slice so that mat is left alone on errors.
+	m := len(rows)
+	data := make([]float32, m*n)
+	for i, row := range rows {
+		for j, s := range row {
+			v, err := strconv.ParseFloat(strings.TrimSpace(s), scalarBits)
+			if err != nil {
+				return nil, fmt.Errorf("element (%d, %d) of the matrix: %v", i, j, err)
+			}
+			data[j*m+i] = float32(v)
+		}
+	}
+
+	return mat.setFrom(data, m, n), nil
+}
+
+func formatElement(v float32) string {
+	return strconv.FormatFloat(float64(v), 'g', -1, scalarBits)
+}
@@ -0,0 +1,79 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMxNCSV(t *testing.T) {
+	t.Parallel()
+
+	mat := NewMatrixFromData([]float32{1, -2.5, 0.1, 3, 1e-7, 4}, 2, 3)
+	buf := new(bytes.Buffer)
+	if err := mat.WriteCSV(buf); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	if want := "1,0.1,1e-07\n-2.5,3,4\n"; buf.String() != want {
+		t.Errorf("WriteCSV = %q, want %q", buf.String(), want)
+	}
+
+	got, err := NewMatrix(5, 5).ReadCSV(buf)
+	if err != nil || !got.ApproxEqualThreshold(mat, 0) {
+		t.Errorf("ReadCSV = %v, %v, want %v", got.Raw(), err, mat.Raw())
+	}
+
+	spaced, err := (*MatMxN)(nil).ReadCSV(strings.NewReader("1, 2\n 3 ,4\n"))
+	if err != nil || !spaced.ApproxEqual(NewMatrixFromData([]float32{1, 3, 2, 4}, 2, 2)) {
+		t.Errorf("ReadCSV with spaces = %v, %v", spaced.Raw(), err)
+	}
+
+	for _, in := range []string{"1,2\n3\n", "1,x\n"} {
+		if _, err := NewMatrix(1, 1).ReadCSV(strings.NewReader(in)); err == nil {
+			t.Errorf("ReadCSV(%q) didn't fail", in)
+		}
+	}
+}
+
+func TestMxNParseMatlab(t *testing.T) {
+	t.Parallel()
+
+	want := NewMatrixFromData([]float32{1, 3, 2, 4}, 2, 2)
+	tests := []struct {
+		Description string
+		In          string
+	}{
+		{"Spaces and semicolons", "[1 2; 3 4]"},
+		{"Commas", "[1,2;3,4]"},
+		{"Newlines", "[1 2\n 3 4]"},
+		{"No brackets", " 1, 2 ; 3 4 "},
+		{"Trailing semicolon", "[1 2; 3 4;]"},
+	}
+	for _, c := range tests {
+		got, err := (*MatMxN)(nil).ParseMatlab(c.In)
+		if err != nil || !got.ApproxEqual(want) {
+			t.Errorf("%s: ParseMatlab(%q) = %v, %v, want %v", c.Description, c.In, got.Raw(), err, want.Raw())
+		}
+	}
+
+	mat := NewMatrixFromData([]float32{1.5, -2, 0, 3e10, 7, 8}, 3, 2)
+	if s := mat.MatlabString(); s != "[1.5 3e+10; -2 7; 0 8]" {
+		t.Errorf("MatlabString = %q", s)
+	}
+	if got, err := NewMatrix(1, 1).ParseMatlab(mat.MatlabString()); err != nil || !got.ApproxEqualThreshold(mat, 0) {
+		t.Errorf("ParseMatlab(MatlabString()) = %v, %v, want %v", got.Raw(), err, mat.Raw())
+	}
+	if got, err := NewMatrix(2, 2).ParseMatlab("[]"); err != nil || got.NumRows() != 0 || got.NumCols() != 0 {
+		t.Errorf("ParseMatlab(\"[]\") = %v, %v, want an empty matrix", got, err)
+	}
+
+	for _, in := range []string{"[1 2; 3]", "[1 2", "[1 a]"} {
+		if _, err := NewMatrix(1, 1).ParseMatlab(in); err == nil {
+			t.Errorf("ParseMatlab(%q) didn't fail", in)
+		}
+	}
+}
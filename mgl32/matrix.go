@@ -9,7 +9,9 @@ package mgl32
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"text/tabwriter"
 )
 
@@ -87,6 +89,26 @@ func (m *Mat2) SetRow(row int, v Vec2) {
 	m[row+0], m[row+2] = v[0], v[1]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat2) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+2], m[j+2] = m[j+0], m[i+0], m[j+2], m[i+2]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat2) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+2] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat2) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+2] += c * m[j+2]
+}
+
 // Diag is a basic operation on a square matrix that simply
 // returns main diagonal (meaning all elements such that row==col).
 func (m Mat2) Diag() Vec2 {
@@ -209,6 +231,60 @@ func (m1 Mat2) Transpose() Mat2 {
 	return Mat2{m1[0], m1[2], m1[1], m1[3]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat2 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec2.MulMat2 for the row vector multiplication.
+func (m1 Mat2) RowMajorArray() [4]float32 {
+	return [4]float32{m1[0], m1[2], m1[1], m1[3]}
+}
+
+// Mat2FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat2FromRowMajor(data [4]float32) Mat2 {
+	return Mat2{data[0], data[2], data[1], data[3]}
+}
+
+// MulMat2 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul2x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec2) MulMat2(m Mat2) Vec2 {
+	return Vec2{
+		v[0]*m[0] + v[1]*m[1],
+		v[0]*m[2] + v[1]*m[3],
+	}
+}
+
+// TransposeMul2 returns m1^T * m2 without explicitly transposing m1.
+func (m1 Mat2) TransposeMul2(m2 Mat2) Mat2 {
+	return Mat2{
+		m1[0]*m2[0] + m1[1]*m2[1],
+		m1[2]*m2[0] + m1[3]*m2[1],
+		m1[0]*m2[2] + m1[1]*m2[3],
+		m1[2]*m2[2] + m1[3]*m2[3],
+	}
+}
+
+// MulTranspose2 returns m1 * m2^T without explicitly transposing m2.
+//
+// In the row vector convention, matrices are the transposes of the ones
+// used here, and a product A*B of such matrices corresponds to
+// B^T * A^T in this package. MulTranspose2 and TransposeMul2 make it
+// possible to combine the two conventions without intermediate transposes.
+func (m1 Mat2) MulTranspose2(m2 Mat2) Mat2 {
+	return Mat2{
+		m1[0]*m2[0] + m1[2]*m2[2],
+		m1[1]*m2[0] + m1[3]*m2[2],
+		m1[0]*m2[1] + m1[2]*m2[3],
+		m1[1]*m2[1] + m1[3]*m2[3],
+	}
+}
+
 // Det returns the determinant of a matrix. It is a measure of a square matrix's
 // singularity and invertability, among other things. In this library, the
 // determinant is hard coded based on pre-computed cofactor expansion, and uses
@@ -229,9 +305,12 @@ func (m Mat2) Det() float32 {
 // If the determinant is 0.0, this function returns the zero matrix. However, due to floating point errors, it is
 // entirely plausible to get a false positive or negative.
 // In the future, an alternate function may be written which takes in a pre-computed determinant.
+//
+// The Singular policy can make this function panic on a singular matrix instead.
 func (m Mat2) Inv() Mat2 {
 	det := m.Det()
 	if FloatEqual(det, float32(0.0)) {
+		singular("Mat2.Inv", m)
 		return Mat2{}
 	}
 
@@ -240,6 +319,15 @@ func (m Mat2) Inv() Mat2 {
 	return retMat.Mul(1 / det)
 }
 
+// TryInv is like Inv, but returns ErrSingular for a singular matrix
+// regardless of the Singular policy.
+func (m Mat2) TryInv() (Mat2, error) {
+	if FloatEqual(m.Det(), float32(0.0)) {
+		return Mat2{}, ErrSingular
+	}
+	return m.Inv(), nil
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat2) ApproxEqual(m2 Mat2) bool {
@@ -345,11 +433,59 @@ func (m Mat2) Trace() float32 {
 	return m[0] + m[3]
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat2) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat2) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 2; col++ {
+		sum := Abs(m[col*2+0]) + Abs(m[col*2+1])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat2) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 2; row++ {
+		sum := Abs(m[0*2+row]) + Abs(m[1*2+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat2) Abs() Mat2 {
 	return Mat2{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 4
+// elements or this panics.
+func (m Mat2) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat2FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 4 elements or this panics.
+func Mat2FromBytes(b []byte, order binary.ByteOrder) Mat2 {
+	var m Mat2
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat2) String() string {
 	buf := new(bytes.Buffer)
@@ -376,6 +512,28 @@ func (m *Mat2x3) SetRow(row int, v Vec3) {
 	m[row+0], m[row+2], m[row+4] = v[0], v[1], v[2]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat2x3) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+2], m[j+2], m[i+4], m[j+4] = m[j+0], m[i+0], m[j+2], m[i+2], m[j+4], m[i+4]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat2x3) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+2] *= c
+	m[i+4] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat2x3) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+2] += c * m[j+2]
+	m[i+4] += c * m[j+4]
+}
+
 // Mat2x3FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
 // good for hand-building matrices.
@@ -474,6 +632,36 @@ func (m1 Mat2x3) Transpose() Mat3x2 {
 	return Mat3x2{m1[0], m1[2], m1[4], m1[1], m1[3], m1[5]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat2x3 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec2.MulMat2x3 for the row vector multiplication.
+func (m1 Mat2x3) RowMajorArray() [6]float32 {
+	return [6]float32{m1[0], m1[2], m1[4], m1[1], m1[3], m1[5]}
+}
+
+// Mat2x3FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat2x3FromRowMajor(data [6]float32) Mat2x3 {
+	return Mat2x3{data[0], data[3], data[1], data[4], data[2], data[5]}
+}
+
+// MulMat2x3 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul2x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec2) MulMat2x3(m Mat2x3) Vec3 {
+	return Vec3{
+		v[0]*m[0] + v[1]*m[1],
+		v[0]*m[2] + v[1]*m[3],
+		v[0]*m[4] + v[1]*m[5],
+	}
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat2x3) ApproxEqual(m2 Mat2x3) bool {
@@ -573,11 +761,59 @@ func (m Mat2x3) Cols() (col0, col1, col2 Vec2) {
 	return m.Col(0), m.Col(1), m.Col(2)
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat2x3) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat2x3) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 3; col++ {
+		sum := Abs(m[col*2+0]) + Abs(m[col*2+1])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat2x3) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 2; row++ {
+		sum := Abs(m[0*2+row]) + Abs(m[1*2+row]) + Abs(m[2*2+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat2x3) Abs() Mat2x3 {
 	return Mat2x3{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 6
+// elements or this panics.
+func (m Mat2x3) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat2x3FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 6 elements or this panics.
+func Mat2x3FromBytes(b []byte, order binary.ByteOrder) Mat2x3 {
+	var m Mat2x3
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat2x3) String() string {
 	buf := new(bytes.Buffer)
@@ -604,6 +840,30 @@ func (m *Mat2x4) SetRow(row int, v Vec4) {
 	m[row+0], m[row+2], m[row+4], m[row+6] = v[0], v[1], v[2], v[3]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat2x4) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+2], m[j+2], m[i+4], m[j+4], m[i+6], m[j+6] = m[j+0], m[i+0], m[j+2], m[i+2], m[j+4], m[i+4], m[j+6], m[i+6]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat2x4) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+2] *= c
+	m[i+4] *= c
+	m[i+6] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat2x4) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+2] += c * m[j+2]
+	m[i+4] += c * m[j+4]
+	m[i+6] += c * m[j+6]
+}
+
 // Mat2x4FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
 // good for hand-building matrices.
@@ -702,6 +962,37 @@ func (m1 Mat2x4) Transpose() Mat4x2 {
 	return Mat4x2{m1[0], m1[2], m1[4], m1[6], m1[1], m1[3], m1[5], m1[7]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat2x4 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec2.MulMat2x4 for the row vector multiplication.
+func (m1 Mat2x4) RowMajorArray() [8]float32 {
+	return [8]float32{m1[0], m1[2], m1[4], m1[6], m1[1], m1[3], m1[5], m1[7]}
+}
+
+// Mat2x4FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat2x4FromRowMajor(data [8]float32) Mat2x4 {
+	return Mat2x4{data[0], data[4], data[1], data[5], data[2], data[6], data[3], data[7]}
+}
+
+// MulMat2x4 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul2x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec2) MulMat2x4(m Mat2x4) Vec4 {
+	return Vec4{
+		v[0]*m[0] + v[1]*m[1],
+		v[0]*m[2] + v[1]*m[3],
+		v[0]*m[4] + v[1]*m[5],
+		v[0]*m[6] + v[1]*m[7],
+	}
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat2x4) ApproxEqual(m2 Mat2x4) bool {
@@ -801,11 +1092,59 @@ func (m Mat2x4) Cols() (col0, col1, col2, col3 Vec2) {
 	return m.Col(0), m.Col(1), m.Col(2), m.Col(3)
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat2x4) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat2x4) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 4; col++ {
+		sum := Abs(m[col*2+0]) + Abs(m[col*2+1])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat2x4) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 2; row++ {
+		sum := Abs(m[0*2+row]) + Abs(m[1*2+row]) + Abs(m[2*2+row]) + Abs(m[3*2+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat2x4) Abs() Mat2x4 {
 	return Mat2x4{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 8
+// elements or this panics.
+func (m Mat2x4) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat2x4FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 8 elements or this panics.
+func Mat2x4FromBytes(b []byte, order binary.ByteOrder) Mat2x4 {
+	var m Mat2x4
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat2x4) String() string {
 	buf := new(bytes.Buffer)
@@ -832,6 +1171,26 @@ func (m *Mat3x2) SetRow(row int, v Vec2) {
 	m[row+0], m[row+3] = v[0], v[1]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat3x2) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+3], m[j+3] = m[j+0], m[i+0], m[j+3], m[i+3]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat3x2) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+3] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat3x2) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+3] += c * m[j+3]
+}
+
 // Mat3x2FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
 // good for hand-building matrices.
@@ -940,6 +1299,35 @@ func (m1 Mat3x2) Transpose() Mat2x3 {
 	return Mat2x3{m1[0], m1[3], m1[1], m1[4], m1[2], m1[5]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat3x2 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec3.MulMat3x2 for the row vector multiplication.
+func (m1 Mat3x2) RowMajorArray() [6]float32 {
+	return [6]float32{m1[0], m1[3], m1[1], m1[4], m1[2], m1[5]}
+}
+
+// Mat3x2FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat3x2FromRowMajor(data [6]float32) Mat3x2 {
+	return Mat3x2{data[0], data[2], data[4], data[1], data[3], data[5]}
+}
+
+// MulMat3x2 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul3x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec3) MulMat3x2(m Mat3x2) Vec2 {
+	return Vec2{
+		v[0]*m[0] + v[1]*m[1] + v[2]*m[2],
+		v[0]*m[3] + v[1]*m[4] + v[2]*m[5],
+	}
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat3x2) ApproxEqual(m2 Mat3x2) bool {
@@ -1039,11 +1427,59 @@ func (m Mat3x2) Cols() (col0, col1 Vec3) {
 	return m.Col(0), m.Col(1)
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat3x2) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat3x2) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 2; col++ {
+		sum := Abs(m[col*3+0]) + Abs(m[col*3+1]) + Abs(m[col*3+2])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat3x2) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 3; row++ {
+		sum := Abs(m[0*3+row]) + Abs(m[1*3+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat3x2) Abs() Mat3x2 {
 	return Mat3x2{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 6
+// elements or this panics.
+func (m Mat3x2) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat3x2FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 6 elements or this panics.
+func Mat3x2FromBytes(b []byte, order binary.ByteOrder) Mat3x2 {
+	var m Mat3x2
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat3x2) String() string {
 	buf := new(bytes.Buffer)
@@ -1070,6 +1506,28 @@ func (m *Mat3) SetRow(row int, v Vec3) {
 	m[row+0], m[row+3], m[row+6] = v[0], v[1], v[2]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat3) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+3], m[j+3], m[i+6], m[j+6] = m[j+0], m[i+0], m[j+3], m[i+3], m[j+6], m[i+6]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat3) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+3] *= c
+	m[i+6] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat3) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+3] += c * m[j+3]
+	m[i+6] += c * m[j+6]
+}
+
 // Diag is a basic operation on a square matrix that simply
 // returns main diagonal (meaning all elements such that row==col).
 func (m Mat3) Diag() Vec3 {
@@ -1202,6 +1660,71 @@ func (m1 Mat3) Transpose() Mat3 {
 	return Mat3{m1[0], m1[3], m1[6], m1[1], m1[4], m1[7], m1[2], m1[5], m1[8]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat3 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec3.MulMat3 for the row vector multiplication.
+func (m1 Mat3) RowMajorArray() [9]float32 {
+	return [9]float32{m1[0], m1[3], m1[6], m1[1], m1[4], m1[7], m1[2], m1[5], m1[8]}
+}
+
+// Mat3FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat3FromRowMajor(data [9]float32) Mat3 {
+	return Mat3{data[0], data[3], data[6], data[1], data[4], data[7], data[2], data[5], data[8]}
+}
+
+// MulMat3 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul3x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec3) MulMat3(m Mat3) Vec3 {
+	return Vec3{
+		v[0]*m[0] + v[1]*m[1] + v[2]*m[2],
+		v[0]*m[3] + v[1]*m[4] + v[2]*m[5],
+		v[0]*m[6] + v[1]*m[7] + v[2]*m[8],
+	}
+}
+
+// TransposeMul3 returns m1^T * m2 without explicitly transposing m1.
+func (m1 Mat3) TransposeMul3(m2 Mat3) Mat3 {
+	return Mat3{
+		m1[0]*m2[0] + m1[1]*m2[1] + m1[2]*m2[2],
+		m1[3]*m2[0] + m1[4]*m2[1] + m1[5]*m2[2],
+		m1[6]*m2[0] + m1[7]*m2[1] + m1[8]*m2[2],
+		m1[0]*m2[3] + m1[1]*m2[4] + m1[2]*m2[5],
+		m1[3]*m2[3] + m1[4]*m2[4] + m1[5]*m2[5],
+		m1[6]*m2[3] + m1[7]*m2[4] + m1[8]*m2[5],
+		m1[0]*m2[6] + m1[1]*m2[7] + m1[2]*m2[8],
+		m1[3]*m2[6] + m1[4]*m2[7] + m1[5]*m2[8],
+		m1[6]*m2[6] + m1[7]*m2[7] + m1[8]*m2[8],
+	}
+}
+
+// MulTranspose3 returns m1 * m2^T without explicitly transposing m2.
+//
+// In the row vector convention, matrices are the transposes of the ones
+// used here, and a product A*B of such matrices corresponds to
+// B^T * A^T in this package. MulTranspose3 and TransposeMul3 make it
+// possible to combine the two conventions without intermediate transposes.
+func (m1 Mat3) MulTranspose3(m2 Mat3) Mat3 {
+	return Mat3{
+		m1[0]*m2[0] + m1[3]*m2[3] + m1[6]*m2[6],
+		m1[1]*m2[0] + m1[4]*m2[3] + m1[7]*m2[6],
+		m1[2]*m2[0] + m1[5]*m2[3] + m1[8]*m2[6],
+		m1[0]*m2[1] + m1[3]*m2[4] + m1[6]*m2[7],
+		m1[1]*m2[1] + m1[4]*m2[4] + m1[7]*m2[7],
+		m1[2]*m2[1] + m1[5]*m2[4] + m1[8]*m2[7],
+		m1[0]*m2[2] + m1[3]*m2[5] + m1[6]*m2[8],
+		m1[1]*m2[2] + m1[4]*m2[5] + m1[7]*m2[8],
+		m1[2]*m2[2] + m1[5]*m2[5] + m1[8]*m2[8],
+	}
+}
+
 // Det returns the determinant of a matrix. It is a measure of a square matrix's
 // singularity and invertability, among other things. In this library, the
 // determinant is hard coded based on pre-computed cofactor expansion, and uses
@@ -1222,9 +1745,12 @@ func (m Mat3) Det() float32 {
 // If the determinant is 0.0, this function returns the zero matrix. However, due to floating point errors, it is
 // entirely plausible to get a false positive or negative.
 // In the future, an alternate function may be written which takes in a pre-computed determinant.
+//
+// The Singular policy can make this function panic on a singular matrix instead.
 func (m Mat3) Inv() Mat3 {
 	det := m.Det()
 	if FloatEqual(det, float32(0.0)) {
+		singular("Mat3.Inv", m)
 		return Mat3{}
 	}
 
@@ -1243,6 +1769,15 @@ func (m Mat3) Inv() Mat3 {
 	return retMat.Mul(1 / det)
 }
 
+// TryInv is like Inv, but returns ErrSingular for a singular matrix
+// regardless of the Singular policy.
+func (m Mat3) TryInv() (Mat3, error) {
+	if FloatEqual(m.Det(), float32(0.0)) {
+		return Mat3{}, ErrSingular
+	}
+	return m.Inv(), nil
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat3) ApproxEqual(m2 Mat3) bool {
@@ -1348,11 +1883,59 @@ func (m Mat3) Trace() float32 {
 	return m[0] + m[4] + m[8]
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat3) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat3) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 3; col++ {
+		sum := Abs(m[col*3+0]) + Abs(m[col*3+1]) + Abs(m[col*3+2])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat3) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 3; row++ {
+		sum := Abs(m[0*3+row]) + Abs(m[1*3+row]) + Abs(m[2*3+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat3) Abs() Mat3 {
 	return Mat3{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 9
+// elements or this panics.
+func (m Mat3) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat3FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 9 elements or this panics.
+func Mat3FromBytes(b []byte, order binary.ByteOrder) Mat3 {
+	var m Mat3
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat3) String() string {
 	buf := new(bytes.Buffer)
@@ -1379,6 +1962,30 @@ func (m *Mat3x4) SetRow(row int, v Vec4) {
 	m[row+0], m[row+3], m[row+6], m[row+9] = v[0], v[1], v[2], v[3]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat3x4) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+3], m[j+3], m[i+6], m[j+6], m[i+9], m[j+9] = m[j+0], m[i+0], m[j+3], m[i+3], m[j+6], m[i+6], m[j+9], m[i+9]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat3x4) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+3] *= c
+	m[i+6] *= c
+	m[i+9] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat3x4) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+3] += c * m[j+3]
+	m[i+6] += c * m[j+6]
+	m[i+9] += c * m[j+9]
+}
+
 // Mat3x4FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
 // good for hand-building matrices.
@@ -1487,6 +2094,37 @@ func (m1 Mat3x4) Transpose() Mat4x3 {
 	return Mat4x3{m1[0], m1[3], m1[6], m1[9], m1[1], m1[4], m1[7], m1[10], m1[2], m1[5], m1[8], m1[11]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat3x4 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec3.MulMat3x4 for the row vector multiplication.
+func (m1 Mat3x4) RowMajorArray() [12]float32 {
+	return [12]float32{m1[0], m1[3], m1[6], m1[9], m1[1], m1[4], m1[7], m1[10], m1[2], m1[5], m1[8], m1[11]}
+}
+
+// Mat3x4FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat3x4FromRowMajor(data [12]float32) Mat3x4 {
+	return Mat3x4{data[0], data[4], data[8], data[1], data[5], data[9], data[2], data[6], data[10], data[3], data[7], data[11]}
+}
+
+// MulMat3x4 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul3x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec3) MulMat3x4(m Mat3x4) Vec4 {
+	return Vec4{
+		v[0]*m[0] + v[1]*m[1] + v[2]*m[2],
+		v[0]*m[3] + v[1]*m[4] + v[2]*m[5],
+		v[0]*m[6] + v[1]*m[7] + v[2]*m[8],
+		v[0]*m[9] + v[1]*m[10] + v[2]*m[11],
+	}
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat3x4) ApproxEqual(m2 Mat3x4) bool {
@@ -1586,11 +2224,59 @@ func (m Mat3x4) Cols() (col0, col1, col2, col3 Vec3) {
 	return m.Col(0), m.Col(1), m.Col(2), m.Col(3)
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat3x4) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8] + m[9]*m[9] + m[10]*m[10] + m[11]*m[11])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat3x4) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 4; col++ {
+		sum := Abs(m[col*3+0]) + Abs(m[col*3+1]) + Abs(m[col*3+2])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat3x4) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 3; row++ {
+		sum := Abs(m[0*3+row]) + Abs(m[1*3+row]) + Abs(m[2*3+row]) + Abs(m[3*3+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat3x4) Abs() Mat3x4 {
 	return Mat3x4{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8]), Abs(m[9]), Abs(m[10]), Abs(m[11])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 12
+// elements or this panics.
+func (m Mat3x4) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat3x4FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 12 elements or this panics.
+func Mat3x4FromBytes(b []byte, order binary.ByteOrder) Mat3x4 {
+	var m Mat3x4
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat3x4) String() string {
 	buf := new(bytes.Buffer)
@@ -1617,6 +2303,26 @@ func (m *Mat4x2) SetRow(row int, v Vec2) {
 	m[row+0], m[row+4] = v[0], v[1]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat4x2) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+4], m[j+4] = m[j+0], m[i+0], m[j+4], m[i+4]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat4x2) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+4] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat4x2) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+4] += c * m[j+4]
+}
+
 // Mat4x2FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
 // good for hand-building matrices.
@@ -1735,6 +2441,35 @@ func (m1 Mat4x2) Transpose() Mat2x4 {
 	return Mat2x4{m1[0], m1[4], m1[1], m1[5], m1[2], m1[6], m1[3], m1[7]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat4x2 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec4.MulMat4x2 for the row vector multiplication.
+func (m1 Mat4x2) RowMajorArray() [8]float32 {
+	return [8]float32{m1[0], m1[4], m1[1], m1[5], m1[2], m1[6], m1[3], m1[7]}
+}
+
+// Mat4x2FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat4x2FromRowMajor(data [8]float32) Mat4x2 {
+	return Mat4x2{data[0], data[2], data[4], data[6], data[1], data[3], data[5], data[7]}
+}
+
+// MulMat4x2 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul4x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec4) MulMat4x2(m Mat4x2) Vec2 {
+	return Vec2{
+		v[0]*m[0] + v[1]*m[1] + v[2]*m[2] + v[3]*m[3],
+		v[0]*m[4] + v[1]*m[5] + v[2]*m[6] + v[3]*m[7],
+	}
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat4x2) ApproxEqual(m2 Mat4x2) bool {
@@ -1834,11 +2569,59 @@ func (m Mat4x2) Cols() (col0, col1 Vec4) {
 	return m.Col(0), m.Col(1)
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat4x2) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat4x2) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 2; col++ {
+		sum := Abs(m[col*4+0]) + Abs(m[col*4+1]) + Abs(m[col*4+2]) + Abs(m[col*4+3])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat4x2) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 4; row++ {
+		sum := Abs(m[0*4+row]) + Abs(m[1*4+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat4x2) Abs() Mat4x2 {
 	return Mat4x2{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 8
+// elements or this panics.
+func (m Mat4x2) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat4x2FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 8 elements or this panics.
+func Mat4x2FromBytes(b []byte, order binary.ByteOrder) Mat4x2 {
+	var m Mat4x2
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat4x2) String() string {
 	buf := new(bytes.Buffer)
@@ -1865,6 +2648,28 @@ func (m *Mat4x3) SetRow(row int, v Vec3) {
 	m[row+0], m[row+4], m[row+8] = v[0], v[1], v[2]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat4x3) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+4], m[j+4], m[i+8], m[j+8] = m[j+0], m[i+0], m[j+4], m[i+4], m[j+8], m[i+8]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat4x3) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+4] *= c
+	m[i+8] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat4x3) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+4] += c * m[j+4]
+	m[i+8] += c * m[j+8]
+}
+
 // Mat4x3FromRows builds a new matrix from row vectors.
 // The resulting matrix will still be in column major order, but this can be
 // good for hand-building matrices.
@@ -1983,6 +2788,36 @@ func (m1 Mat4x3) Transpose() Mat3x4 {
 	return Mat3x4{m1[0], m1[4], m1[8], m1[1], m1[5], m1[9], m1[2], m1[6], m1[10], m1[3], m1[7], m1[11]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat4x3 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec4.MulMat4x3 for the row vector multiplication.
+func (m1 Mat4x3) RowMajorArray() [12]float32 {
+	return [12]float32{m1[0], m1[4], m1[8], m1[1], m1[5], m1[9], m1[2], m1[6], m1[10], m1[3], m1[7], m1[11]}
+}
+
+// Mat4x3FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat4x3FromRowMajor(data [12]float32) Mat4x3 {
+	return Mat4x3{data[0], data[3], data[6], data[9], data[1], data[4], data[7], data[10], data[2], data[5], data[8], data[11]}
+}
+
+// MulMat4x3 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul4x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec4) MulMat4x3(m Mat4x3) Vec3 {
+	return Vec3{
+		v[0]*m[0] + v[1]*m[1] + v[2]*m[2] + v[3]*m[3],
+		v[0]*m[4] + v[1]*m[5] + v[2]*m[6] + v[3]*m[7],
+		v[0]*m[8] + v[1]*m[9] + v[2]*m[10] + v[3]*m[11],
+	}
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat4x3) ApproxEqual(m2 Mat4x3) bool {
@@ -2082,11 +2917,59 @@ func (m Mat4x3) Cols() (col0, col1, col2 Vec4) {
 	return m.Col(0), m.Col(1), m.Col(2)
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat4x3) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8] + m[9]*m[9] + m[10]*m[10] + m[11]*m[11])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat4x3) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 3; col++ {
+		sum := Abs(m[col*4+0]) + Abs(m[col*4+1]) + Abs(m[col*4+2]) + Abs(m[col*4+3])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat4x3) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 4; row++ {
+		sum := Abs(m[0*4+row]) + Abs(m[1*4+row]) + Abs(m[2*4+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat4x3) Abs() Mat4x3 {
 	return Mat4x3{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8]), Abs(m[9]), Abs(m[10]), Abs(m[11])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 12
+// elements or this panics.
+func (m Mat4x3) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat4x3FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 12 elements or this panics.
+func Mat4x3FromBytes(b []byte, order binary.ByteOrder) Mat4x3 {
+	var m Mat4x3
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat4x3) String() string {
 	buf := new(bytes.Buffer)
@@ -2113,6 +2996,30 @@ func (m *Mat4) SetRow(row int, v Vec4) {
 	m[row+0], m[row+4], m[row+8], m[row+12] = v[0], v[1], v[2], v[3]
 }
 
+// SwapRows exchanges rows i and j of the matrix, so it mutates the calling
+// matrix.
+func (m *Mat4) SwapRows(i, j int) {
+	m[i+0], m[j+0], m[i+4], m[j+4], m[i+8], m[j+8], m[i+12], m[j+12] = m[j+0], m[i+0], m[j+4], m[i+4], m[j+8], m[i+8], m[j+12], m[i+12]
+}
+
+// ScaleRow multiplies row i of the matrix by c, so it mutates the calling
+// matrix.
+func (m *Mat4) ScaleRow(i int, c float32) {
+	m[i+0] *= c
+	m[i+4] *= c
+	m[i+8] *= c
+	m[i+12] *= c
+}
+
+// AddScaledRow adds c times row j to row i of the matrix, the elementary row
+// operation of Gaussian elimination, so it mutates the calling matrix.
+func (m *Mat4) AddScaledRow(i, j int, c float32) {
+	m[i+0] += c * m[j+0]
+	m[i+4] += c * m[j+4]
+	m[i+8] += c * m[j+8]
+	m[i+12] += c * m[j+12]
+}
+
 // Diag is a basic operation on a square matrix that simply
 // returns main diagonal (meaning all elements such that row==col).
 func (m Mat4) Diag() Vec4 {
@@ -2255,6 +3162,86 @@ func (m1 Mat4) Transpose() Mat4 {
 	return Mat4{m1[0], m1[4], m1[8], m1[12], m1[1], m1[5], m1[9], m1[13], m1[2], m1[6], m1[10], m1[14], m1[3], m1[7], m1[11], m1[15]}
 }
 
+// RowMajorArray returns the elements of the matrix in row major order, as
+// expected by APIs that don't use OpenGL's column major convention (e.g. HLSL
+// row_major constant buffers, or most non-graphics libraries).
+//
+// Note that Direct3D style libraries such as DirectXMath combine row major
+// storage with row vectors (v*M rather than M*v), and the two conventions
+// cancel out: the raw data of a Mat4 can be passed to them as is, and
+// will be interpreted as the transpose of the same transformation. See
+// Vec4.MulMat4 for the row vector multiplication.
+func (m1 Mat4) RowMajorArray() [16]float32 {
+	return [16]float32{m1[0], m1[4], m1[8], m1[12], m1[1], m1[5], m1[9], m1[13], m1[2], m1[6], m1[10], m1[14], m1[3], m1[7], m1[11], m1[15]}
+}
+
+// Mat4FromRowMajor builds a matrix from elements stored in row major order.
+// It is the inverse of RowMajorArray.
+func Mat4FromRowMajor(data [16]float32) Mat4 {
+	return Mat4{data[0], data[4], data[8], data[12], data[1], data[5], data[9], data[13], data[2], data[6], data[10], data[14], data[3], data[7], data[11], data[15]}
+}
+
+// MulMat4 multiplies the matrix from the left by v, treating v as a row
+// vector: v*m. This is equivalent to m.Transpose().Mul4x1(v), and is
+// the convention used by Direct3D style (row vector) math libraries.
+func (v Vec4) MulMat4(m Mat4) Vec4 {
+	return Vec4{
+		v[0]*m[0] + v[1]*m[1] + v[2]*m[2] + v[3]*m[3],
+		v[0]*m[4] + v[1]*m[5] + v[2]*m[6] + v[3]*m[7],
+		v[0]*m[8] + v[1]*m[9] + v[2]*m[10] + v[3]*m[11],
+		v[0]*m[12] + v[1]*m[13] + v[2]*m[14] + v[3]*m[15],
+	}
+}
+
+// TransposeMul4 returns m1^T * m2 without explicitly transposing m1.
+func (m1 Mat4) TransposeMul4(m2 Mat4) Mat4 {
+	return Mat4{
+		m1[0]*m2[0] + m1[1]*m2[1] + m1[2]*m2[2] + m1[3]*m2[3],
+		m1[4]*m2[0] + m1[5]*m2[1] + m1[6]*m2[2] + m1[7]*m2[3],
+		m1[8]*m2[0] + m1[9]*m2[1] + m1[10]*m2[2] + m1[11]*m2[3],
+		m1[12]*m2[0] + m1[13]*m2[1] + m1[14]*m2[2] + m1[15]*m2[3],
+		m1[0]*m2[4] + m1[1]*m2[5] + m1[2]*m2[6] + m1[3]*m2[7],
+		m1[4]*m2[4] + m1[5]*m2[5] + m1[6]*m2[6] + m1[7]*m2[7],
+		m1[8]*m2[4] + m1[9]*m2[5] + m1[10]*m2[6] + m1[11]*m2[7],
+		m1[12]*m2[4] + m1[13]*m2[5] + m1[14]*m2[6] + m1[15]*m2[7],
+		m1[0]*m2[8] + m1[1]*m2[9] + m1[2]*m2[10] + m1[3]*m2[11],
+		m1[4]*m2[8] + m1[5]*m2[9] + m1[6]*m2[10] + m1[7]*m2[11],
+		m1[8]*m2[8] + m1[9]*m2[9] + m1[10]*m2[10] + m1[11]*m2[11],
+		m1[12]*m2[8] + m1[13]*m2[9] + m1[14]*m2[10] + m1[15]*m2[11],
+		m1[0]*m2[12] + m1[1]*m2[13] + m1[2]*m2[14] + m1[3]*m2[15],
+		m1[4]*m2[12] + m1[5]*m2[13] + m1[6]*m2[14] + m1[7]*m2[15],
+		m1[8]*m2[12] + m1[9]*m2[13] + m1[10]*m2[14] + m1[11]*m2[15],
+		m1[12]*m2[12] + m1[13]*m2[13] + m1[14]*m2[14] + m1[15]*m2[15],
+	}
+}
+
+// MulTranspose4 returns m1 * m2^T without explicitly transposing m2.
+//
+// In the row vector convention, matrices are the transposes of the ones
+// used here, and a product A*B of such matrices corresponds to
+// B^T * A^T in this package. MulTranspose4 and TransposeMul4 make it
+// possible to combine the two conventions without intermediate transposes.
+func (m1 Mat4) MulTranspose4(m2 Mat4) Mat4 {
+	return Mat4{
+		m1[0]*m2[0] + m1[4]*m2[4] + m1[8]*m2[8] + m1[12]*m2[12],
+		m1[1]*m2[0] + m1[5]*m2[4] + m1[9]*m2[8] + m1[13]*m2[12],
+		m1[2]*m2[0] + m1[6]*m2[4] + m1[10]*m2[8] + m1[14]*m2[12],
+		m1[3]*m2[0] + m1[7]*m2[4] + m1[11]*m2[8] + m1[15]*m2[12],
+		m1[0]*m2[1] + m1[4]*m2[5] + m1[8]*m2[9] + m1[12]*m2[13],
+		m1[1]*m2[1] + m1[5]*m2[5] + m1[9]*m2[9] + m1[13]*m2[13],
+		m1[2]*m2[1] + m1[6]*m2[5] + m1[10]*m2[9] + m1[14]*m2[13],
+		m1[3]*m2[1] + m1[7]*m2[5] + m1[11]*m2[9] + m1[15]*m2[13],
+		m1[0]*m2[2] + m1[4]*m2[6] + m1[8]*m2[10] + m1[12]*m2[14],
+		m1[1]*m2[2] + m1[5]*m2[6] + m1[9]*m2[10] + m1[13]*m2[14],
+		m1[2]*m2[2] + m1[6]*m2[6] + m1[10]*m2[10] + m1[14]*m2[14],
+		m1[3]*m2[2] + m1[7]*m2[6] + m1[11]*m2[10] + m1[15]*m2[14],
+		m1[0]*m2[3] + m1[4]*m2[7] + m1[8]*m2[11] + m1[12]*m2[15],
+		m1[1]*m2[3] + m1[5]*m2[7] + m1[9]*m2[11] + m1[13]*m2[15],
+		m1[2]*m2[3] + m1[6]*m2[7] + m1[10]*m2[11] + m1[14]*m2[15],
+		m1[3]*m2[3] + m1[7]*m2[7] + m1[11]*m2[11] + m1[15]*m2[15],
+	}
+}
+
 // Det returns the determinant of a matrix. It is a measure of a square matrix's
 // singularity and invertability, among other things. In this library, the
 // determinant is hard coded based on pre-computed cofactor expansion, and uses
@@ -2275,9 +3262,12 @@ func (m Mat4) Det() float32 {
 // If the determinant is 0.0, this function returns the zero matrix. However, due to floating point errors, it is
 // entirely plausible to get a false positive or negative.
 // In the future, an alternate function may be written which takes in a pre-computed determinant.
+//
+// The Singular policy can make this function panic on a singular matrix instead.
 func (m Mat4) Inv() Mat4 {
 	det := m.Det()
 	if FloatEqual(det, float32(0.0)) {
+		singular("Mat4.Inv", m)
 		return Mat4{}
 	}
 
@@ -2303,6 +3293,15 @@ func (m Mat4) Inv() Mat4 {
 	return retMat.Mul(1 / det)
 }
 
+// TryInv is like Inv, but returns ErrSingular for a singular matrix
+// regardless of the Singular policy.
+func (m Mat4) TryInv() (Mat4, error) {
+	if FloatEqual(m.Det(), float32(0.0)) {
+		return Mat4{}, ErrSingular
+	}
+	return m.Inv(), nil
+}
+
 // ApproxEqual performs an element-wise approximate equality test between two matrices,
 // as if FloatEqual had been used.
 func (m1 Mat4) ApproxEqual(m2 Mat4) bool {
@@ -2408,11 +3407,59 @@ func (m Mat4) Trace() float32 {
 	return m[0] + m[5] + m[10] + m[15]
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. This is the Len of the matrix seen
+// as a vector, and bounds the spectral norm (the largest singular value) from above.
+func (m Mat4) NormFrobenius() float32 {
+	return float32(math.Sqrt(float64(m[0]*m[0] + m[1]*m[1] + m[2]*m[2] + m[3]*m[3] + m[4]*m[4] + m[5]*m[5] + m[6]*m[6] + m[7]*m[7] + m[8]*m[8] + m[9]*m[9] + m[10]*m[10] + m[11]*m[11] + m[12]*m[12] + m[13]*m[13] + m[14]*m[14] + m[15]*m[15])))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column.
+func (m Mat4) Norm1() float32 {
+	var norm float32
+	for col := 0; col < 4; col++ {
+		sum := Abs(m[col*4+0]) + Abs(m[col*4+1]) + Abs(m[col*4+2]) + Abs(m[col*4+3])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row.
+func (m Mat4) NormInf() float32 {
+	var norm float32
+	for row := 0; row < 4; row++ {
+		sum := Abs(m[0*4+row]) + Abs(m[1*4+row]) + Abs(m[2*4+row]) + Abs(m[3*4+row])
+		if sum > norm {
+			norm = sum
+		}
+	}
+	return norm
+}
+
 // Abs returns the element-wise absolute value of this matrix
 func (m Mat4) Abs() Mat4 {
 	return Mat4{Abs(m[0]), Abs(m[1]), Abs(m[2]), Abs(m[3]), Abs(m[4]), Abs(m[5]), Abs(m[6]), Abs(m[7]), Abs(m[8]), Abs(m[9]), Abs(m[10]), Abs(m[11]), Abs(m[12]), Abs(m[13]), Abs(m[14]), Abs(m[15])}
 }
 
+// PutBytes stores the elements of m in b in column major order and the given
+// byte order, as described in bytes.go. b must have room for all 16
+// elements or this panics.
+func (m Mat4) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, m[:])
+}
+
+// Mat4FromBytes reads a matrix stored by PutBytes from b in the given
+// byte order. b must hold all 16 elements or this panics.
+func Mat4FromBytes(b []byte, order binary.ByteOrder) Mat4 {
+	var m Mat4
+	getScalars(m[:], b, order)
+	return m
+}
+
 // Pretty prints the matrix
 func (m Mat4) String() string {
 	buf := new(bytes.Buffer)
@@ -0,0 +1,268 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"sort"
+)
+
+// MeshBVH is a static bounding volume hierarchy of axis-aligned boxes over
+// the triangles of an indexed mesh, answering raycasts, closest point and
+// sphere overlap queries in (on average) logarithmic time.
+//
+// The nodes are stored depth first in a single slice, and the triangles are
+// copied in leaf order as a vertex and two edges, as used by the ray test, so
+// queries don't chase pointers or indices into the original mesh.
+type MeshBVH struct {
+	nodes []meshBVHNode
+	tris  []meshBVHTri
+}
+
+// meshBVHNode is a node of a MeshBVH. For leaves, count is the number of
+// triangles starting at first; inner nodes have a count of 0, their left
+// child right after them and their right child at index first.
+type meshBVHNode struct {
+	box          AABB
+	first, count int32
+}
+
+// meshBVHTri is a triangle of a MeshBVH, with its index in the mesh.
+type meshBVHTri struct {
+	a, ab, ac Vec3
+	index     int32
+}
+
+// meshBVHLeafSize is the largest number of triangles in a leaf.
+const meshBVHLeafSize = 4
+
+// MeshHit describes where a ray hits a mesh.
+type MeshHit struct {
+	// Triangle is the index of the triangle hit, so its vertices are
+	// indices[3*Triangle:3*Triangle+3].
+	Triangle int
+	// T is the distance along the ray, in units of the length of its Dir.
+	T float32
+	// U and V are the barycentric coordinates of the hit point in the
+	// triangle (a,b,c), which is a + U*(b-a) + V*(c-a); use them to
+	// interpolate vertex attributes.
+	U, V float32
+}
+
+// NewMeshBVH builds a MeshBVH over the triangle mesh given by vertices and
+// indices, each consecutive triple of indices being a triangle. Later changes
+// to the mesh are not reflected in the tree.
+func NewMeshBVH(vertices []Vec3, indices []uint32) *MeshBVH {
+	n := len(indices) / 3
+	bvh := &MeshBVH{tris: make([]meshBVHTri, n)}
+	centroids := make([]Vec3, n)
+	for i := range bvh.tris {
+		a, b, c := vertices[indices[3*i]], vertices[indices[3*i+1]], vertices[indices[3*i+2]]
+		bvh.tris[i] = meshBVHTri{a, b.Sub(a), c.Sub(a), int32(i)}
+		centroids[i] = a.Add(b).Add(c).Mul(1. / 3)
+	}
+
+	if n > 0 {
+		bvh.nodes = make([]meshBVHNode, 0, 2*(n+meshBVHLeafSize-1)/meshBVHLeafSize)
+		bvh.build(0, n, centroids)
+	}
+	return bvh
+}
+
+// build appends the subtree over the triangles [lo, hi) to the nodes, sorting
+// them along the way.
+func (bvh *MeshBVH) build(lo, hi int, centroids []Vec3) {
+	tris := bvh.tris[lo:hi]
+	box := bvh.tris[lo].bounds()
+	center := AABB{centroids[lo], centroids[lo]}
+	for i := lo + 1; i < hi; i++ {
+		box = box.Union(bvh.tris[i].bounds())
+		center = center.Union(AABB{centroids[i], centroids[i]})
+	}
+
+	node := len(bvh.nodes)
+	bvh.nodes = append(bvh.nodes, meshBVHNode{box: box, first: int32(lo), count: int32(hi - lo)})
+	if hi-lo <= meshBVHLeafSize {
+		return
+	}
+
+	// Split at the median along the longest axis of the centroids.
+	size := center.Max.Sub(center.Min)
+	axis := 0
+	if size[1] > size[axis] {
+		axis = 1
+	}
+	if size[2] > size[axis] {
+		axis = 2
+	}
+	sort.Sort(meshBVHSorter{tris, centroids[lo:hi], axis})
+
+	mid := (lo + hi) / 2
+	bvh.build(lo, mid, centroids)
+	bvh.nodes[node].first, bvh.nodes[node].count = int32(len(bvh.nodes)), 0
+	bvh.build(mid, hi, centroids)
+}
+
+// meshBVHSorter sorts triangles along with their centroids.
+type meshBVHSorter struct {
+	tris      []meshBVHTri
+	centroids []Vec3
+	axis      int
+}
+
+func (s meshBVHSorter) Len() int { return len(s.tris) }
+
+func (s meshBVHSorter) Less(i, j int) bool {
+	return s.centroids[i][s.axis] < s.centroids[j][s.axis]
+}
+
+func (s meshBVHSorter) Swap(i, j int) {
+	s.tris[i], s.tris[j] = s.tris[j], s.tris[i]
+	s.centroids[i], s.centroids[j] = s.centroids[j], s.centroids[i]
+}
+
+func (tri meshBVHTri) bounds() AABB {
+	return AABBFromPoints(tri.a, tri.a.Add(tri.ab), tri.a.Add(tri.ac))
+}
+
+func (tri meshBVHTri) vertices() (a, b, c Vec3) {
+	return tri.a, tri.a.Add(tri.ab), tri.a.Add(tri.ac)
+}
+
+// Len returns the number of triangles in the tree.
+func (bvh *MeshBVH) Len() int {
+	return len(bvh.tris)
+}
+
+// Bounds returns the bounding box of the mesh, or EmptyAABB() if it has no
+// triangles.
+func (bvh *MeshBVH) Bounds() AABB {
+	if len(bvh.nodes) == 0 {
+		return EmptyAABB()
+	}
+	return bvh.nodes[0].box
+}
+
+// Raycast returns the first hit of the ray r on the mesh with 0 <= T <= maxT.
+// Triangles are hit from both sides. Pass InfPos as maxT for an unbounded ray.
+func (bvh *MeshBVH) Raycast(r Ray, maxT float32) (hit MeshHit, ok bool) {
+	if len(bvh.nodes) == 0 {
+		return MeshHit{}, false
+	}
+
+	hit.T = maxT
+	stack := make([]int32, 1, 64)
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		node := &bvh.nodes[index]
+		stack = stack[:len(stack)-1]
+
+		if t, in := rayAABB(r.Origin, r.Dir, node.box); !in || t > hit.T {
+			continue
+		}
+
+		if node.count > 0 {
+			for _, tri := range bvh.tris[node.first : node.first+node.count] {
+				if t, u, v, in := tri.intersectRay(r); in && t <= hit.T {
+					hit, ok = MeshHit{int(tri.index), t, u, v}, true
+				}
+			}
+			continue
+		}
+
+		// Push the farther child first, so the nearer one is searched first
+		// and shortens the ray for the other.
+		left, right := index+1, node.first
+		tl, _ := rayAABB(r.Origin, r.Dir, bvh.nodes[left].box)
+		tr, _ := rayAABB(r.Origin, r.Dir, bvh.nodes[right].box)
+		if tl < tr {
+			left, right = right, left
+		}
+		stack = append(stack, left, right)
+	}
+
+	return hit, ok
+}
+
+// intersectRay intersects the ray with the triangle using the Möller-Trumbore
+// algorithm, returning the distance and barycentric coordinates of the hit.
+func (tri meshBVHTri) intersectRay(r Ray) (t, u, v float32, ok bool) {
+	return intersectTriangle(r, tri.a, tri.ab, tri.ac)
+}
+
+// ClosestPoint returns the point of the mesh closest to p, and the index of
+// the triangle it lies on. If the mesh has no triangles, the triangle is -1.
+func (bvh *MeshBVH) ClosestPoint(p Vec3) (point Vec3, triangle int) {
+	triangle = -1
+	if len(bvh.nodes) == 0 {
+		return Vec3{}, triangle
+	}
+
+	best := InfPos
+	stack := make([]int32, 1, 64)
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		node := &bvh.nodes[index]
+		stack = stack[:len(stack)-1]
+
+		if clampToAABB(p, node.box).Sub(p).LenSqr() > best {
+			continue
+		}
+
+		if node.count > 0 {
+			for _, tri := range bvh.tris[node.first : node.first+node.count] {
+				a, b, c := tri.vertices()
+				q := ClosestPointTriangle(p, a, b, c)
+				if d := q.Sub(p).LenSqr(); d < best {
+					point, triangle, best = q, int(tri.index), d
+				}
+			}
+			continue
+		}
+
+		left, right := index+1, node.first
+		dl := clampToAABB(p, bvh.nodes[left].box).Sub(p).LenSqr()
+		dr := clampToAABB(p, bvh.nodes[right].box).Sub(p).LenSqr()
+		if dl < dr {
+			left, right = right, left
+		}
+		stack = append(stack, left, right)
+	}
+
+	return point, triangle
+}
+
+// OverlapSphere appends to dst the indices of the triangles intersecting (or
+// touching) the sphere s, in no particular order, and returns the extended
+// slice.
+func (bvh *MeshBVH) OverlapSphere(dst []int, s Sphere) []int {
+	if len(bvh.nodes) == 0 {
+		return dst
+	}
+
+	rr := s.Radius * s.Radius
+	stack := make([]int32, 1, 64)
+	for len(stack) > 0 {
+		index := stack[len(stack)-1]
+		node := &bvh.nodes[index]
+		stack = stack[:len(stack)-1]
+
+		if clampToAABB(s.Center, node.box).Sub(s.Center).LenSqr() > rr {
+			continue
+		}
+
+		if node.count > 0 {
+			for _, tri := range bvh.tris[node.first : node.first+node.count] {
+				if _, ok := s.IntersectsTriangle(tri.vertices()); ok {
+					dst = append(dst, int(tri.index))
+				}
+			}
+			continue
+		}
+
+		stack = append(stack, index+1, node.first)
+	}
+
+	return dst
+}
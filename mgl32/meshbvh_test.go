@@ -0,0 +1,125 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// randomTriangleSoup returns n small random triangles in the cube [-5,5]^3.
+func randomTriangleSoup(r *rand.Rand, n int) ([]Vec3, []uint32) {
+	var vertices []Vec3
+	var indices []uint32
+	rnd := func(s float32) float32 { return (r.Float32()*2 - 1) * s }
+	for i := 0; i < n; i++ {
+		c := Vec3{rnd(5), rnd(5), rnd(5)}
+		for k := 0; k < 3; k++ {
+			indices = append(indices, uint32(len(vertices)))
+			vertices = append(vertices, c.Add(Vec3{rnd(0.5), rnd(0.5), rnd(0.5)}))
+		}
+	}
+	return vertices, indices
+}
+
+func TestMeshBVHBox(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -2, -3}, Vec3{1, 2, 3}}
+	vertices, indices := boxMesh(box)
+	bvh := NewMeshBVH(vertices, indices)
+
+	if bvh.Len() != 12 || bvh.Bounds() != box {
+		t.Errorf("BVH of a box has %d triangles and bounds %v", bvh.Len(), bvh.Bounds())
+	}
+
+	hit, ok := bvh.Raycast(Ray{Vec3{0.5, 0.5, 10}, Vec3{0, 0, -1}}, InfPos)
+	if !ok || !FloatEqual(hit.T, 7) || hit.Triangle/2 != 1 {
+		t.Errorf("Raycast onto +z = %v, %v, want T 7 on a +z triangle", hit, ok)
+	}
+	a, b, c := vertices[indices[3*hit.Triangle]], vertices[indices[3*hit.Triangle+1]], vertices[indices[3*hit.Triangle+2]]
+	if p := a.Add(b.Sub(a).Mul(hit.U)).Add(c.Sub(a).Mul(hit.V)); !p.ApproxEqualThreshold(Vec3{0.5, 0.5, 3}, 1e-6) {
+		t.Errorf("barycentric coordinates of the hit give %v", p)
+	}
+
+	if _, ok := bvh.Raycast(Ray{Vec3{0.5, 0.5, 10}, Vec3{0, 0, -1}}, 6); ok {
+		t.Errorf("Raycast hit the box beyond maxT")
+	}
+	if hit, ok := bvh.Raycast(Ray{Vec3{}, Vec3{1, 0, 0}}, InfPos); !ok || !FloatEqual(hit.T, 1) {
+		t.Errorf("Raycast from inside = %v, %v, want T 1", hit, ok)
+	}
+
+	p, tri := bvh.ClosestPoint(Vec3{5, 0.5, 0.5})
+	if !p.ApproxEqual(Vec3{1, 0.5, 0.5}) || tri/2 != 5 {
+		t.Errorf("ClosestPoint = %v on %d, want {1, 0.5, 0.5} on a +x triangle", p, tri)
+	}
+
+	if got := bvh.OverlapSphere(nil, Sphere{Vec3{0, 0, 3.5}, 0.6}); len(got) != 2 {
+		t.Errorf("OverlapSphere near +z = %v, want its 2 triangles", got)
+	}
+
+	empty := NewMeshBVH(nil, nil)
+	if _, ok := empty.Raycast(Ray{Vec3{}, Vec3{1, 0, 0}}, InfPos); ok {
+		t.Errorf("Raycast hit an empty mesh")
+	}
+	if _, tri := empty.ClosestPoint(Vec3{}); tri != -1 {
+		t.Errorf("ClosestPoint on an empty mesh returned triangle %d", tri)
+	}
+}
+
+func TestMeshBVHRandom(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(42))
+	vertices, indices := randomTriangleSoup(r, 500)
+	bvh := NewMeshBVH(vertices, indices)
+	tris := make([]meshBVHTri, len(indices)/3)
+	for i := range tris {
+		a, b, c := vertices[indices[3*i]], vertices[indices[3*i+1]], vertices[indices[3*i+2]]
+		tris[i] = meshBVHTri{a, b.Sub(a), c.Sub(a), int32(i)}
+	}
+
+	for i := 0; i < 200; i++ {
+		p := Vec3{r.Float32()*14 - 7, r.Float32()*14 - 7, r.Float32()*14 - 7}
+		ray := Ray{p, Vec3{r.Float32() - 0.5, r.Float32() - 0.5, r.Float32() - 0.5}}
+
+		want, wantOk := MeshHit{T: InfPos}, false
+		bestDist, bestTri := InfPos, -1
+		var overlap []int
+		s := Sphere{p, 1}
+		for _, tri := range tris {
+			if t, u, v, ok := tri.intersectRay(ray); ok && t < want.T {
+				want, wantOk = MeshHit{int(tri.index), t, u, v}, true
+			}
+			a, b, c := tri.vertices()
+			if d := ClosestPointTriangle(p, a, b, c).Sub(p).LenSqr(); d < bestDist {
+				bestDist, bestTri = d, int(tri.index)
+			}
+			if _, ok := s.IntersectsTriangle(a, b, c); ok {
+				overlap = append(overlap, int(tri.index))
+			}
+		}
+
+		if hit, ok := bvh.Raycast(ray, InfPos); ok != wantOk || ok && hit != want {
+			t.Errorf("Raycast(%v) = %v, %v, want %v, %v", ray, hit, ok, want, wantOk)
+		}
+		if q, tri := bvh.ClosestPoint(p); tri != bestTri || !FloatEqualThreshold(q.Sub(p).LenSqr(), bestDist, 1e-5) {
+			t.Errorf("ClosestPoint(%v) is on %d at %v, want %d at %v", p, tri, q.Sub(p).LenSqr(), bestTri, bestDist)
+		}
+		got := bvh.OverlapSphere(nil, s)
+		sort.Ints(got)
+		if len(got) != len(overlap) {
+			t.Errorf("OverlapSphere(%v) = %v, want %v", s, got, overlap)
+			continue
+		}
+		for i := range got {
+			if got[i] != overlap[i] {
+				t.Errorf("OverlapSphere(%v) = %v, want %v", s, got, overlap)
+				break
+			}
+		}
+	}
+}
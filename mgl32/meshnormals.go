@@ -0,0 +1,145 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// NormalWeighting determines how the normals of the triangles around a vertex
+// are weighted into its smooth normal.
+type NormalWeighting int
+
+// The NormalWeighting constants. WeightArea is the cheapest, and favors the
+// normals of large triangles. WeightAngle weights each triangle by its angle
+// at the vertex, so the result does not depend on how the surface around the
+// vertex is triangulated, as recommended by Thürmer and Wüthrich (1998).
+const (
+	WeightArea NormalWeighting = iota
+	WeightAngle
+)
+
+// faceNormals returns the normal of each triangle of the mesh, of length twice
+// its area for WeightArea and of unit length for WeightAngle, and the weights
+// of its corners.
+func faceNormals(vertices []Vec3, indices []uint32, weighting NormalWeighting) (normals []Vec3, weights [][3]float32) {
+	normals = make([]Vec3, len(indices)/3)
+	weights = make([][3]float32, len(normals))
+	for f := range normals {
+		var p [3]Vec3
+		for i := range p {
+			p[i] = vertices[indices[3*f+i]]
+		}
+		normals[f] = p[1].Sub(p[0]).Cross(p[2].Sub(p[0]))
+
+		for i := range p {
+			if weighting == WeightArea {
+				weights[f][i] = 1
+				continue
+			}
+			e1, e2 := p[(i+1)%3].Sub(p[i]), p[(i+2)%3].Sub(p[i])
+			weights[f][i] = float32(math.Atan2(float64(e1.Cross(e2).Len()), float64(e1.Dot(e2))))
+		}
+		if weighting == WeightAngle {
+			if l := normals[f].Len(); l > 0 {
+				normals[f] = normals[f].Mul(1 / l)
+			}
+		}
+	}
+	return normals, weights
+}
+
+// normalizeOrZero returns v normalized, or the zero vector if v is zero.
+func normalizeOrZero(v Vec3) Vec3 {
+	if l := v.Len(); l > 0 {
+		return v.Mul(1 / l)
+	}
+	return Vec3{}
+}
+
+// VertexNormals returns the smooth normal of each vertex of the triangle mesh
+// given by vertices and indices, each consecutive triple of indices being a
+// triangle winding counterclockwise seen from the front. The normals of the
+// triangles around a vertex are combined as given by weighting. Vertices not
+// used by any triangle, or only by degenerate ones, get a zero normal.
+//
+// Vertices shared by triangles on both sides of a hard edge get a normal
+// averaging both sides; see CreaseVertexNormals to split them.
+func VertexNormals(vertices []Vec3, indices []uint32, weighting NormalWeighting) []Vec3 {
+	faces, weights := faceNormals(vertices, indices, weighting)
+
+	normals := make([]Vec3, len(vertices))
+	for f, n := range faces {
+		for i := 0; i < 3; i++ {
+			v := indices[3*f+i]
+			normals[v] = normals[v].Add(n.Mul(weights[f][i]))
+		}
+	}
+	for i := range normals {
+		normals[i] = normalizeOrZero(normals[i])
+	}
+
+	return normals
+}
+
+// CreaseVertexNormals computes smooth vertex normals like VertexNormals, but
+// only smooths across edges where the triangles meet at an angle of at most
+// creaseAngle radians; the vertices of sharper edges are split, so that each
+// side gets its own normal.
+//
+// The result is a new mesh: normals[i] is the normal of its vertex i, which
+// is at the position vertices[sources[i]] of the input mesh (use sources to
+// copy any other vertex attributes), and newIndices are its triangles, in the
+// same order as the input. Corners of a vertex getting the same normal share
+// a single output vertex.
+//
+// Each corner of a triangle averages the triangles around its vertex whose
+// normal is within creaseAngle of its own, so a creaseAngle of 0 gives flat
+// shading and one of Pi the same normals as VertexNormals.
+func CreaseVertexNormals(vertices []Vec3, indices []uint32, weighting NormalWeighting, creaseAngle float32) (normals []Vec3, newIndices []uint32, sources []uint32) {
+	faces, weights := faceNormals(vertices, indices, weighting)
+	unit := make([]Vec3, len(faces))
+	for f, n := range faces {
+		unit[f] = normalizeOrZero(n)
+	}
+
+	// The triangles around each vertex, as corner indices.
+	corners := make([][]int, len(vertices))
+	for c := 0; c < 3*len(faces); c++ {
+		corners[indices[c]] = append(corners[indices[c]], c)
+	}
+
+	cosCrease := float32(math.Cos(float64(creaseAngle)))
+	type vertexNormal struct {
+		source uint32
+		normal Vec3
+	}
+	shared := make(map[vertexNormal]uint32)
+	newIndices = make([]uint32, 3*len(faces))
+
+	for c := range newIndices {
+		v, f := indices[c], c/3
+
+		var sum Vec3
+		for _, c2 := range corners[v] {
+			f2 := c2 / 3
+			if f2 == f || unit[f].Dot(unit[f2]) >= cosCrease {
+				sum = sum.Add(faces[f2].Mul(weights[f2][c2%3]))
+			}
+		}
+		key := vertexNormal{v, normalizeOrZero(sum)}
+
+		i, ok := shared[key]
+		if !ok {
+			i = uint32(len(normals))
+			shared[key] = i
+			normals = append(normals, key.normal)
+			sources = append(sources, v)
+		}
+		newIndices[c] = i
+	}
+
+	return normals, newIndices, sources
+}
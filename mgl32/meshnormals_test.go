@@ -0,0 +1,81 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVertexNormals(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -2, -3}, Vec3{1, 2, 3}}
+	vertices, indices := boxMesh(box)
+	center := box.Min.Add(box.Max).Mul(0.5)
+
+	// Angle weighting doesn't depend on how faces are split into triangles,
+	// so the corners of the box get the diagonal normals of a cube.
+	normals := VertexNormals(vertices, indices, WeightAngle)
+	for i, n := range normals {
+		var want Vec3
+		for k := range want {
+			want[k] = float32(math.Copysign(1/math.Sqrt(3), float64(vertices[i][k]-center[k])))
+		}
+		if !n.ApproxEqualThreshold(want, 1e-5) {
+			t.Errorf("angle weighted normal of %v = %v, want %v", vertices[i], n, want)
+		}
+	}
+
+	// Area weighting favors the larger faces, but still points outwards.
+	for i, n := range VertexNormals(vertices, indices, WeightArea) {
+		if !FloatEqualThreshold(n.Len(), 1, 1e-5) || n.Dot(vertices[i].Sub(center)) <= 0 {
+			t.Errorf("area weighted normal of %v = %v", vertices[i], n)
+		}
+	}
+
+	// A lone triangle, and an unused vertex.
+	tri := []Vec3{{0, 0, 0}, {2, 0, 0}, {0, 1, 0}, {5, 5, 5}}
+	normals = VertexNormals(tri, []uint32{0, 1, 2}, WeightArea)
+	for i, want := range []Vec3{{0, 0, 1}, {0, 0, 1}, {0, 0, 1}, {}} {
+		if normals[i] != want {
+			t.Errorf("normal of vertex %d of a triangle = %v, want %v", i, normals[i], want)
+		}
+	}
+}
+
+func TestCreaseVertexNormals(t *testing.T) {
+	t.Parallel()
+
+	vertices, indices := boxMesh(AABB{Vec3{-1, -1, -1}, Vec3{1, 1, 1}})
+
+	// Sharp edges split each corner of the box into one vertex per face.
+	normals, newIndices, sources := CreaseVertexNormals(vertices, indices, WeightAngle, math.Pi/4)
+	if len(normals) != 24 || len(sources) != 24 || len(newIndices) != len(indices) {
+		t.Fatalf("creased box has %d vertices and %d indices, want 24 and %d", len(normals), len(newIndices), len(indices))
+	}
+	for c, i := range newIndices {
+		if sources[i] != indices[c] {
+			t.Errorf("corner %d uses vertex %d of source %d, want source %d", c, i, sources[i], indices[c])
+		}
+		f := c / 3
+		a, b, d := vertices[indices[3*f]], vertices[indices[3*f+1]], vertices[indices[3*f+2]]
+		if want := b.Sub(a).Cross(d.Sub(a)).Normalize(); !normals[i].ApproxEqualThreshold(want, 1e-6) {
+			t.Errorf("creased normal of corner %d = %v, want %v", c, normals[i], want)
+		}
+	}
+
+	// With no crease, the vertices are the same as VertexNormals'.
+	smooth := VertexNormals(vertices, indices, WeightAngle)
+	normals, newIndices, sources = CreaseVertexNormals(vertices, indices, WeightAngle, math.Pi)
+	if len(normals) != len(vertices) {
+		t.Fatalf("uncreased box has %d vertices, want %d", len(normals), len(vertices))
+	}
+	for c, i := range newIndices {
+		if sources[i] != indices[c] || !normals[i].ApproxEqualThreshold(smooth[indices[c]], 1e-6) {
+			t.Errorf("uncreased corner %d has normal %v of source %d, want %v of %d", c, normals[i], sources[i], smooth[indices[c]], indices[c])
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mgltest provides test assertions for the types of the mgl32
+// package that compare within a tolerance and, on failure, report which
+// elements differ and by how much, instead of a bare boolean.
+//
+// Elements are compared with an absolute tolerance: they are near if they are
+// equal (so infinities of the same sign match) or differ by at most eps. NaNs
+// never match. Each assertion reports its failure with t.Errorf, so the test
+// continues, and returns whether it passed.
+package mgltest
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+func near(want, got, eps float32) bool {
+	return want == got || mgl32.Abs(want-got) <= eps
+}
+
+// AssertFloatNear checks that got is within eps of want.
+func AssertFloatNear(t testing.TB, want, got, eps float32) bool {
+	t.Helper()
+	if near(want, got, eps) {
+		return true
+	}
+	t.Errorf("want %v, got %v, diff %v (eps %v)", want, got, got-want, eps)
+	return false
+}
+
+// AssertMatrixNear checks that every element of got is within eps of the
+// same element of want, which must have the same size. On failure it prints
+// got with the differing elements marked, as mgl32.FormatMatrixDiff does.
+func AssertMatrixNear(t testing.TB, want, got mgl32.Matrix, eps float32) bool {
+	t.Helper()
+	ok := want.NumRows() == got.NumRows() && want.NumCols() == got.NumCols()
+	for row := 0; ok && row < want.NumRows(); row++ {
+		for col := 0; ok && col < want.NumCols(); col++ {
+			ok = near(want.At(row, col), got.At(row, col), eps)
+		}
+	}
+	if ok {
+		return true
+	}
+	t.Errorf("matrices differ (eps %v):\n%s", eps, mgl32.FormatMatrixDiff(want, got, eps, mgl32.DefaultFormat))
+	return false
+}
+
+// AssertMat2Near is AssertMatrixNear for Mat2.
+func AssertMat2Near(t testing.TB, want, got mgl32.Mat2, eps float32) bool {
+	t.Helper()
+	return AssertMatrixNear(t, want, got, eps)
+}
+
+// AssertMat3Near is AssertMatrixNear for Mat3.
+func AssertMat3Near(t testing.TB, want, got mgl32.Mat3, eps float32) bool {
+	t.Helper()
+	return AssertMatrixNear(t, want, got, eps)
+}
+
+// AssertMat4Near is AssertMatrixNear for Mat4.
+func AssertMat4Near(t testing.TB, want, got mgl32.Mat4, eps float32) bool {
+	t.Helper()
+	return AssertMatrixNear(t, want, got, eps)
+}
+
+// AssertVec2Near checks that every element of got is within eps of the same
+// element of want.
+func AssertVec2Near(t testing.TB, want, got mgl32.Vec2, eps float32) bool {
+	t.Helper()
+	return assertElementsNear(t, "vectors", want[:], got[:], eps)
+}
+
+// AssertVec3Near checks that every element of got is within eps of the same
+// element of want.
+func AssertVec3Near(t testing.TB, want, got mgl32.Vec3, eps float32) bool {
+	t.Helper()
+	return assertElementsNear(t, "vectors", want[:], got[:], eps)
+}
+
+// AssertVec4Near checks that every element of got is within eps of the same
+// element of want.
+func AssertVec4Near(t testing.TB, want, got mgl32.Vec4, eps float32) bool {
+	t.Helper()
+	return assertElementsNear(t, "vectors", want[:], got[:], eps)
+}
+
+// AssertQuatNear checks that every element of got is within eps of the same
+// element of want, in W, X, Y, Z order. Since q and -q are the same rotation,
+// use AssertOrientationNear to compare rotations.
+func AssertQuatNear(t testing.TB, want, got mgl32.Quat, eps float32) bool {
+	t.Helper()
+	w, g := want.WXYZ(), got.WXYZ()
+	return assertElementsNear(t, "quaternions", w[:], g[:], eps)
+}
+
+// AssertOrientationNear checks that the unit quaternions want and got are
+// the same rotation, within eps, as AssertQuatNear checks either got or -got.
+func AssertOrientationNear(t testing.TB, want, got mgl32.Quat, eps float32) bool {
+	t.Helper()
+	if want.Dot(got) < 0 {
+		got = got.Scale(-1)
+	}
+	w, g := want.WXYZ(), got.WXYZ()
+	return assertElementsNear(t, "orientations", w[:], g[:], eps)
+}
+
+func assertElementsNear(t testing.TB, what string, want, got []float32, eps float32) bool {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	for i := range want {
+		if !near(want[i], got[i], eps) {
+			fmt.Fprintf(buf, "\n[%d]: want %v, got %v, diff %v", i, want[i], got[i], got[i]-want[i])
+		}
+	}
+	if buf.Len() == 0 {
+		return true
+	}
+	t.Errorf("%s differ (eps %v): want %v, got %v%s", what, eps, want, got, buf)
+	return false
+}
@@ -0,0 +1,91 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgltest
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+)
+
+// recorder captures the failures reported to it.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertions(t *testing.T) {
+	t.Parallel()
+
+	nan := float32(math.NaN())
+	tests := []struct {
+		name   string
+		assert func(testing.TB) bool
+		ok     bool
+		report []string
+	}{
+		{"float", func(tb testing.TB) bool { return AssertFloatNear(tb, 1, 1.0005, 1e-3) }, true, nil},
+		{"float", func(tb testing.TB) bool { return AssertFloatNear(tb, 1, 1.5, 1e-3) }, false, []string{"want 1, got 1.5, diff 0.5"}},
+		{"NaN", func(tb testing.TB) bool { return AssertFloatNear(tb, nan, nan, 1) }, false, []string{"want NaN"}},
+		{"Inf", func(tb testing.TB) bool {
+			return AssertFloatNear(tb, float32(math.Inf(1)), float32(math.Inf(1)), 0)
+		}, true, nil},
+
+		{"Mat4", func(tb testing.TB) bool {
+			return AssertMat4Near(tb, mgl32.Ident4(), mgl32.Ident4().Mul(1+1e-6), 1e-5)
+		}, true, nil},
+		{"Mat4", func(tb testing.TB) bool {
+			return AssertMat4Near(tb, mgl32.Ident4(), mgl32.Translate3D(0, 2, 0), 1e-5)
+		}, false, []string{"*2.000000", "(1, 3): want 0.000000, got 2.000000, diff 2.000000"}},
+		{"Mat3", func(tb testing.TB) bool {
+			return AssertMat3Near(tb, mgl32.Ident3(), mgl32.Diag3(mgl32.Vec3{1, 1, 1.1}), 1e-2)
+		}, false, []string{"(2, 2): want 1.000000, got 1.100000"}},
+		{"Mat2", func(tb testing.TB) bool { return AssertMat2Near(tb, mgl32.Mat2{}, mgl32.Mat2{}, 0) }, true, nil},
+		{"MatMxN size", func(tb testing.TB) bool {
+			return AssertMatrixNear(tb, mgl32.NewMatrixFromData([]float32{1, 2}, 1, 2), mgl32.NewMatrixFromData([]float32{1, 2}, 2, 1), 1)
+		}, false, []string{"size mismatch: want 1x2, got 2x1"}},
+
+		{"Vec3", func(tb testing.TB) bool {
+			return AssertVec3Near(tb, mgl32.Vec3{1, 2, 3}, mgl32.Vec3{1, 2, 3.001}, 1e-2)
+		}, true, nil},
+		{"Vec3", func(tb testing.TB) bool {
+			return AssertVec3Near(tb, mgl32.Vec3{1, 2, 3}, mgl32.Vec3{1, 2.5, 3}, 1e-2)
+		}, false, []string{"[1]: want 2, got 2.5, diff 0.5"}},
+		{"Vec2", func(tb testing.TB) bool { return AssertVec2Near(tb, mgl32.Vec2{1, 2}, mgl32.Vec2{0, 2}, 0.5) }, false, []string{"[0]: want 1, got 0"}},
+		{"Vec4", func(tb testing.TB) bool { return AssertVec4Near(tb, mgl32.Vec4{}, mgl32.Vec4{0, 0, 0, nan}, 1) }, false, []string{"[3]: want 0, got NaN"}},
+
+		{"Quat", func(tb testing.TB) bool {
+			return AssertQuatNear(tb, mgl32.QuatIdent(), mgl32.QuatIdent().Scale(-1), 1e-5)
+		}, false, []string{"[0]: want 1, got -1"}},
+		{"orientation", func(tb testing.TB) bool {
+			return AssertOrientationNear(tb, mgl32.QuatIdent(), mgl32.QuatIdent().Scale(-1), 1e-5)
+		}, true, nil},
+		{"orientation", func(tb testing.TB) bool {
+			return AssertOrientationNear(tb, mgl32.QuatIdent(), mgl32.QuatRotate(0.1, mgl32.Vec3{0, 0, 1}), 1e-5)
+		}, false, []string{"orientations differ", "[3]:"}},
+	}
+
+	for _, test := range tests {
+		r := &recorder{TB: t}
+		if ok := test.assert(r); ok != test.ok || ok != (len(r.errors) == 0) {
+			t.Errorf("%s assertion returned %v with errors %q, want %v", test.name, ok, r.errors, test.ok)
+			continue
+		}
+		for _, want := range test.report {
+			if len(r.errors) != 1 || !strings.Contains(r.errors[0], want) {
+				t.Errorf("%s assertion reported %q, want it to contain %q", test.name, r.errors, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// RoundingMode determines how the normalized integer conversions (such as
+// FloatToUnorm8) round values that fall between two representable integers.
+type RoundingMode int
+
+// The RoundingMode constants. RoundNearestEven is what D3D10+ and current
+// OpenGL/Vulkan implementations use when converting floats to normalized
+// integers, RoundTowardZero matches GPUs (and code) that simply truncate.
+// RoundTowardNegative and RoundTowardPositive are the floor and ceiling, as
+// needed to find the cells of a grid that coordinates fall into.
+const (
+	RoundNearestEven RoundingMode = iota
+	RoundHalfAwayFromZero
+	RoundTowardZero
+	RoundTowardNegative
+	RoundTowardPositive
+)
+
+func (mode RoundingMode) round(v float64) float64 {
+	switch mode {
+	case RoundNearestEven:
+		return math.RoundToEven(v)
+	case RoundHalfAwayFromZero:
+		return math.Round(v)
+	case RoundTowardZero:
+		return math.Trunc(v)
+	case RoundTowardNegative:
+		return math.Floor(v)
+	case RoundTowardPositive:
+		return math.Ceil(v)
+	default:
+		panic("Unsupported rounding mode")
+	}
+}
+
+// FloatToUnorm converts v to an unsigned normalized integer with the given
+// number of bits. This clamps v to [0,1] and maps it linearly to [0,2^bits-1],
+// rounding according to mode. Bits must be in the range [1,32].
+func FloatToUnorm(v float32, bits uint, mode RoundingMode) uint32 {
+	maxValue := float64(uint64(1)<<bits - 1)
+	if v != v { // NaN converts to zero, as on GPUs
+		return 0
+	}
+	return uint32(mode.round(float64(Clamp(v, 0, 1)) * maxValue))
+}
+
+// UnormToFloat converts an unsigned normalized integer with the given number of
+// bits back to a float in the range [0,1].
+func UnormToFloat(v uint32, bits uint) float32 {
+	return float32(float64(v) / float64(uint64(1)<<bits-1))
+}
+
+// FloatToSnorm converts v to a signed normalized integer with the given number
+// of bits. This clamps v to [-1,1] and maps it linearly to
+// [-(2^(bits-1)-1),2^(bits-1)-1], rounding according to mode. The most negative
+// value of the integer type is never produced. Bits must be in the range [2,32].
+func FloatToSnorm(v float32, bits uint, mode RoundingMode) int32 {
+	maxValue := float64(uint64(1)<<(bits-1) - 1)
+	if v != v {
+		return 0
+	}
+	return int32(mode.round(float64(Clamp(v, -1, 1)) * maxValue))
+}
+
+// SnormToFloat converts a signed normalized integer with the given number of
+// bits back to a float in the range [-1,1]. Following the GL and D3D rules,
+// both the most negative value and the one after it map to -1.
+func SnormToFloat(v int32, bits uint) float32 {
+	return float32(math.Max(float64(v)/float64(uint64(1)<<(bits-1)-1), -1))
+}
+
+// FloatToUnorm8 is FloatToUnorm for 8 bit integers.
+func FloatToUnorm8(v float32, mode RoundingMode) uint8 {
+	return uint8(FloatToUnorm(v, 8, mode))
+}
+
+// FloatToUnorm16 is FloatToUnorm for 16 bit integers.
+func FloatToUnorm16(v float32, mode RoundingMode) uint16 {
+	return uint16(FloatToUnorm(v, 16, mode))
+}
+
+// FloatToSnorm8 is FloatToSnorm for 8 bit integers.
+func FloatToSnorm8(v float32, mode RoundingMode) int8 {
+	return int8(FloatToSnorm(v, 8, mode))
+}
+
+// FloatToSnorm16 is FloatToSnorm for 16 bit integers.
+func FloatToSnorm16(v float32, mode RoundingMode) int16 {
+	return int16(FloatToSnorm(v, 16, mode))
+}
+
+// Unorm8ToFloat is UnormToFloat for 8 bit integers.
+func Unorm8ToFloat(v uint8) float32 {
+	return UnormToFloat(uint32(v), 8)
+}
+
+// Unorm16ToFloat is UnormToFloat for 16 bit integers.
+func Unorm16ToFloat(v uint16) float32 {
+	return UnormToFloat(uint32(v), 16)
+}
+
+// Snorm8ToFloat is SnormToFloat for 8 bit integers.
+func Snorm8ToFloat(v int8) float32 {
+	return SnormToFloat(int32(v), 8)
+}
+
+// Snorm16ToFloat is SnormToFloat for 16 bit integers.
+func Snorm16ToFloat(v int16) float32 {
+	return SnormToFloat(int32(v), 16)
+}
+
+// QuantizeUnorm8 converts every element of src with FloatToUnorm8, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted. To
+// convert a vector, simply slice it (e.g. QuantizeUnorm8(dst[:], color[:], mode)).
+func QuantizeUnorm8(dst []uint8, src []float32, mode RoundingMode) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = FloatToUnorm8(src[i], mode)
+	}
+}
+
+// QuantizeUnorm16 converts every element of src with FloatToUnorm16, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted.
+func QuantizeUnorm16(dst []uint16, src []float32, mode RoundingMode) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = FloatToUnorm16(src[i], mode)
+	}
+}
+
+// QuantizeSnorm8 converts every element of src with FloatToSnorm8, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted.
+func QuantizeSnorm8(dst []int8, src []float32, mode RoundingMode) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = FloatToSnorm8(src[i], mode)
+	}
+}
+
+// QuantizeSnorm16 converts every element of src with FloatToSnorm16, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted.
+func QuantizeSnorm16(dst []int16, src []float32, mode RoundingMode) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = FloatToSnorm16(src[i], mode)
+	}
+}
+
+// DequantizeUnorm8 converts every element of src with Unorm8ToFloat, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted.
+func DequantizeUnorm8(dst []float32, src []uint8) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = Unorm8ToFloat(src[i])
+	}
+}
+
+// DequantizeUnorm16 converts every element of src with Unorm16ToFloat, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted.
+func DequantizeUnorm16(dst []float32, src []uint16) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = Unorm16ToFloat(src[i])
+	}
+}
+
+// DequantizeSnorm8 converts every element of src with Snorm8ToFloat, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted.
+func DequantizeSnorm8(dst []float32, src []int8) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = Snorm8ToFloat(src[i])
+	}
+}
+
+// DequantizeSnorm16 converts every element of src with Snorm16ToFloat, storing
+// the results in dst. Only min(len(dst), len(src)) elements are converted.
+func DequantizeSnorm16(dst []float32, src []int16) {
+	for i := range dst[:intMin(len(dst), len(src))] {
+		dst[i] = Snorm16ToFloat(src[i])
+	}
+}
+
+// PackUnorm3x10_1x2 packs v into 32 bits as three 10 bit and one 2 bit
+// unsigned normalized integers, converted with FloatToUnorm, with x in the
+// lowest bits and w in the highest two. This is the GL_UNSIGNED_INT_2_10_10_10_REV
+// vertex format and the RGB10_A2 texture format.
+func PackUnorm3x10_1x2(v Vec4, mode RoundingMode) uint32 {
+	return FloatToUnorm(v[0], 10, mode) |
+		FloatToUnorm(v[1], 10, mode)<<10 |
+		FloatToUnorm(v[2], 10, mode)<<20 |
+		FloatToUnorm(v[3], 2, mode)<<30
+}
+
+// UnpackUnorm3x10_1x2 is the inverse of PackUnorm3x10_1x2.
+func UnpackUnorm3x10_1x2(packed uint32) Vec4 {
+	return Vec4{
+		UnormToFloat(packed&0x3ff, 10),
+		UnormToFloat(packed>>10&0x3ff, 10),
+		UnormToFloat(packed>>20&0x3ff, 10),
+		UnormToFloat(packed>>30, 2),
+	}
+}
+
+// PackSnorm3x10_1x2 packs v into 32 bits as three 10 bit and one 2 bit
+// signed normalized integers, converted with FloatToSnorm, with x in the
+// lowest bits and w in the highest two. This is the GL_INT_2_10_10_10_REV
+// vertex format, commonly used for normals and tangents with the sign of the
+// bitangent in w.
+func PackSnorm3x10_1x2(v Vec4, mode RoundingMode) uint32 {
+	return uint32(FloatToSnorm(v[0], 10, mode))&0x3ff |
+		uint32(FloatToSnorm(v[1], 10, mode))&0x3ff<<10 |
+		uint32(FloatToSnorm(v[2], 10, mode))&0x3ff<<20 |
+		uint32(FloatToSnorm(v[3], 2, mode))<<30
+}
+
+// UnpackSnorm3x10_1x2 is the inverse of PackSnorm3x10_1x2.
+func UnpackSnorm3x10_1x2(packed uint32) Vec4 {
+	// Shifting each field to the top and back sign extends it.
+	return Vec4{
+		SnormToFloat(int32(packed<<22)>>22, 10),
+		SnormToFloat(int32(packed<<12)>>22, 10),
+		SnormToFloat(int32(packed<<2)>>22, 10),
+		SnormToFloat(int32(packed)>>30, 2),
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestFloatToUnorm8Rounding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In                   float32
+		Even, AwayZero, Zero uint8
+	}{
+		{0, 0, 0, 0},
+		{1, 255, 255, 255},
+		{2, 255, 255, 255},
+		{-1, 0, 0, 0},
+		{0.5, 128, 128, 127},
+	}
+
+	for _, test := range tests {
+		if got := FloatToUnorm8(test.In, RoundNearestEven); got != test.Even {
+			t.Errorf("FloatToUnorm8(%v, RoundNearestEven) = %d, expected %d", test.In, got, test.Even)
+		}
+		if got := FloatToUnorm8(test.In, RoundHalfAwayFromZero); got != test.AwayZero {
+			t.Errorf("FloatToUnorm8(%v, RoundHalfAwayFromZero) = %d, expected %d", test.In, got, test.AwayZero)
+		}
+		if got := FloatToUnorm8(test.In, RoundTowardZero); got != test.Zero {
+			t.Errorf("FloatToUnorm8(%v, RoundTowardZero) = %d, expected %d", test.In, got, test.Zero)
+		}
+	}
+}
+
+func TestFloatToUnormTies(t *testing.T) {
+	t.Parallel()
+
+	// 0.5 is exactly between 0 and 1 for 1 bit, and between 1 and 2 for 2 bits.
+	if got := FloatToUnorm(0.5, 1, RoundNearestEven); got != 0 {
+		t.Errorf("FloatToUnorm(0.5, 1, RoundNearestEven) = %d, expected 0", got)
+	}
+	if got := FloatToUnorm(0.5, 1, RoundHalfAwayFromZero); got != 1 {
+		t.Errorf("FloatToUnorm(0.5, 1, RoundHalfAwayFromZero) = %d, expected 1", got)
+	}
+	if got := FloatToUnorm(0.5, 2, RoundNearestEven); got != 2 {
+		t.Errorf("FloatToUnorm(0.5, 2, RoundNearestEven) = %d, expected 2", got)
+	}
+	if got := FloatToUnorm(0.5, 2, RoundTowardZero); got != 1 {
+		t.Errorf("FloatToUnorm(0.5, 2, RoundTowardZero) = %d, expected 1", got)
+	}
+}
+
+func TestSnormConversions(t *testing.T) {
+	t.Parallel()
+
+	if got := FloatToSnorm8(-1, RoundNearestEven); got != -127 {
+		t.Errorf("FloatToSnorm8(-1) = %d, expected -127", got)
+	}
+	if got := FloatToSnorm16(1, RoundNearestEven); got != 32767 {
+		t.Errorf("FloatToSnorm16(1) = %d, expected 32767", got)
+	}
+	if got := FloatToSnorm(-0.5, 3, RoundNearestEven); got != -2 {
+		t.Errorf("FloatToSnorm(-0.5, 3) = %d, expected -2", got)
+	}
+	if got := FloatToSnorm(-0.5, 3, RoundHalfAwayFromZero); got != -2 {
+		t.Errorf("FloatToSnorm(-0.5, 3, RoundHalfAwayFromZero) = %d, expected -2", got)
+	}
+	if got := FloatToSnorm(-0.5, 2, RoundNearestEven); got != 0 {
+		t.Errorf("FloatToSnorm(-0.5, 2) = %d, expected 0", got)
+	}
+	if got := Snorm8ToFloat(-128); got != -1 {
+		t.Errorf("Snorm8ToFloat(-128) = %v, expected -1", got)
+	}
+	if got := Snorm16ToFloat(16384); !FloatEqualThreshold(got, 16384.0/32767, 1e-6) {
+		t.Errorf("Snorm16ToFloat(16384) = %v, expected %v", got, 16384.0/32767)
+	}
+}
+
+func TestQuantizeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	v := Vec4{-0.75, 0.1, 0.5, 1}
+	var packed [4]int16
+	QuantizeSnorm16(packed[:], v[:], RoundNearestEven)
+
+	var back Vec4
+	DequantizeSnorm16(back[:], packed[:])
+	if !back.ApproxEqualThreshold(v, 1e-4) {
+		t.Errorf("Snorm16 round trip of %v gave %v", v, back)
+	}
+
+	c := Vec3{0.2, 0.4, 0.6}
+	var bytes [3]uint8
+	QuantizeUnorm8(bytes[:], c[:], RoundNearestEven)
+	if bytes != [3]uint8{51, 102, 153} {
+		t.Errorf("QuantizeUnorm8(%v) = %v, expected [51 102 153]", c, bytes)
+	}
+}
+
+func TestPack3x10_1x2(t *testing.T) {
+	t.Parallel()
+
+	unorm := []struct {
+		V      Vec4
+		Packed uint32
+	}{
+		{Vec4{0, 0, 0, 0}, 0},
+		{Vec4{1, 1, 1, 1}, 0xffffffff},
+		{Vec4{1, 0, 0, 0}, 0x3ff},
+		{Vec4{0, 1, 0, 0}, 0x3ff << 10},
+		{Vec4{0, 0, 0.5, 1.0 / 3}, 512<<20 | 1<<30},
+		{Vec4{2, -1, 0, 0.9}, 0x3ff | 3<<30},
+	}
+	for _, c := range unorm {
+		if got := PackUnorm3x10_1x2(c.V, RoundNearestEven); got != c.Packed {
+			t.Errorf("PackUnorm3x10_1x2(%v) = %#08x, want %#08x", c.V, got, c.Packed)
+		}
+	}
+
+	snorm := []struct {
+		V      Vec4
+		Packed uint32
+	}{
+		{Vec4{0, 0, 0, 0}, 0},
+		{Vec4{1, 0, 0, 1}, 0x1ff | 1<<30},
+		{Vec4{-1, 0, 0, -1}, 0x201 | 3<<30},
+		{Vec4{0, 0, -1, 0}, 0x201 << 20},
+		{Vec4{0, 0.5, 0, 0}, 256 << 10},
+	}
+	for _, c := range snorm {
+		if got := PackSnorm3x10_1x2(c.V, RoundNearestEven); got != c.Packed {
+			t.Errorf("PackSnorm3x10_1x2(%v) = %#08x, want %#08x", c.V, got, c.Packed)
+		}
+	}
+
+	// Values on the grids round trip.
+	vs := []Vec4{{0.25, -0.75, 1, -1}, {-1, 1, 0, 1}, {0.5, 0, -0.5, 0}}
+	for _, v := range vs {
+		want := Vec4{
+			SnormToFloat(FloatToSnorm(v[0], 10, RoundNearestEven), 10),
+			SnormToFloat(FloatToSnorm(v[1], 10, RoundNearestEven), 10),
+			SnormToFloat(FloatToSnorm(v[2], 10, RoundNearestEven), 10),
+			v[3],
+		}
+		if got := UnpackSnorm3x10_1x2(PackSnorm3x10_1x2(v, RoundNearestEven)); got != want {
+			t.Errorf("UnpackSnorm3x10_1x2(PackSnorm3x10_1x2(%v)) = %v, want %v", v, got, want)
+		}
+
+		u := Vec4{Abs(v[0]), Abs(v[1]), Abs(v[2]), Abs(v[3])}
+		if got := UnpackUnorm3x10_1x2(PackUnorm3x10_1x2(u, RoundNearestEven)); !got.ApproxEqualThreshold(u, 1.0/2046) {
+			t.Errorf("UnpackUnorm3x10_1x2(PackUnorm3x10_1x2(%v)) = %v", u, got)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"sort"
+)
+
+// OBB is an oriented bounding box: the points Center + Axes*x for all x with
+// |x[i]| <= HalfExtents[i]. The columns of Axes are orthonormal and form a
+// rotation.
+type OBB struct {
+	Center      Vec3
+	Axes        Mat3
+	HalfExtents Vec3
+}
+
+// OBBFromPoints fits an OBB to the given points. Its axes are the principal
+// axes of the points (the eigenvectors of their covariance matrix), and its
+// extents are refit to the minimum and maximum projections of the points on
+// them, so the box contains all of the points. This is usually much tighter than
+// the AABB of points that don't happen to lie along the coordinate axes, but
+// isn't the minimum-volume box; see OBBFromPointsRefined. If no points are given,
+// the result is the zero OBB.
+func OBBFromPoints(points []Vec3) OBB {
+	if len(points) == 0 {
+		return OBB{}
+	}
+
+	n := float32(len(points))
+	var mean Vec3
+	for _, p := range points {
+		mean = mean.Add(p)
+	}
+	mean = mean.Mul(1 / n)
+
+	var cov Mat3
+	for _, p := range points {
+		d := p.Sub(mean)
+		cov = cov.Add(d.OuterProd3(d))
+	}
+
+	// The covariance is symmetric positive semi-definite, so its singular
+	// vectors are its eigenvectors.
+	axes, _, _ := cov.Mul(1 / n).SVD()
+	if axes.Det() < 0 {
+		axes.SetCol(2, axes.Col(2).Mul(-1))
+	}
+
+	return obbFit(points, axes)
+}
+
+// OBBFromPointsRefined is like OBBFromPoints, but additionally tries, for each of
+// the principal axes, keeping that axis and replacing the other two by the
+// sides of the minimum-area rectangle containing the points projected on the plane
+// perpendicular to it, found with rotating calipers around their convex hull. The
+// box with the smallest volume is returned. This costs a sort of the points per
+// axis, and fixes the loose fits of PCA on points that are unevenly distributed
+// over the shape, such as the vertices of a mesh with one finely tessellated side.
+func OBBFromPointsRefined(points []Vec3) OBB {
+	best := OBBFromPoints(points)
+	if len(points) < 3 {
+		return best
+	}
+
+	pca := best.Axes
+	proj := make([]Vec2, len(points))
+	for k := 0; k < 3; k++ {
+		u, v, w := pca.Col((k+1)%3), pca.Col((k+2)%3), pca.Col(k)
+		for i, p := range points {
+			proj[i] = Vec2{p.Dot(u), p.Dot(v)}
+		}
+
+		dir, ok := minAreaRectDir(convexHull2(proj))
+		if !ok {
+			continue
+		}
+
+		a := u.Mul(dir[0]).Add(v.Mul(dir[1]))
+		b := w.Cross(a)
+		var axes Mat3
+		axes.SetCol((k+1)%3, a)
+		axes.SetCol((k+2)%3, b)
+		axes.SetCol(k, w)
+
+		if box := obbFit(points, axes); box.Volume() < best.Volume() {
+			best = box
+		}
+	}
+
+	return best
+}
+
+// obbFit returns the smallest OBB with the given axes containing the points.
+func obbFit(points []Vec3, axes Mat3) OBB {
+	t := axes.Transpose()
+	lo := t.Mul3x1(points[0])
+	hi := lo
+	for _, p := range points[1:] {
+		q := t.Mul3x1(p)
+		for i := range q {
+			SetMin(&lo[i], &q[i])
+			SetMax(&hi[i], &q[i])
+		}
+	}
+
+	return OBB{
+		Center:      axes.Mul3x1(lo.Add(hi).Mul(0.5)),
+		Axes:        axes,
+		HalfExtents: hi.Sub(lo).Mul(0.5),
+	}
+}
+
+// Volume returns the volume of the box.
+func (b OBB) Volume() float32 {
+	return 8 * b.HalfExtents[0] * b.HalfExtents[1] * b.HalfExtents[2]
+}
+
+// ContainsPoint returns whether p lies inside of the box or on its boundary.
+func (b OBB) ContainsPoint(p Vec3) bool {
+	local := b.Axes.Transpose().Mul3x1(p.Sub(b.Center))
+	for i := range local {
+		if Abs(local[i]) > b.HalfExtents[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Corners returns the 8 corners of the box. Corner i is at the positive
+// extent along axis j if bit j of i is set, and at the negative one otherwise.
+func (b OBB) Corners() [8]Vec3 {
+	var corners [8]Vec3
+	for i := range corners {
+		c := b.Center
+		for j := 0; j < 3; j++ {
+			e := b.HalfExtents[j]
+			if i&(1<<uint(j)) == 0 {
+				e = -e
+			}
+			c = c.Add(b.Axes.Col(j).Mul(e))
+		}
+		corners[i] = c
+	}
+	return corners
+}
+
+// convexHull2 returns the convex hull of the points in counterclockwise order,
+// without collinear points (up to rounding), using Andrew's monotone chain. The points are
+// sorted in place.
+func convexHull2(points []Vec2) []Vec2 {
+	sort.Slice(points, func(i, j int) bool {
+		return points[i][0] < points[j][0] || points[i][0] == points[j][0] && points[i][1] < points[j][1]
+	})
+
+	hull := make([]Vec2, 0, 2*len(points))
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range points {
+			for len(hull) >= start+2 && hull[len(hull)-1].Sub(hull[len(hull)-2]).Cross(p.Sub(hull[len(hull)-2])) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		// The last point of each chain starts the other one.
+		hull = hull[:len(hull)-1]
+
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+
+	return hull
+}
+
+// minAreaRectDir returns the unit direction of a side of the minimum-area
+// rectangle containing the convex polygon hull, which is counterclockwise. One side of that rectangle lies on an edge of the
+// hull, so rotating calipers visit every edge while advancing the points
+// that support the other three sides. It fails for hulls of fewer than 3
+// points.
+func minAreaRectDir(hull []Vec2) (dir Vec2, ok bool) {
+	n := len(hull)
+	if n < 3 {
+		return Vec2{}, false
+	}
+
+	// Supporting points: furthest along the edge, furthest from it and
+	// furthest back along it.
+	right, top, left := 1, 1, 1
+	bestArea := InfPos
+	for i := 0; i < n; i++ {
+		e := hull[(i+1)%n].Sub(hull[i]).Normalize()
+		nrm := e.Perp()
+
+		at := func(j int) Vec2 { return hull[j%n] }
+		if right < i+1 {
+			right = i + 1
+		}
+		for k := 0; k < n && at(right+1).Dot(e) >= at(right).Dot(e); k++ {
+			right++
+		}
+		if top < right {
+			top = right
+		}
+		for k := 0; k < n && at(top+1).Dot(nrm) >= at(top).Dot(nrm); k++ {
+			top++
+		}
+		if left < top {
+			left = top
+		}
+		for k := 0; k < n && at(left+1).Dot(e) <= at(left).Dot(e); k++ {
+			left++
+		}
+
+		width := at(right).Dot(e) - at(left).Dot(e)
+		height := at(top).Dot(nrm) - hull[i].Dot(nrm)
+		if area := width * height; area < bestArea {
+			bestArea, dir = area, e
+		}
+	}
+
+	return dir, true
+}
@@ -0,0 +1,145 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// boxPoints returns a grid of n^3 points filling the box with the given
+// center, rotation and half extents, corners included.
+func boxPoints(n int, center Vec3, rot Mat3, half Vec3) []Vec3 {
+	var points []Vec3
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			for k := 0; k < n; k++ {
+				local := Vec3{float32(i), float32(j), float32(k)}.Mul(2 / float32(n-1)).Sub(Vec3{1, 1, 1})
+				points = append(points, center.Add(rot.Mul3x1(Vec3{local[0] * half[0], local[1] * half[1], local[2] * half[2]})))
+			}
+		}
+	}
+	return points
+}
+
+func containsAll(b OBB, points []Vec3) bool {
+	grown := b
+	grown.HalfExtents = b.HalfExtents.Add(Vec3{1e-4, 1e-4, 1e-4})
+	for _, p := range points {
+		if !grown.ContainsPoint(p) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestOBBFromPoints(t *testing.T) {
+	t.Parallel()
+
+	rot := QuatRotate(0.7, Vec3{1, 2, 3}.Normalize()).Mat4().Mat3()
+	center, half := Vec3{3, -1, 2}, Vec3{4, 2, 0.5}
+	points := boxPoints(10, center, rot, half)
+
+	b := OBBFromPoints(points)
+	if !containsAll(b, points) {
+		t.Errorf("OBBFromPoints doesn't contain all of the points")
+	}
+	if b.Axes.Det() < 0.999 {
+		t.Errorf("OBBFromPoints axes %v aren't a rotation", b.Axes)
+	}
+	if !b.Center.ApproxFuncEqual(center, func(a, b float32) bool { return Abs(a-b) < 1e-3 }) {
+		t.Errorf("OBBFromPoints center = %v, want %v", b.Center, center)
+	}
+	if !b.HalfExtents.ApproxFuncEqual(half, func(a, b float32) bool { return Abs(a-b) < 1e-3 }) {
+		t.Errorf("OBBFromPoints half extents = %v, want %v", b.HalfExtents, half)
+	}
+	if aabb := AABBFromPoints(points...); b.Volume() >= aabb.Max.Sub(aabb.Min).X()*aabb.Max.Sub(aabb.Min).Y()*aabb.Max.Sub(aabb.Min).Z() {
+		t.Errorf("OBBFromPoints volume %v isn't smaller than the AABB's", b.Volume())
+	}
+
+	if b := OBBFromPoints(nil); b != (OBB{}) {
+		t.Errorf("OBBFromPoints(nil) = %v, want the zero OBB", b)
+	}
+	if b := OBBFromPoints([]Vec3{{1, 2, 3}}); b.Center != (Vec3{1, 2, 3}) || b.HalfExtents != (Vec3{}) {
+		t.Errorf("OBBFromPoints of one point = %v", b)
+	}
+}
+
+func TestOBBFromPointsRefined(t *testing.T) {
+	t.Parallel()
+
+	// A flat box whose points crowd along one diagonal of its large face, which
+	// tilts the principal axes away from the sides.
+	r := rand.New(rand.NewSource(3))
+	rot := QuatRotate(-0.4, Vec3{0, 1, 1}.Normalize()).Mat4().Mat3()
+	center, half := Vec3{-2, 5, 1}, Vec3{3, 2, 0.25}
+	points := boxPoints(2, center, rot, half)
+	for i := 0; i < 500; i++ {
+		s, z := 2*r.Float32()-1, r.Float32()
+		for _, z := range []float32{z, -z} {
+			local := Vec3{half[0] * s, half[1] * s * 0.9, half[2] * z}
+			points = append(points, center.Add(rot.Mul3x1(local)))
+		}
+	}
+
+	pca := OBBFromPoints(points)
+	b := OBBFromPointsRefined(points)
+	if !containsAll(b, points) {
+		t.Errorf("OBBFromPointsRefined doesn't contain all of the points")
+	}
+	if b.Axes.Det() < 0.999 {
+		t.Errorf("OBBFromPointsRefined axes %v aren't a rotation", b.Axes)
+	}
+	want := (OBB{HalfExtents: half}).Volume()
+	if v := b.Volume(); Abs(v-want) > 1e-2*want {
+		t.Errorf("OBBFromPointsRefined volume = %v, want %v (PCA: %v)", v, want, pca.Volume())
+	}
+	if pca.Volume() < want*1.1 {
+		t.Errorf("PCA volume %v is already tight, the test doesn't exercise the refinement", pca.Volume())
+	}
+}
+
+func TestOBBCornersContains(t *testing.T) {
+	t.Parallel()
+
+	b := OBB{Vec3{1, 1, 1}, Rotate3DZ(0.5), Vec3{1, 2, 3}}
+	for i, c := range b.Corners() {
+		if !b.ContainsPoint(c.Add(b.Center.Sub(c).Mul(1e-3))) {
+			t.Errorf("Corner %d = %v isn't in the box", i, c)
+		}
+		if b.ContainsPoint(c.Add(c.Sub(b.Center).Mul(1e-3))) {
+			t.Errorf("Point just outside corner %d is in the box", i)
+		}
+	}
+	if v := b.Volume(); !FloatEqual(v, 48) {
+		t.Errorf("Volume = %v, want 48", v)
+	}
+}
+
+func TestMinAreaRectDir(t *testing.T) {
+	t.Parallel()
+
+	// A rectangle rotated by 0.3 rad, plus points inside of it.
+	e := Vec2{1, 0}.Rotated(0.3)
+	var points []Vec2
+	for _, c := range []Vec2{{0, 0}, {4, 0}, {4, 1}, {0, 1}, {1, 0.5}, {3, 0.2}} {
+		points = append(points, e.Mul(c[0]).Add(e.Perp().Mul(c[1])))
+	}
+
+	hull := convexHull2(points)
+	if len(hull) != 4 {
+		t.Fatalf("convexHull2 = %v, want the 4 corners", hull)
+	}
+	for i := range hull {
+		if hull[(i+1)%4].Sub(hull[i]).Cross(hull[(i+2)%4].Sub(hull[i])) <= 0 {
+			t.Errorf("convexHull2 = %v isn't counterclockwise", hull)
+		}
+	}
+
+	dir, ok := minAreaRectDir(hull)
+	if !ok || Abs(Abs(dir.Dot(e))-1) > 1e-5 && Abs(dir.Dot(e)) > 1e-5 {
+		t.Errorf("minAreaRectDir = %v, %v; want a direction along or across %v", dir, ok, e)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Capsule is the set of points within Radius of the segment from A to B.
+type Capsule struct {
+	A, B   Vec3
+	Radius float32
+}
+
+// The penetration queries below return the minimum translation vector of two
+// overlapping shapes: the shortest translation of the receiver that separates
+// it from the other shape, leaving them touching. Its direction is the contact
+// normal, pointing from the other shape towards the receiver, and its length
+// is the penetration depth. If the shapes don't overlap, or only touch, ok is
+// false and the vector is zero.
+
+// PenetrationSphere returns the minimum translation vector separating s from
+// the sphere other. If the centers coincide, s is pushed along +x.
+func (s Sphere) PenetrationSphere(other Sphere) (mtv Vec3, ok bool) {
+	return penetrationPoints(s.Center, other.Center, s.Radius+other.Radius, Vec3{1, 0, 0})
+}
+
+// PenetrationAABB returns the minimum translation vector separating s from
+// the box. If the center of s is inside the box, s is pushed out through the
+// nearest face.
+func (s Sphere) PenetrationAABB(box AABB) (mtv Vec3, ok bool) {
+	p := clampToAABB(s.Center, box)
+	if p != s.Center {
+		return penetrationPoints(s.Center, p, s.Radius, Vec3{})
+	}
+
+	axis, depth := 0, InfPos
+	var dir float32
+	for i := 0; i < 3; i++ {
+		if d := s.Center[i] - box.Min[i]; d < depth {
+			axis, depth, dir = i, d, -1
+		}
+		if d := box.Max[i] - s.Center[i]; d < depth {
+			axis, depth, dir = i, d, 1
+		}
+	}
+	mtv[axis] = dir * (depth + s.Radius)
+	return mtv, true
+}
+
+// PenetrationAABB returns the minimum translation vector separating box from
+// the box other, along the axis of least overlap.
+func (box AABB) PenetrationAABB(other AABB) (mtv Vec3, ok bool) {
+	axis, depth := -1, InfPos
+	var dir float32
+	for i := 0; i < 3; i++ {
+		lo, hi := box.Min[i], box.Max[i]
+		SetMax(&lo, &other.Min[i])
+		SetMin(&hi, &other.Max[i])
+		d := hi - lo
+		if d <= 0 {
+			return Vec3{}, false
+		}
+		if d < depth {
+			axis, depth, dir = i, d, 1
+			if box.Min[i]+box.Max[i] < other.Min[i]+other.Max[i] {
+				dir = -1
+			}
+		}
+	}
+	mtv[axis] = dir * depth
+	return mtv, true
+}
+
+// PenetrationCapsule returns the minimum translation vector separating c from
+// the capsule other. If their segments intersect, c is pushed perpendicular
+// to its own segment.
+func (c Capsule) PenetrationCapsule(other Capsule) (mtv Vec3, ok bool) {
+	p, q, _ := ClosestPointsSegmentSegment(c.A, c.B, other.A, other.B)
+
+	fallback := Vec3{1, 0, 0}
+	if axis := c.B.Sub(c.A); axis.LenSqr() > 0 {
+		fallback = anyPerpendicular(axis.Normalize())
+	}
+	return penetrationPoints(p, q, c.Radius+other.Radius, fallback)
+}
+
+// penetrationPoints returns the translation of p moving it to distance r from
+// q, if it is closer than that, along fallback when p and q coincide.
+func penetrationPoints(p, q Vec3, r float32, fallback Vec3) (Vec3, bool) {
+	d := p.Sub(q)
+	distSqr := d.LenSqr()
+	if distSqr >= r*r {
+		return Vec3{}, false
+	}
+	if distSqr == 0 {
+		return fallback.Mul(r), true
+	}
+	dist := d.Len()
+	return d.Mul((r - dist) / dist), true
+}
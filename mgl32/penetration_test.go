@@ -0,0 +1,119 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "testing"
+
+func TestSpherePenetrationSphere(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b Sphere
+		mtv  Vec3
+		ok   bool
+	}{
+		{Sphere{Vec3{1.5, 0, 0}, 1}, Sphere{Vec3{}, 1}, Vec3{0.5, 0, 0}, true},
+		{Sphere{Vec3{0, -1, 0}, 0.5}, Sphere{Vec3{0, 0, 0}, 1}, Vec3{0, -0.5, 0}, true},
+		{Sphere{Vec3{}, 1}, Sphere{Vec3{}, 2}, Vec3{3, 0, 0}, true},
+		{Sphere{Vec3{2, 0, 0}, 1}, Sphere{Vec3{}, 1}, Vec3{}, false},
+		{Sphere{Vec3{3, 3, 3}, 1}, Sphere{Vec3{}, 1}, Vec3{}, false},
+	}
+
+	for _, test := range tests {
+		mtv, ok := test.a.PenetrationSphere(test.b)
+		if ok != test.ok || !mtv.ApproxFuncEqual(test.mtv, absEqual(1e-6)) {
+			t.Errorf("%v.PenetrationSphere(%v) = %v, %v, want %v, %v", test.a, test.b, mtv, ok, test.mtv, test.ok)
+		}
+	}
+}
+
+func TestSpherePenetrationAABB(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+	tests := []struct {
+		s   Sphere
+		mtv Vec3
+		ok  bool
+	}{
+		{Sphere{Vec3{1.5, 0, 0}, 1}, Vec3{0.5, 0, 0}, true},
+		{Sphere{Vec3{0, 0, -1.25}, 0.5}, Vec3{0, 0, -0.25}, true},
+		{Sphere{Vec3{0.5, 0, 0}, 0.25}, Vec3{0.75, 0, 0}, true},
+		{Sphere{Vec3{0.2, -0.9, 0.1}, 0.5}, Vec3{0, -0.6, 0}, true},
+		{Sphere{Vec3{2, 2, 2}, 1}, Vec3{}, false},
+		{Sphere{Vec3{0, 3, 0}, 1}, Vec3{}, false},
+	}
+
+	for _, test := range tests {
+		mtv, ok := test.s.PenetrationAABB(box)
+		if ok != test.ok || !mtv.ApproxFuncEqual(test.mtv, absEqual(1e-6)) {
+			t.Errorf("%v.PenetrationAABB(%v) = %v, %v, want %v, %v", test.s, box, mtv, ok, test.mtv, test.ok)
+		}
+	}
+
+	// A corner overlap pushes along the diagonal.
+	s := Sphere{Vec3{1.5, 1.5, 1.5}, 1}
+	mtv, ok := s.PenetrationAABB(box)
+	moved := Sphere{s.Center.Add(mtv), s.Radius}
+	if !ok || !FloatEqualThreshold(moved.Center.Sub(Vec3{1, 1, 1}).Len(), 1, 1e-5) {
+		t.Errorf("%v.PenetrationAABB(%v) = %v, %v", s, box, mtv, ok)
+	}
+}
+
+func TestAABBPenetrationAABB(t *testing.T) {
+	t.Parallel()
+
+	b := AABB{Vec3{0, 0, 0}, Vec3{2, 2, 2}}
+	tests := []struct {
+		a   AABB
+		mtv Vec3
+		ok  bool
+	}{
+		{AABB{Vec3{1.5, 0, 0}, Vec3{3.5, 2, 2}}, Vec3{0.5, 0, 0}, true},
+		{AABB{Vec3{-1, 0.5, 0.5}, Vec3{1, 1.5, 1.5}}, Vec3{-1, 0, 0}, true},
+		{AABB{Vec3{0.5, 1.75, 0.5}, Vec3{1.5, 2.5, 1.5}}, Vec3{0, 0.25, 0}, true},
+		{AABB{Vec3{-0.5, -0.5, -0.1}, Vec3{1, 1, 0.1}}, Vec3{0, 0, -0.1}, true},
+		{AABB{Vec3{2, 0, 0}, Vec3{3, 2, 2}}, Vec3{}, false},
+		{AABB{Vec3{0, 0, 3}, Vec3{1, 1, 4}}, Vec3{}, false},
+	}
+
+	for _, test := range tests {
+		mtv, ok := test.a.PenetrationAABB(b)
+		if ok != test.ok || !mtv.ApproxFuncEqual(test.mtv, absEqual(1e-6)) {
+			t.Errorf("%v.PenetrationAABB(%v) = %v, %v, want %v, %v", test.a, b, mtv, ok, test.mtv, test.ok)
+		}
+	}
+}
+
+func TestCapsulePenetrationCapsule(t *testing.T) {
+	t.Parallel()
+
+	b := Capsule{Vec3{0, 0, -1}, Vec3{0, 0, 1}, 0.5}
+	tests := []struct {
+		a   Capsule
+		mtv Vec3
+		ok  bool
+	}{
+		{Capsule{Vec3{0.75, -1, 0}, Vec3{0.75, 1, 0}, 0.5}, Vec3{0.25, 0, 0}, true},
+		{Capsule{Vec3{0, 0, 1.5}, Vec3{0, 0, 3}, 0.25}, Vec3{0, 0, 0.25}, true},
+		{Capsule{Vec3{-0.5, 0, 0}, Vec3{-0.5, 0, 2}, 0.5}, Vec3{-0.5, 0, 0}, true},
+		{Capsule{Vec3{2, 0, 0}, Vec3{2, 1, 0}, 0.5}, Vec3{}, false},
+		{Capsule{Vec3{0, 0, 2}, Vec3{0, 0, 3}, 0.5}, Vec3{}, false},
+	}
+
+	for _, test := range tests {
+		mtv, ok := test.a.PenetrationCapsule(b)
+		if ok != test.ok || !mtv.ApproxFuncEqual(test.mtv, absEqual(1e-6)) {
+			t.Errorf("%v.PenetrationCapsule(%v) = %v, %v, want %v, %v", test.a, b, mtv, ok, test.mtv, test.ok)
+		}
+	}
+
+	// Crossing segments push perpendicular to the first capsule.
+	a := Capsule{Vec3{-1, 0, 0}, Vec3{1, 0, 0}, 0.5}
+	mtv, ok := a.PenetrationCapsule(b)
+	if !ok || !FloatEqualThreshold(mtv.Len(), 1, 1e-6) || !FloatEqualThreshold(mtv.Dot(Vec3{1, 0, 0}), 0, 1e-6) {
+		t.Errorf("%v.PenetrationCapsule(%v) = %v, %v", a, b, mtv, ok)
+	}
+}
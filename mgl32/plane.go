@@ -0,0 +1,68 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Planes are represented as a Vec4 (a,b,c,d) of the plane equation
+// ax + by + cz + d = 0, as in Line3.IntersectPlane, so that the dot product
+// of the plane with a point p.Vec4(1) is the signed distance of p to it,
+// scaled by the length of the normal (a,b,c).
+
+// PlaneFromPointNormal returns the plane through point perpendicular to
+// normal, which is normalized first.
+func PlaneFromPointNormal(point, normal Vec3) Vec4 {
+	n := normal.Normalize()
+	return n.Vec4(-n.Dot(point))
+}
+
+// PlaneFromPoints returns the plane through the points a, b and c, facing the
+// side from which they appear counterclockwise. If they are collinear, the
+// result is the zero plane.
+func PlaneFromPoints(a, b, c Vec3) Vec4 {
+	n := b.Sub(a).Cross(c.Sub(a))
+	if n.LenSqr() == 0 {
+		return Vec4{}
+	}
+	return PlaneFromPointNormal(a, n)
+}
+
+// NormalizePlane scales the plane p so that its normal is a unit vector,
+// which makes PlaneDistance return actual distances. A plane with a zero
+// normal is returned unchanged.
+func NormalizePlane(p Vec4) Vec4 {
+	l := p.Vec3().Len()
+	if l == 0 {
+		return p
+	}
+	return p.Mul(1 / l)
+}
+
+// PlaneDistance returns the signed distance of point to the normalized plane
+// p, positive on the side its normal points to.
+func PlaneDistance(p Vec4, point Vec3) float32 {
+	return p.Dot(point.Vec4(1))
+}
+
+// TransformPlane returns the plane p transformed by m: the plane containing
+// the points m maps the points of p to. Since planes transform as row
+// vectors, this multiplies p by the inverse transpose of m; to move many planes
+// by the same transform, compute it once and use TransformPlaneInv with
+// m.Inv(). The result is not normalized unless m is rigid, see
+// NormalizePlane. If m is singular, the result is the zero plane, whatever the
+// Singular policy.
+func (m Mat4) TransformPlane(p Vec4) Vec4 {
+	inv, err := m.TryInv()
+	if err != nil {
+		return Vec4{}
+	}
+	return inv.TransformPlaneInv(p)
+}
+
+// TransformPlaneInv returns the plane p transformed by the inverse of m,
+// m.Transpose().Mul4x1(p). For example, with m a projection times a view
+// matrix, it moves planes from clip space, such as the clip planes
+// x + w >= 0 (Vec4{1, 0, 0, 1}), to world space.
+func (m Mat4) TransformPlaneInv(p Vec4) Vec4 {
+	return m.Transpose().Mul4x1(p)
+}
@@ -0,0 +1,76 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPlaneFromPoints(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1}
+	p := PlaneFromPoints(a, b, c)
+	s := float32(1 / math.Sqrt(3))
+	if want := (Vec4{s, s, s, -s}); !p.ApproxEqual(want) {
+		t.Errorf("PlaneFromPoints(%v, %v, %v) = %v, want %v", a, b, c, p, want)
+	}
+	if got := PlaneDistance(p, Vec3{}); !FloatEqualThreshold(got, -s, 1e-6) {
+		t.Errorf("PlaneDistance(%v, origin) = %v, want %v", p, got, -s)
+	}
+	if got := PlaneFromPoints(a, b, b.Mul(2).Sub(a)); got != (Vec4{}) {
+		t.Errorf("PlaneFromPoints collinear = %v, want zero", got)
+	}
+	if got, want := PlaneFromPointNormal(Vec3{0, 3, 7}, Vec3{0, 2, 0}), (Vec4{0, 1, 0, -3}); !got.ApproxEqual(want) {
+		t.Errorf("PlaneFromPointNormal = %v, want %v", got, want)
+	}
+}
+
+func TestNormalizePlane(t *testing.T) {
+	t.Parallel()
+
+	p := Vec4{0, 3, 4, 10}
+	if got, want := NormalizePlane(p), (Vec4{0, 0.6, 0.8, 2}); !got.ApproxEqual(want) {
+		t.Errorf("NormalizePlane(%v) = %v, want %v", p, got, want)
+	}
+	if got := NormalizePlane(Vec4{0, 0, 0, 1}); got != (Vec4{0, 0, 0, 1}) {
+		t.Errorf("NormalizePlane of a degenerate plane = %v", got)
+	}
+}
+
+func TestTransformPlane(t *testing.T) {
+	t.Parallel()
+
+	p := PlaneFromPointNormal(Vec3{1, 2, 3}, Vec3{1, -1, 2})
+	points := []Vec3{{1, 2, 3}}
+	u, v := Vec3{1, 1, 0}, Vec3{-2, 0, 1}
+	points = append(points, points[0].Add(u), points[0].Add(v), points[0].Add(u.Mul(2)).Sub(v))
+
+	for _, m := range []Mat4{
+		Ident4(),
+		Translate3D(1, -2, 5),
+		HomogRotate3D(0.8, Vec3{1, 2, 3}.Normalize()).Mul4(Translate3D(0, 1, 0)),
+		Scale3D(2, 0.5, 3).Mul4(ShearX3D(0.3, -0.2)),
+	} {
+		q := m.TransformPlane(p)
+		for _, pt := range points {
+			if got := q.Dot(TransformCoordinate(pt, m).Vec4(1)); !absEqual(1e-5)(got, 0) {
+				t.Errorf("TransformPlane(%v) doesn't contain the transformed %v: %v", m, pt, got)
+			}
+		}
+		if got := m.Inv().TransformPlaneInv(p); !got.ApproxFuncEqual(q, absEqual(1e-5)) {
+			t.Errorf("Inv().TransformPlaneInv() = %v, want %v", got, q)
+		}
+	}
+
+	// The left clip plane of a projection, moved to eye space.
+	proj := Perspective(math.Pi/2, 1, 0.1, 100)
+	left := NormalizePlane(proj.TransformPlaneInv(Vec4{1, 0, 0, 1}))
+	s := float32(1 / math.Sqrt(2))
+	if want := (Vec4{s, 0, -s, 0}); !left.ApproxFuncEqual(want, absEqual(1e-6)) {
+		t.Errorf("left clip plane in eye space = %v, want %v", left, want)
+	}
+}
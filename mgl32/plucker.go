@@ -0,0 +1,93 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Line3 is a directed, infinite line in 3D space stored in Plücker
+// coordinates: D is the direction of the line and M its moment, M = P x D for
+// any point P on the line.
+//
+// Plücker coordinates make a number of tests exact up to the sign of a single
+// expression (see Line3.Side), which is what watertight ray/triangle
+// traversal is built on. The direction does not have to be normalized.
+type Line3 struct {
+	D, M Vec3
+}
+
+// Line3FromPoints returns the line going through p and then q.
+func Line3FromPoints(p, q Vec3) Line3 {
+	return Line3{q.Sub(p), p.Cross(q)}
+}
+
+// Line3FromPointDir returns the line going through p in direction dir.
+func Line3FromPointDir(p, dir Vec3) Line3 {
+	return Line3{dir, p.Cross(dir)}
+}
+
+// Side returns the permuted inner product of the two lines, l1.D.Dot(l2.M) +
+// l2.D.Dot(l1.M). Its sign tells which way l2 passes around l1, so two lines
+// passing l1 in opposite senses have opposite signs. It is zero exactly when
+// the lines are coplanar (they intersect or are parallel).
+func (l1 Line3) Side(l2 Line3) float32 {
+	return l1.D.Dot(l2.M) + l2.D.Dot(l1.M)
+}
+
+// Dist returns the shortest distance between the two lines.
+func (l1 Line3) Dist(l2 Line3) float32 {
+	cross := l1.D.Cross(l2.D)
+	if crossLen := cross.Len(); crossLen > Epsilon {
+		return Abs(l1.Side(l2)) / crossLen
+	}
+
+	// Parallel lines; measure from the point of l2 closest to the origin.
+	return l1.DistToPoint(l2.ClosestToOrigin())
+}
+
+// DistToPoint returns the distance between the line and the point p.
+func (l Line3) DistToPoint(p Vec3) float32 {
+	return p.Cross(l.D).Sub(l.M).Len() / l.D.Len()
+}
+
+// ClosestToOrigin returns the point on the line closest to the origin.
+func (l Line3) ClosestToOrigin() Vec3 {
+	return l.D.Cross(l.M).Mul(1 / l.D.LenSqr())
+}
+
+// IntersectPlane computes the point where the line crosses the plane given as
+// (a,b,c,d), where ax + by + cz + d = 0. If the line is parallel to the plane
+// ok is false.
+func (l Line3) IntersectPlane(plane Vec4) (p Vec3, ok bool) {
+	n := plane.Vec3()
+	nd := n.Dot(l.D)
+	if nd == 0 {
+		return Vec3{}, false
+	}
+
+	return n.Cross(l.M).Sub(l.D.Mul(plane[3])).Mul(1 / nd), true
+}
+
+// IntersectTriangle tests whether the line passes through the triangle
+// (a, b, c), returning the intersection point if it does.
+//
+// The test is done with the signs of the Side products of the line and the
+// three triangle edges, so a line passing through a shared edge of two
+// triangles is always reported as hitting at least one of them, regardless of
+// floating point error. Both triangle windings are accepted. Lines lying in
+// the plane of the triangle are reported as not intersecting.
+func (l Line3) IntersectTriangle(a, b, c Vec3) (p Vec3, ok bool) {
+	s1 := l.Side(Line3FromPoints(a, b))
+	s2 := l.Side(Line3FromPoints(b, c))
+	s3 := l.Side(Line3FromPoints(c, a))
+
+	if !(s1 >= 0 && s2 >= 0 && s3 >= 0) && !(s1 <= 0 && s2 <= 0 && s3 <= 0) {
+		return Vec3{}, false
+	}
+	sum := s1 + s2 + s3
+	if sum == 0 {
+		return Vec3{}, false
+	}
+
+	// The side products are proportional to the barycentric coordinates.
+	return c.Mul(s1 / sum).Add(a.Mul(s2 / sum)).Add(b.Mul(s3 / sum)), true
+}
@@ -0,0 +1,80 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestLine3Side(t *testing.T) {
+	t.Parallel()
+
+	x := Line3FromPoints(Vec3{0, 0, 0}, Vec3{1, 0, 0})
+
+	above := Line3FromPointDir(Vec3{0, 0, 1}, Vec3{0, 1, 0})
+	below := Line3FromPointDir(Vec3{0, 0, -1}, Vec3{0, 1, 0})
+	crossing := Line3FromPointDir(Vec3{5, -1, 0}, Vec3{0, 1, 0})
+	parallel := Line3FromPointDir(Vec3{0, 3, 0}, Vec3{-2, 0, 0})
+
+	if s1, s2 := x.Side(above), x.Side(below); s1*s2 >= 0 {
+		t.Errorf("Lines passing on opposite sides should have opposite signs, got %v and %v", s1, s2)
+	}
+	if s := x.Side(crossing); s != 0 {
+		t.Errorf("Intersecting lines should have a zero side product, got %v", s)
+	}
+	if s := x.Side(parallel); s != 0 {
+		t.Errorf("Parallel lines should have a zero side product, got %v", s)
+	}
+
+	if d := x.Dist(above); !FloatEqual(d, 1) {
+		t.Errorf("Distance between skew lines is %v, expected 1", d)
+	}
+	if d := x.Dist(parallel); !FloatEqual(d, 3) {
+		t.Errorf("Distance between parallel lines is %v, expected 3", d)
+	}
+}
+
+func TestLine3IntersectPlane(t *testing.T) {
+	t.Parallel()
+
+	l := Line3FromPoints(Vec3{1, 2, 3}, Vec3{2, 3, 5})
+	p, ok := l.IntersectPlane(Vec4{0, 0, 1, -7}) // z = 7
+	if !ok || !p.ApproxEqual(Vec3{3, 4, 7}) {
+		t.Errorf("IntersectPlane = %v, %v; expected [3 4 7], true", p, ok)
+	}
+
+	if _, ok := l.IntersectPlane(Vec4{1, -1, 0, 0}); ok {
+		t.Errorf("Line parallel to the plane should not intersect it")
+	}
+}
+
+func TestLine3IntersectTriangle(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := Vec3{0, 0, 0}, Vec3{4, 0, 0}, Vec3{0, 4, 0}
+
+	l := Line3FromPointDir(Vec3{1, 1, 5}, Vec3{0, 0, -1})
+	if p, ok := l.IntersectTriangle(a, b, c); !ok || !p.ApproxEqual(Vec3{1, 1, 0}) {
+		t.Errorf("IntersectTriangle = %v, %v; expected [1 1 0], true", p, ok)
+	}
+	// Other winding
+	if p, ok := l.IntersectTriangle(a, c, b); !ok || !p.ApproxEqual(Vec3{1, 1, 0}) {
+		t.Errorf("IntersectTriangle (reversed winding) = %v, %v; expected [1 1 0], true", p, ok)
+	}
+
+	miss := Line3FromPointDir(Vec3{3, 3, 5}, Vec3{0, 0, -1})
+	if _, ok := miss.IntersectTriangle(a, b, c); ok {
+		t.Errorf("Line outside of the triangle was reported as intersecting")
+	}
+
+	// A line through the shared edge of two triangles hits both.
+	edge := Line3FromPointDir(Vec3{2, 2, 5}, Vec3{0, 0, -1})
+	d := Vec3{4, 4, 0}
+	_, ok1 := edge.IntersectTriangle(b, c, a)
+	_, ok2 := edge.IntersectTriangle(b, d, c)
+	if !ok1 || !ok2 {
+		t.Errorf("Line through a shared edge should hit both triangles, got %v and %v", ok1, ok2)
+	}
+}
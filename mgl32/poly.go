@@ -0,0 +1,117 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Polynomial roots, for the analytic intersection routines. They work in
+// float64 regardless of the precision of the package, because the quartic
+// in particular loses too much precision in float32.
+
+// solveQuadratic returns the real roots of a*x^2 + b*x + c in increasing
+// order. If a is 0, the root of the linear equation is returned.
+func solveQuadratic(a, b, c float64) []float64 {
+	if a == 0 {
+		if b == 0 {
+			return nil
+		}
+		return []float64{-c / b}
+	}
+
+	disc := b*b - 4*a*c
+	if disc < 0 {
+		return nil
+	}
+	if disc == 0 {
+		return []float64{-b / (2 * a)}
+	}
+
+	// Avoid the cancellation of -b + sqrt(disc) when b is big.
+	q := -0.5 * (b + math.Copysign(math.Sqrt(disc), b))
+	x0, x1 := q/a, c/q
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	return []float64{x0, x1}
+}
+
+// cubicLargestRoot returns the largest real root of the monic cubic
+// x^3 + a*x^2 + b*x + c.
+func cubicLargestRoot(a, b, c float64) float64 {
+	q := (a*a - 3*b) / 9
+	r := (2*a*a*a - 9*a*b + 27*c) / 54
+
+	if r*r < q*q*q {
+		// Three real roots, -2*sqrt(q)*cos((theta+2*k*Pi)/3) - a/3. The
+		// largest is for k = 1, whose angle in [2Pi/3, Pi] has the most
+		// negative cosine.
+		theta := math.Acos(r / math.Sqrt(q*q*q))
+		return -2*math.Sqrt(q)*math.Cos((theta+2*math.Pi)/3) - a/3
+	}
+
+	u := -math.Copysign(math.Cbrt(math.Abs(r)+math.Sqrt(r*r-q*q*q)), r)
+	var v float64
+	if u != 0 {
+		v = q / u
+	}
+	return u + v - a/3
+}
+
+// solveQuartic returns the real roots of
+// c4*x^4 + c3*x^3 + c2*x^2 + c1*x + c0, with c4 != 0, in increasing order. It
+// uses Ferrari's method, and polishes the roots with Newton's method.
+func solveQuartic(c4, c3, c2, c1, c0 float64) []float64 {
+	a, b, c, d := c3/c4, c2/c4, c1/c4, c0/c4
+
+	// Depress the quartic with x = y - a/4 into y^4 + p*y^2 + q*y + r.
+	aa := a * a
+	p := b - 3*aa/8
+	q := c - a*b/2 + aa*a/8
+	r := d - a*c/4 + aa*b/16 - 3*aa*aa/256
+
+	var ys []float64
+	if math.Abs(q) < 1e-12 {
+		// Biquadratic: y^2 = z for the roots z of z^2 + p*z + r.
+		for _, z := range solveQuadratic(1, p, r) {
+			if z >= 0 {
+				s := math.Sqrt(z)
+				ys = append(ys, -s, s)
+			}
+		}
+	} else {
+		// With m a positive root of the resolvent cubic
+		// 8m^3 + 8p*m^2 + (2p^2 - 8r)*m - q^2, the quartic factors into
+		// two quadratics.
+		m := cubicLargestRoot(p, p*p/4-r, -q*q/8)
+		if m <= 0 {
+			return nil
+		}
+		s := math.Sqrt(2 * m)
+		ys = append(ys, solveQuadratic(1, s, p/2+m-q/(2*s))...)
+		ys = append(ys, solveQuadratic(1, -s, p/2+m+q/(2*s))...)
+	}
+
+	roots := make([]float64, 0, len(ys))
+	for _, y := range ys {
+		x := y - a/4
+		for i := 0; i < 2; i++ {
+			f := (((x+a)*x+b)*x+c)*x + d
+			df := ((4*x+3*a)*x+2*b)*x + c
+			if df == 0 {
+				break
+			}
+			x -= f / df
+		}
+		roots = append(roots, x)
+	}
+	for i := 1; i < len(roots); i++ {
+		for j := i; j > 0 && roots[j] < roots[j-1]; j-- {
+			roots[j], roots[j-1] = roots[j-1], roots[j]
+		}
+	}
+	return roots
+}
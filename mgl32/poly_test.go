@@ -0,0 +1,62 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolveQuartic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Coeffs [5]float64
+		Roots  []float64
+	}{
+		// (x-1)(x-2)(x-3)(x-4)
+		{[5]float64{1, -10, 35, -50, 24}, []float64{1, 2, 3, 4}},
+		// 2(x^2-1)(x^2-4), biquadratic
+		{[5]float64{2, 0, -10, 0, 8}, []float64{-2, -1, 1, 2}},
+		// (x+0.5)(x-3)(x^2+1)
+		{[5]float64{1, -2.5, -0.5, -2.5, -1.5}, []float64{-0.5, 3}},
+		// Roots in pairs of nearly opposite values, for which the resolvent
+		// cubic has three real roots.
+		{
+			[5]float64{1, -0.007080580732236275, -11.423998056605136, 0.040444369683402925, 32.59576217871224},
+			[]float64{-2.4249073689642677, -2.3509417179524474, 2.3544821640377385, 2.428447503611213},
+		},
+		// x^4 + 1 has no real roots
+		{[5]float64{1, 0, 0, 0, 1}, nil},
+	}
+
+	for _, c := range tests {
+		roots := solveQuartic(c.Coeffs[0], c.Coeffs[1], c.Coeffs[2], c.Coeffs[3], c.Coeffs[4])
+		if len(roots) != len(c.Roots) {
+			t.Errorf("solveQuartic%v = %v, expected %v", c.Coeffs, roots, c.Roots)
+			continue
+		}
+		for i := range roots {
+			if math.Abs(roots[i]-c.Roots[i]) > 1e-9 {
+				t.Errorf("solveQuartic%v = %v, expected %v", c.Coeffs, roots, c.Roots)
+				break
+			}
+		}
+	}
+}
+
+func TestSolveQuadratic(t *testing.T) {
+	t.Parallel()
+
+	if roots := solveQuadratic(1, -1e8, 1); len(roots) != 2 || math.Abs(roots[0]-1e-8) > 1e-20 || math.Abs(roots[1]-1e8) > 1e-6 {
+		t.Errorf("solveQuadratic lost precision: %v", roots)
+	}
+	if roots := solveQuadratic(0, 2, -4); len(roots) != 1 || roots[0] != 2 {
+		t.Errorf("solveQuadratic of a linear equation = %v, expected [2]", roots)
+	}
+	if roots := solveQuadratic(1, 0, 1); len(roots) != 0 {
+		t.Errorf("solveQuadratic(1, 0, 1) = %v, expected no roots", roots)
+	}
+}
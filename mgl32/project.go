@@ -30,6 +30,40 @@ func Perspective(fovy, aspect, near, far float32) Mat4 {
 	return Mat4{float32(f / aspect), 0, 0, 0, 0, float32(f), 0, 0, 0, 0, float32((near + far) / nmf), -1, 0, 0, float32((2. * far * near) / nmf), 0}
 }
 
+// PerspectiveInfinite generates a perspective matrix like Perspective, with
+// the far plane at infinity: points at any distance beyond near are in the
+// clip volume, with NDC depth approaching 1 at infinity. This removes far
+// plane clipping, for instance of shadow volumes or skies.
+func PerspectiveInfinite(fovy, aspect, near float32) Mat4 {
+	f := float32(1. / math.Tan(float64(fovy)/2.0))
+
+	return Mat4{f / aspect, 0, 0, 0, 0, f, 0, 0, 0, 0, -1, -1, 0, 0, -2 * near, 0}
+}
+
+// PerspectiveReversedZ generates a perspective matrix like Perspective, with
+// the depth reversed: the near plane maps to an NDC depth of 1 and the far
+// plane to -1, so the depth test must be inverted (GL_GREATER) and the depth
+// buffer cleared to 0. Reversed depth only improves precision with a [0, 1]
+// depth range and a floating point depth buffer, where it spreads the
+// precision of the floats evenly over distance; use the methods of
+// ClipConventions with ZeroToOneDepth, or glClipControl, for that.
+func PerspectiveReversedZ(fovy, aspect, near, far float32) Mat4 {
+	m := Perspective(fovy, aspect, near, far)
+	m[10], m[14] = -m[10], -m[14]
+	return m
+}
+
+// PerspectiveReversedZInfinite generates a perspective matrix with reversed
+// depth, as with PerspectiveReversedZ, and the far plane at infinity, as with
+// PerspectiveInfinite. Depth approaches -1, or 0 when it ranges over [0, 1],
+// at infinity. This is the usual projection of renderers using reversed
+// depth.
+func PerspectiveReversedZInfinite(fovy, aspect, near float32) Mat4 {
+	m := PerspectiveInfinite(fovy, aspect, near)
+	m[10], m[14] = -m[10], -m[14]
+	return m
+}
+
 // Frustum generates a Frustum Matrix.
 func Frustum(left, right, bottom, top, near, far float32) Mat4 {
 	rml, tmb, fmn := (right - left), (top - bottom), (far - near)
@@ -38,6 +72,61 @@ func Frustum(left, right, bottom, top, near, far float32) Mat4 {
 	return Mat4{float32((2. * near) / rml), 0, 0, 0, 0, float32((2. * near) / tmb), 0, 0, float32(A), float32(B), float32(C), -1, 0, 0, float32(D), 0}
 }
 
+// PerspectiveFromIntrinsics generates a perspective matrix matching a pinhole
+// camera calibrated with OpenCV, given the focal lengths fx, fy and principal
+// point cx, cy of its camera matrix, in pixels, and the size of its images.
+// Following OpenCV, pixel centers are at integer coordinates, so the image
+// spans [-0.5, width-0.5] horizontally, and y grows downwards.
+//
+// The result expects eye coordinates in the OpenGL convention (y up, looking
+// down -z), while OpenCV's camera looks down +z with y down; see
+// ViewFromExtrinsics to build the matching view matrix.
+func PerspectiveFromIntrinsics(fx, fy, cx, cy float32, width, height int, near, far float32) Mat4 {
+	left := -(cx + 0.5) * near / fx
+	right := (float32(width) - 0.5 - cx) * near / fx
+	top := (cy + 0.5) * near / fy
+	bottom := -(float32(height) - 0.5 - cy) * near / fy
+
+	return Frustum(left, right, bottom, top, near, far)
+}
+
+// ViewFromExtrinsics converts the extrinsics of an OpenCV camera, a rotation
+// and translation taking world coordinates to OpenCV camera coordinates
+// (x right, y down, z forward), to a view matrix producing OpenGL eye
+// coordinates (x right, y up, z backward), for use with
+// PerspectiveFromIntrinsics.
+func ViewFromExtrinsics(rotation Mat3, translation Vec3) Mat4 {
+	return Diag4(Vec4{1, -1, -1, 1}).Mul4(Mat4FromBlocks(rotation, translation))
+}
+
+// FocalLengthToFOV returns the field of view, in radians, covered by a lens of
+// the given focal length in front of a film (or sensor) of the given size, in
+// the same units. Use the film height to get the fovy for Perspective.
+func FocalLengthToFOV(focalLength, filmSize float32) float32 {
+	return 2 * float32(math.Atan(float64(filmSize/(2*focalLength))))
+}
+
+// PerspectiveFilmback generates a perspective matrix for a physical camera,
+// described like in DCC applications: the size of its film back (sensor),
+// the focal length of its lens, in the same units (usually millimeters), and
+// its lens shift. The shift moves the lens parallel to the film to offset the
+// image without tilting the camera, e.g. to keep verticals straight in
+// architectural renders; it is expressed as a fraction of the film width and
+// height respectively, so a shiftY of 0.5 moves the image up by half its
+// height.
+//
+// The viewport should have the aspect ratio of the film back, or the image
+// will be stretched.
+func PerspectiveFilmback(filmWidth, filmHeight, focalLength, shiftX, shiftY, near, far float32) Mat4 {
+	sx, sy := near/focalLength*filmWidth, near/focalLength*filmHeight
+	left := (shiftX - 0.5) * sx
+	right := (shiftX + 0.5) * sx
+	bottom := (shiftY - 0.5) * sy
+	top := (shiftY + 0.5) * sy
+
+	return Frustum(left, right, bottom, top, near, far)
+}
+
 // LookAt generates a transform matrix from world space to the given eye space.
 func LookAt(eyeX, eyeY, eyeZ, centerX, centerY, centerZ, upX, upY, upZ float32) Mat4 {
 	return LookAtV(Vec3{eyeX, eyeY, eyeZ}, Vec3{centerX, centerY, centerZ}, Vec3{upX, upY, upZ})
@@ -86,9 +175,8 @@ func Project(obj Vec3, modelview, projection Mat4, initialX, initialY, width, he
 // rather than the exact values given by Projectf. (It's still unlikely to be
 // perfect due to precision errors, but it will be closer)
 func UnProject(win Vec3, modelview, projection Mat4, initialX, initialY, width, height int) (obj Vec3, err error) {
-	inv := projection.Mul4(modelview).Inv()
-	var blank Mat4
-	if inv == blank {
+	inv, err := projection.Mul4(modelview).TryInv()
+	if err != nil {
 		return Vec3{}, errors.New("Could not find matrix inverse (projection times modelview is probably non-singular)")
 	}
 
@@ -9,7 +9,7 @@ import (
 )
 
 // RotationOrder is the order in which rotations will be transformed for the
-// purposes of AnglesToQuat.
+// purposes of AnglesToQuat and ToEuler.
 type RotationOrder int
 
 // The RotationOrder constants represent a series of rotations along the given
@@ -77,6 +77,31 @@ func (q Quat) Z() float32 {
 	return q.V[2]
 }
 
+// QuatFromXYZW creates a quaternion from its components given in x, y, z, w
+// order, the scalar part last. This is the order used by glTF, ROS, Eigen's
+// coefficient storage and most physics engines.
+func QuatFromXYZW(x, y, z, w float32) Quat {
+	return Quat{w, Vec3{x, y, z}}
+}
+
+// QuatFromWXYZ creates a quaternion from its components given in w, x, y, z
+// order, the scalar part first.
+func QuatFromWXYZ(w, x, y, z float32) Quat {
+	return Quat{w, Vec3{x, y, z}}
+}
+
+// XYZW returns the components of the quaternion with the scalar part last,
+// e.g. for a glTF rotation or a ROS geometry_msgs/Quaternion.
+func (q Quat) XYZW() [4]float32 {
+	return [4]float32{q.V[0], q.V[1], q.V[2], q.W}
+}
+
+// WXYZ returns the components of the quaternion with the scalar part first,
+// which matches the order of the fields of Quat.
+func (q Quat) WXYZ() [4]float32 {
+	return [4]float32{q.W, q.V[0], q.V[1], q.V[2]}
+}
+
 // Add adds two quaternions. It's no more complicated than
 // adding their W and V components.
 func (q1 Quat) Add(q2 Quat) Quat {
@@ -120,7 +145,8 @@ func (q1 Quat) Norm() float32 {
 
 // Normalize the quaternion, returning its versor (unit quaternion).
 //
-// This is the same as normalizing it as a Vec4.
+// This is the same as normalizing it as a Vec4, except that the zero
+// quaternion normalizes to the identity unless the Singular policy says otherwise.
 func (q1 Quat) Normalize() Quat {
 	length := q1.Len()
 
@@ -128,6 +154,7 @@ func (q1 Quat) Normalize() Quat {
 		return q1
 	}
 	if length == 0 {
+		singular("Quat.Normalize", q1)
 		return QuatIdent()
 	}
 	if length == InfPos {
@@ -143,8 +170,67 @@ func (q1 Quat) Normalize() Quat {
 // This method computes the square norm by directly adding the sum
 // of the squares of all terms instead of actually squaring q1.Len(),
 // both for performance and precision.
+//
+// The zero quaternion has no inverse; this function returns infinite (or NaN)
+// components for it unless the Singular policy says otherwise.
 func (q1 Quat) Inverse() Quat {
-	return q1.Conjugate().Scale(1 / q1.Dot(q1))
+	dot := q1.Dot(q1)
+	if dot == 0 {
+		singular("Quat.Inverse", q1)
+	}
+	return q1.Conjugate().Scale(1 / dot)
+}
+
+// TryNormalize is like Normalize, but returns ErrZeroLength for the zero
+// quaternion regardless of the Singular policy.
+func (q1 Quat) TryNormalize() (Quat, error) {
+	if q1.Len() == 0 {
+		return Quat{}, ErrZeroLength
+	}
+	return q1.Normalize(), nil
+}
+
+// TryInverse is like Inverse, but returns ErrZeroLength for the zero
+// quaternion regardless of the Singular policy.
+func (q1 Quat) TryInverse() (Quat, error) {
+	if q1.Dot(q1) == 0 {
+		return Quat{}, ErrZeroLength
+	}
+	return q1.Inverse(), nil
+}
+
+// Log returns the logarithm of the rotation q1, which is normalized first. The
+// logarithm of a unit quaternion is the pure quaternion {0, v}, where v is the
+// axis of rotation times half the angle; Log returns v. QuatExp is its
+// inverse, and Log is what turns rotations into vectors that can be averaged,
+// interpolated and integrated linearly. Like the angle, v is longer than Pi/2
+// if q1.W is negative, the long way around to the orientation.
+func (q1 Quat) Log() Vec3 {
+	s := q1.V.Len()
+	if s == 0 {
+		return Vec3{}
+	}
+	return q1.V.Mul(float32(math.Atan2(float64(s), float64(q1.W))) / s)
+}
+
+// QuatExp returns the exponential of the pure quaternion {0, v}, the unit
+// quaternion rotating by 2|v| radians about v. It is the inverse of Log, and
+// stays exact as v goes to 0, where it is the identity.
+func QuatExp(v Vec3) Quat {
+	theta := v.Len()
+	if theta == 0 {
+		return QuatIdent()
+	}
+	sin, cos := math.Sincos(float64(theta))
+	return Quat{float32(cos), v.Mul(float32(sin) / theta)}
+}
+
+// Pow returns q1 to the power t, exp(t log(q1)): the rotation about the same
+// axis as q1 by t times its angle, with q1 normalized first. q1.Pow(t) is
+// QuatSlerp(QuatIdent(), q1, t), and q1.Pow(0.5) the rotation halfway to q1,
+// if q1.W isn't negative; otherwise it is a power of the long way around.
+func (q1 Quat) Pow(t float32) Quat {
+	return QuatExp(q1.Log().Mul(t))
 }
 
 // Rotate a vector by the rotation this quaternion represents.
@@ -259,6 +345,54 @@ func QuatNlerp(q1, q2 Quat, amount float32) Quat {
 	return QuatLerp(q1, q2, amount).Normalize()
 }
 
+// QuatSlerpShort is QuatSlerp, spelled out for code that also uses
+// QuatSlerpLong: it turns the short way from q1 to q2, by at most 180 degrees.
+func QuatSlerpShort(q1, q2 Quat, amount float32) Quat {
+	return QuatSlerp(q1, q2, amount)
+}
+
+// QuatSlerpLong is like QuatSlerp, but turns the long way from q1 to q2, by at
+// least 180 degrees, for instance for an object that must spin all the way
+// around rather than swing back. If q1 and q2 are the same orientation, it
+// turns a full circle around an arbitrary axis.
+func QuatSlerpLong(q1, q2 Quat, amount float32) Quat {
+	q1, q2 = q1.Normalize(), q2.Normalize()
+	if q1.Dot(q2) > 0 {
+		q2 = q2.Scale(-1)
+	}
+
+	dot := Clamp(q1.Dot(q2), -1, 1)
+	rel := q2.Sub(q1.Scale(dot))
+	if rel.Len() < 1e-6 {
+		// Any quaternion orthogonal to q1 leads around the circle.
+		rel = Quat{-q1.V[0], Vec3{q1.W, -q1.V[2], q1.V[1]}}
+	}
+	rel = rel.Normalize()
+
+	theta := math.Acos(float64(dot)) * float64(amount)
+	s, c := math.Sincos(theta)
+	return q1.Scale(float32(c)).Add(rel.Scale(float32(s)))
+}
+
+// QuatNlerpFast is QuatNlerp along the shortest path, with amount corrected by
+// a cubic so that the result keeps close to the constant velocity of QuatSlerp
+// without its trigonometry (Kapoulkine's approximation). For any pair of
+// rotations, the result is within 0.0008 radians (0.05 degrees) of rotation of
+// QuatSlerp, where QuatNlerp drifts by up to 0.15 radians.
+func QuatNlerpFast(q1, q2 Quat, amount float32) Quat {
+	q1, q2 = q1.Normalize(), q2.Normalize()
+	d := q1.Dot(q2)
+	if d < 0 {
+		q2, d = q2.Scale(-1), -d
+	}
+
+	a := 1.0904 + d*(-3.2452+d*(3.55645-d*1.43519))
+	b := 0.848013 + d*(-1.06021+d*0.215638)
+	k := a*(amount-0.5)*(amount-0.5) + b
+	t := amount + amount*(amount-0.5)*(amount-1)*k
+	return QuatNlerp(q1, q2, t)
+}
+
 // AnglesToQuat performs a rotation in the specified order. If the order is not
 // a valid RotationOrder, this function will panic
 //
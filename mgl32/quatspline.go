@@ -0,0 +1,158 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// These are the quaternion analogues of the Bezier functions of shapes.go,
+// plus Catmull-Rom style splines through rotation keys. Rotations are
+// interpolated on the unit sphere of quaternions rather than component-wise,
+// so the results are always rotations and turn at the rates the control points
+// describe. All control points are normalized first.
+
+// CubicBezierCurveQuat returns the rotation at t along the spherical cubic
+// Bezier curve with the given control rotations, evaluated with De Casteljau's
+// algorithm using QuatSlerp (Shoemake). Like CubicBezierCurve3D, the curve
+// starts at cPoint1 for t = 0 and ends at cPoint4 for t = 1.
+func CubicBezierCurveQuat(t float32, cPoint1, cPoint2, cPoint3, cPoint4 Quat) Quat {
+	return bezierQuat(t, []Quat{cPoint1, cPoint2, cPoint3, cPoint4})
+}
+
+// BezierCurveQuat returns the rotation at t along the spherical Bezier curve with
+// the given control rotations, evaluated with De Casteljau's algorithm using
+// QuatSlerp.
+//
+// t must be in the range 0.0 and 1.0 or this function will panic, as in BezierCurve3D.
+func BezierCurveQuat(t float32, cPoints []Quat) Quat {
+	if t < 0.0 || t > 1.0 {
+		panic("Input to bezier has t not in range [0,1]. If you think this is a precision error, use mathgl.Clamp[f|d] before calling this function")
+	}
+
+	return bezierQuat(t, append([]Quat(nil), cPoints...))
+}
+
+// bezierQuat evaluates the curve in place in cPoints.
+func bezierQuat(t float32, cPoints []Quat) Quat {
+	for n := len(cPoints) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			cPoints[i] = QuatSlerp(cPoints[i], cPoints[i+1], t)
+		}
+	}
+	return cPoints[0].Normalize()
+}
+
+// MakeBezierCurveQuat samples the spherical Bezier curve with control rotations
+// cPoints at numPoints evenly spaced values of t, like MakeBezierCurve3D.
+func MakeBezierCurveQuat(numPoints int, cPoints []Quat) (line []Quat) {
+	line = make([]Quat, numPoints)
+	if numPoints == 0 {
+		return
+	} else if numPoints == 1 {
+		line[0] = cPoints[0].Normalize()
+		return
+	}
+
+	line[0] = cPoints[0].Normalize()
+	for i := 1; i < numPoints-1; i++ {
+		line[i] = BezierCurveQuat(Clamp(float32(i)/float32(numPoints-1), 0.0, 1.0), cPoints)
+	}
+	line[numPoints-1] = cPoints[len(cPoints)-1].Normalize()
+
+	return
+}
+
+// BezierSplineInterpolateQuat does interpolation over a spline of several
+// spherical Bezier curves, like BezierSplineInterpolate3D.
+//
+// If t is out of the range of all given curves, this function will panic
+func BezierSplineInterpolateQuat(t float32, ranges [][2]float32, cPoints [][]Quat) Quat {
+	if len(ranges) != len(cPoints) {
+		panic("Each bezier curve needs a range")
+	}
+
+	for i, curveRange := range ranges {
+		if t >= curveRange[0] && t <= curveRange[1] {
+			return BezierCurveQuat((t-curveRange[0])/(curveRange[1]-curveRange[0]), cPoints[i])
+		}
+	}
+
+	panic("t is out of the range of all bezier curves in this spline")
+}
+
+// CatmullRomQuat returns the rotation at t in [0, 1] between the keys q1 and q2
+// of a smooth spline through the keys q0, q1, q2 and q3. It uses Shoemake's
+// squad with the intermediate control rotations computed from the neighboring
+// keys, which makes the angular velocity continuous across keys, as the
+// tangents of a Catmull-Rom spline make the velocity of a position spline.
+//
+// Consecutive keys describing the same orientation with opposite signs are
+// handled; the spline always turns the short way between them.
+func CatmullRomQuat(t float32, q0, q1, q2, q3 Quat) Quat {
+	q1 = q1.Normalize()
+	q0, q2 = sameHemisphere(q1, q0.Normalize()), sameHemisphere(q1, q2.Normalize())
+	q3 = sameHemisphere(q2, q3.Normalize())
+
+	return QuatSquad(q1, QuatSquadControl(q0, q1, q2), QuatSquadControl(q1, q2, q3), q2, t)
+}
+
+// QuatSquad is Shoemake's spherical quadrangle interpolation, the rotation at
+// t in [0, 1] between q1 and q2 along a smooth curve shaped by the control
+// rotations a and b, which is to QuatSlerp what a cubic Bezier curve is to
+// linear interpolation:
+//
+//	squad(q1, a, b, q2, t) = slerp(slerp(q1, q2, t), slerp(a, b, t), 2t(1-t))
+//
+// Chaining squads through keyframes with the controls from QuatSquadControl
+// gives a spline whose angular velocity is continuous across the keys, which
+// is what CatmullRomQuat and CatmullRomSplineQuat do.
+func QuatSquad(q1, a, b, q2 Quat, t float32) Quat {
+	return QuatSlerp(QuatSlerp(q1, q2, t), QuatSlerp(a, b, t), 2*t*(1-t))
+}
+
+// QuatSquadControl returns the control rotation of QuatSquad at the key q
+// between its neighbouring keys prev and next:
+//
+//	q exp(-(log(q^-1 next) + log(q^-1 prev)) / 4)
+//
+// The keys should be in the same hemisphere, with their signs chosen so that
+// the dot product of neighbours is positive.
+func QuatSquadControl(prev, q, next Quat) Quat {
+	inv := q.Conjugate()
+	sum := inv.Mul(next).Log().Add(inv.Mul(prev).Log())
+	return q.Mul(QuatExp(sum.Mul(-0.25))).Normalize()
+}
+
+// CatmullRomSplineQuat returns the rotation at t along the spline of
+// CatmullRomQuat through all of the keys, where the key i is reached at t = i.
+// The first and last keys are repeated to define the ends of the spline, and
+// t is clamped to [0, len(keys)-1]. This panics if there are no keys.
+func CatmullRomSplineQuat(t float32, keys []Quat) Quat {
+	if len(keys) == 0 {
+		panic("CatmullRomSplineQuat needs at least one key")
+	}
+
+	last := len(keys) - 1
+	t = Clamp(t, 0, float32(last))
+	i := int(t)
+	if i == last {
+		return keys[last].Normalize()
+	}
+
+	key := func(j int) Quat {
+		if j < 0 {
+			j = 0
+		} else if j > last {
+			j = last
+		}
+		return keys[j]
+	}
+	return CatmullRomQuat(t-float32(i), key(i-1), keys[i], keys[i+1], key(i+2))
+}
+
+// sameHemisphere returns whichever of q and -q is closer to ref.
+func sameHemisphere(ref, q Quat) Quat {
+	if ref.Dot(q) < 0 {
+		return q.Scale(-1)
+	}
+	return q
+}
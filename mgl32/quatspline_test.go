@@ -0,0 +1,125 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBezierCurveQuat(t *testing.T) {
+	t.Parallel()
+
+	// Along a single axis, the curve is the Bezier curve of the angles.
+	axis := Vec3{1, -2, 0.5}.Normalize()
+	angles := [4]float32{0.1, 1.2, -0.3, 0.8}
+	var cPoints [4]Quat
+	for i, a := range angles {
+		cPoints[i] = QuatRotate(a, axis)
+	}
+
+	for _, tt := range []float32{0, 0.2, 0.5, 0.7, 1} {
+		angle := CubicBezierCurve2D(tt, Vec2{angles[0]}, Vec2{angles[1]}, Vec2{angles[2]}, Vec2{angles[3]})[0]
+		want := QuatRotate(angle, axis)
+		if q := CubicBezierCurveQuat(tt, cPoints[0], cPoints[1], cPoints[2], cPoints[3]); !q.OrientationEqualThreshold(want, 1e-5) {
+			t.Errorf("CubicBezierCurveQuat(%v) = %v, want %v", tt, q, want)
+		}
+		if q := BezierCurveQuat(tt, cPoints[:]); !q.OrientationEqualThreshold(want, 1e-5) {
+			t.Errorf("BezierCurveQuat(%v) = %v, want %v", tt, q, want)
+		}
+	}
+
+	line := MakeBezierCurveQuat(5, cPoints[:])
+	if len(line) != 5 || !line[0].ApproxEqualThreshold(cPoints[0], 1e-6) || !line[4].ApproxEqualThreshold(cPoints[3], 1e-6) {
+		t.Errorf("MakeBezierCurveQuat = %v, doesn't start and end at the end points", line)
+	}
+	if q := BezierSplineInterpolateQuat(1.5, [][2]float32{{0, 1}, {1, 2}}, [][]Quat{cPoints[:2], cPoints[2:]}); !q.OrientationEqualThreshold(QuatSlerp(cPoints[2], cPoints[3], 0.5), 1e-5) {
+		t.Errorf("BezierSplineInterpolateQuat(1.5) = %v", q)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("BezierCurveQuat didn't panic on t out of range")
+		}
+	}()
+	BezierCurveQuat(1.5, cPoints[:])
+}
+
+func TestCatmullRomQuat(t *testing.T) {
+	t.Parallel()
+
+	// Evenly spaced keys about one axis are turned through at a constant rate.
+	axis := Vec3{0, 1, 0}
+	keys := []Quat{QuatRotate(0, axis), QuatRotate(0.5, axis), QuatRotate(1, axis), QuatRotate(1.5, axis)}
+	for _, tt := range []float32{0, 0.25, 0.5, 1} {
+		want := QuatRotate(0.5+0.5*tt, axis)
+		if q := CatmullRomQuat(tt, keys[0], keys[1], keys[2], keys[3]); !q.OrientationEqualThreshold(want, 1e-5) {
+			t.Errorf("CatmullRomQuat(%v) of evenly spaced keys = %v, want %v", tt, q, want)
+		}
+	}
+
+	// Random keys, some with flipped signs: the spline goes through the keys,
+	// and its angular velocity doesn't jump at them.
+	r := rand.New(rand.NewSource(11))
+	keys = keys[:0]
+	q := QuatIdent()
+	for i := 0; i < 6; i++ {
+		q = QuatRotate(r.Float32()*1.5, Vec3{r.Float32() - 0.5, r.Float32() - 0.5, r.Float32() - 0.5}.Normalize()).Mul(q)
+		if i%2 == 1 {
+			keys = append(keys, q.Scale(-1))
+		} else {
+			keys = append(keys, q)
+		}
+	}
+
+	const h = 1e-3
+	for i, key := range keys {
+		if q := CatmullRomSplineQuat(float32(i), keys); !q.OrientationEqualThreshold(key, 1e-5) {
+			t.Errorf("CatmullRomSplineQuat(%d) = %v, want key %v", i, q, key)
+		}
+		if i == 0 || i == len(keys)-1 {
+			continue
+		}
+		at := func(tt float32) Quat { return CatmullRomSplineQuat(tt, keys) }
+		before := AngularVelocity(at(float32(i)-h), at(float32(i)), h)
+		after := AngularVelocity(at(float32(i)), at(float32(i)+h), h)
+		if d := before.Sub(after).Len(); d > 0.02*before.Len() {
+			t.Errorf("Angular velocity jumps at key %d: %v before, %v after", i, before, after)
+		}
+	}
+
+	if q := CatmullRomSplineQuat(-1, keys); !q.OrientationEqualThreshold(keys[0], 1e-5) {
+		t.Errorf("CatmullRomSplineQuat before the first key = %v, want %v", q, keys[0])
+	}
+}
+
+func TestQuatSquad(t *testing.T) {
+	t.Parallel()
+
+	q1 := QuatRotate(0.3, Vec3{0, 1, 0})
+	q2 := QuatRotate(1.2, Vec3{1, 1, 0}.Normalize())
+	a := QuatRotate(0.5, Vec3{0, 0, 1}).Mul(q1)
+	b := QuatRotate(-0.4, Vec3{1, 0, 0}).Mul(q2)
+
+	if q := QuatSquad(q1, a, b, q2, 0); !q.OrientationEqualThreshold(q1, 1e-6) {
+		t.Errorf("QuatSquad at 0 = %v, want %v", q, q1)
+	}
+	if q := QuatSquad(q1, a, b, q2, 1); !q.OrientationEqualThreshold(q2, 1e-6) {
+		t.Errorf("QuatSquad at 1 = %v, want %v", q, q2)
+	}
+
+	// With the keys as their own controls, squad is slerp.
+	for _, tt := range []float32{0.2, 0.5, 0.9} {
+		if q, want := QuatSquad(q1, q1, q2, q2, tt), QuatSlerp(q1, q2, tt); !q.OrientationEqualThreshold(want, 1e-6) {
+			t.Errorf("QuatSquad(q1, q1, q2, q2, %v) = %v, want %v", tt, q, want)
+		}
+	}
+
+	// Keys turning at a constant rate about one axis are their own controls.
+	axis := Vec3{0, 0, 1}
+	if c := QuatSquadControl(QuatRotate(0.2, axis), QuatRotate(0.5, axis), QuatRotate(0.8, axis)); !c.OrientationEqualThreshold(QuatRotate(0.5, axis), 1e-6) {
+		t.Errorf("QuatSquadControl of evenly spaced keys = %v, want the middle key", c)
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+)
+
+// The Generate methods implement testing/quick.Generator, so that property
+// based tests can take the types of this package as arguments:
+//
+//	f := func(q Quat, v Vec3) bool {
+//		return FloatEqualThreshold(q.Rotate(v).Len(), v.Len(), 1e-4)
+//	}
+//	if err := quick.Check(f, nil); err != nil {
+//		t.Error(err)
+//	}
+//
+// Elements are drawn uniformly from [-size, size], where size is the one
+// passed by testing/quick. Types with invariants respect them: quaternions and
+// rotations are uniformly distributed unit quaternions, directions are unit
+// vectors, extents and radii are non-negative and boxes have Min <= Max.
+// Matrices are not guaranteed to be invertible.
+
+func randScalar(r *rand.Rand, size int) float32 {
+	return float32((2*r.Float64() - 1) * float64(size))
+}
+
+func randScalars(r *rand.Rand, size int, dst []float32) {
+	for i := range dst {
+		dst[i] = randScalar(r, size)
+	}
+}
+
+func randInt32s(r *rand.Rand, size int, dst []int32) {
+	for i := range dst {
+		dst[i] = int32(r.Intn(2*size+1) - size)
+	}
+}
+
+// randDim returns a dimension in [1, size].
+func randDim(r *rand.Rand, size int) int {
+	if size < 1 {
+		return 1
+	}
+	return 1 + r.Intn(size)
+}
+
+// randUnitQuat returns a unit quaternion uniformly distributed over the
+// rotations (Shoemake, "Uniform Random Rotations", Graphics Gems III).
+func randUnitQuat(r *rand.Rand) Quat {
+	u1, u2, u3 := r.Float64(), 2*math.Pi*r.Float64(), 2*math.Pi*r.Float64()
+	a, b := math.Sqrt(1-u1), math.Sqrt(u1)
+	return Quat{float32(b * math.Cos(u3)), Vec3{
+		float32(a * math.Sin(u2)),
+		float32(a * math.Cos(u2)),
+		float32(b * math.Sin(u3)),
+	}}
+}
+
+// randUnitVec3 returns a unit vector uniformly distributed over the sphere.
+func randUnitVec3(r *rand.Rand) Vec3 {
+	for {
+		v := Vec3{float32(r.NormFloat64()), float32(r.NormFloat64()), float32(r.NormFloat64())}
+		if l := v.Len(); l > 1e-3 {
+			return v.Mul(1 / l)
+		}
+	}
+}
+
+// Generate implements testing/quick.Generator.
+func (Vec2) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Vec2
+	randScalars(r, size, v[:])
+	return reflect.ValueOf(v)
+}
+
+// Generate implements testing/quick.Generator.
+func (Vec3) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Vec3
+	randScalars(r, size, v[:])
+	return reflect.ValueOf(v)
+}
+
+// Generate implements testing/quick.Generator.
+func (Vec4) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Vec4
+	randScalars(r, size, v[:])
+	return reflect.ValueOf(v)
+}
+
+// Generate implements testing/quick.Generator.
+func (Vec2i) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Vec2i
+	randInt32s(r, size, v[:])
+	return reflect.ValueOf(v)
+}
+
+// Generate implements testing/quick.Generator.
+func (Vec3i) Generate(r *rand.Rand, size int) reflect.Value {
+	var v Vec3i
+	randInt32s(r, size, v[:])
+	return reflect.ValueOf(v)
+}
+
+// Generate implements testing/quick.Generator. The length of the vector is
+// in [1, size].
+func (*VecN) Generate(r *rand.Rand, size int) reflect.Value {
+	v := make([]float32, randDim(r, size))
+	randScalars(r, size, v)
+	return reflect.ValueOf(NewVecNFromData(v))
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat2) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat2
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat2x3) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat2x3
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat2x4) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat2x4
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat3x2) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat3x2
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat3) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat3
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat3x4) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat3x4
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat4x2) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat4x2
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat4x3) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat4x3
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator.
+func (Mat4) Generate(r *rand.Rand, size int) reflect.Value {
+	var m Mat4
+	randScalars(r, size, m[:])
+	return reflect.ValueOf(m)
+}
+
+// Generate implements testing/quick.Generator. Both dimensions of the matrix
+// are in [1, size].
+func (*MatMxN) Generate(r *rand.Rand, size int) reflect.Value {
+	rows, cols := randDim(r, size), randDim(r, size)
+	data := make([]float32, rows*cols)
+	randScalars(r, size, data)
+	return reflect.ValueOf(NewMatrixFromData(data, rows, cols))
+}
+
+// Generate implements testing/quick.Generator. The quaternion has unit
+// length.
+func (Quat) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(randUnitQuat(r))
+}
+
+// Generate implements testing/quick.Generator. The dual quaternion is a unit
+// dual quaternion, a rotation followed by a translation.
+func (DualQuat) Generate(r *rand.Rand, size int) reflect.Value {
+	var t Vec3
+	randScalars(r, size, t[:])
+	return reflect.ValueOf(DualQuatFromRotationTranslation(randUnitQuat(r), t))
+}
+
+// Generate implements testing/quick.Generator. The rotation has unit length
+// and the scale factors are positive, in [1/2, 2].
+func (Transform) Generate(r *rand.Rand, size int) reflect.Value {
+	var t Transform
+	randScalars(r, size, t.Translation[:])
+	t.Rotation = randUnitQuat(r)
+	for i := range t.Scale {
+		t.Scale[i] = float32(math.Exp2(2*r.Float64() - 1))
+	}
+	return reflect.ValueOf(t)
+}
+
+// Generate implements testing/quick.Generator.
+func (Twist) Generate(r *rand.Rand, size int) reflect.Value {
+	var t Twist
+	randScalars(r, size, t.Angular[:])
+	randScalars(r, size, t.Linear[:])
+	return reflect.ValueOf(t)
+}
+
+// Generate implements testing/quick.Generator. The box has Min <= Max.
+func (AABB) Generate(r *rand.Rand, size int) reflect.Value {
+	var box AABB
+	for i := range box.Min {
+		a, b := randScalar(r, size), randScalar(r, size)
+		if a > b {
+			a, b = b, a
+		}
+		box.Min[i], box.Max[i] = a, b
+	}
+	return reflect.ValueOf(box)
+}
+
+// Generate implements testing/quick.Generator. The rectangle has Min <= Max.
+func (Rect2) Generate(r *rand.Rand, size int) reflect.Value {
+	var rect Rect2
+	for i := range rect.Min {
+		a, b := randScalar(r, size), randScalar(r, size)
+		if a > b {
+			a, b = b, a
+		}
+		rect.Min[i], rect.Max[i] = a, b
+	}
+	return reflect.ValueOf(rect)
+}
+
+// Generate implements testing/quick.Generator. The radius is non-negative.
+func (Sphere) Generate(r *rand.Rand, size int) reflect.Value {
+	var s Sphere
+	randScalars(r, size, s.Center[:])
+	s.Radius = Abs(randScalar(r, size))
+	return reflect.ValueOf(s)
+}
+
+// Generate implements testing/quick.Generator. The radius is non-negative.
+func (Capsule) Generate(r *rand.Rand, size int) reflect.Value {
+	var c Capsule
+	randScalars(r, size, c.A[:])
+	randScalars(r, size, c.B[:])
+	c.Radius = Abs(randScalar(r, size))
+	return reflect.ValueOf(c)
+}
+
+// Generate implements testing/quick.Generator. The axes are orthonormal and
+// form a rotation, and the half extents are non-negative.
+func (OBB) Generate(r *rand.Rand, size int) reflect.Value {
+	var box OBB
+	randScalars(r, size, box.Center[:])
+	box.Axes = randUnitQuat(r).Mat4().Mat3()
+	for i := range box.HalfExtents {
+		box.HalfExtents[i] = Abs(randScalar(r, size))
+	}
+	return reflect.ValueOf(box)
+}
+
+// Generate implements testing/quick.Generator. The direction is a unit
+// vector.
+func (Ray) Generate(r *rand.Rand, size int) reflect.Value {
+	var ray Ray
+	randScalars(r, size, ray.Origin[:])
+	ray.Dir = randUnitVec3(r)
+	return reflect.ValueOf(ray)
+}
+
+// Generate implements testing/quick.Generator.
+func (Segment3) Generate(r *rand.Rand, size int) reflect.Value {
+	var s Segment3
+	randScalars(r, size, s.A[:])
+	randScalars(r, size, s.B[:])
+	return reflect.ValueOf(s)
+}
+
+// Generate implements testing/quick.Generator. The line passes through a
+// point with elements in [-size, size] and has a unit direction.
+func (Line3) Generate(r *rand.Rand, size int) reflect.Value {
+	var p Vec3
+	randScalars(r, size, p[:])
+	return reflect.ValueOf(Line3FromPointDir(p, randUnitVec3(r)))
+}
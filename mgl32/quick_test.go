@@ -0,0 +1,79 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestGenerateInvariants(t *testing.T) {
+	t.Parallel()
+
+	config := &quick.Config{Rand: rand.New(rand.NewSource(1))}
+
+	tests := []struct {
+		name string
+		f    interface{}
+	}{
+		{"Vec3", func(v Vec3) bool {
+			return Abs(v[0]) <= 50 && Abs(v[1]) <= 50 && Abs(v[2]) <= 50
+		}},
+		{"Vec3i", func(v Vec3i) bool {
+			return v[0] >= -50 && v[0] <= 50 && v[2] >= -50 && v[2] <= 50
+		}},
+		{"Quat", func(q Quat) bool {
+			return FloatEqualThreshold(q.Len(), 1, 1e-5)
+		}},
+		{"DualQuat", func(dq DualQuat) bool {
+			return FloatEqualThreshold(dq.Real.Len(), 1, 1e-5) && Abs(dq.Real.Dot(dq.Dual)) <= 1e-3
+		}},
+		{"Transform", func(tr Transform) bool {
+			return FloatEqualThreshold(tr.Rotation.Len(), 1, 1e-5) &&
+				tr.Scale[0] >= 0.5 && tr.Scale[0] <= 2 && tr.Scale[2] >= 0.5 && tr.Scale[2] <= 2
+		}},
+		{"AABB", func(box AABB) bool {
+			return box.Min[0] <= box.Max[0] && box.Min[1] <= box.Max[1] && box.Min[2] <= box.Max[2]
+		}},
+		{"Rect2", func(rect Rect2) bool {
+			return rect.Min[0] <= rect.Max[0] && rect.Min[1] <= rect.Max[1]
+		}},
+		{"Sphere", func(s Sphere) bool { return s.Radius >= 0 }},
+		{"Capsule", func(c Capsule) bool { return c.Radius >= 0 }},
+		{"OBB", func(box OBB) bool {
+			return box.Axes.Mul3(box.Axes.Transpose()).ApproxFuncEqual(Ident3(), absEqual(1e-5)) &&
+				FloatEqualThreshold(box.Axes.Det(), 1, 1e-4) && box.HalfExtents[1] >= 0
+		}},
+		{"Ray", func(r Ray) bool { return FloatEqualThreshold(r.Dir.Len(), 1, 1e-5) }},
+		{"Line3", func(l Line3) bool { return Abs(l.D.Dot(l.M)) <= 1e-3 }},
+		{"VecN", func(v *VecN) bool { return v.Size() >= 1 && v.Size() <= 50 }},
+		{"MatMxN", func(m *MatMxN) bool {
+			return m.NumRows() >= 1 && m.NumRows() <= 50 && m.NumCols() >= 1 && m.NumCols() <= 50
+		}},
+		{"Mat4", func(m Mat4, v Vec4) bool {
+			return m.Mul4x1(v).ApproxEqualThreshold(m.Transpose().Transpose().Mul4x1(v), 1e-6)
+		}},
+		{"Mat2x3", func(m Mat2x3) bool { return m.Transpose().Transpose() == m }},
+	}
+
+	for _, test := range tests {
+		if err := quick.Check(test.f, config); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		}
+	}
+}
+
+func TestGenerateRotation(t *testing.T) {
+	t.Parallel()
+
+	// The property from the package documentation.
+	f := func(q Quat, v Vec3) bool {
+		return FloatEqualThreshold(q.Rotate(v).Len(), v.Len(), 1e-4)
+	}
+	if err := quick.Check(f, &quick.Config{Rand: rand.New(rand.NewSource(2))}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"math/rand"
+)
+
+// RandomQuat returns a random unit quaternion, uniformly distributed over the
+// rotations of 3D space (the Haar measure on SO(3)). It uses Shoemake's
+// subgroup algorithm, so unlike normalizing a random 4D vector it requires no
+// rejection step and generates no bias towards any axis.
+func RandomQuat(r *rand.Rand) Quat {
+	u1, u2, u3 := float64(r.Float32()), 2*math.Pi*float64(r.Float32()), 2*math.Pi*float64(r.Float32())
+	a, b := math.Sqrt(1-u1), math.Sqrt(u1)
+	s2, c2 := math.Sincos(u2)
+	s3, c3 := math.Sincos(u3)
+	return Quat{float32(b * c3), Vec3{float32(a * s2), float32(a * c2), float32(b * s3)}}
+}
+
+// RandomRotation3 returns a random rotation matrix, uniformly distributed over
+// SO(3).
+func RandomRotation3(r *rand.Rand) Mat3 {
+	return RandomQuat(r).Mat4().Mat3()
+}
+
+// RandomOrthogonal3 returns a random orthogonal matrix, uniformly distributed
+// over O(3). Half of the results are reflections (determinant -1).
+func RandomOrthogonal3(r *rand.Rand) Mat3 {
+	m := RandomRotation3(r)
+	if r.Intn(2) == 0 {
+		return m.Mul(-1)
+	}
+	return m
+}
+
+// RandomSPD3 returns a random symmetric positive definite matrix whose
+// eigenvalues are uniformly distributed in [minEigen, maxEigen], and whose
+// eigenvectors are a uniformly distributed rotation. This panics unless
+// 0 < minEigen <= maxEigen.
+func RandomSPD3(r *rand.Rand, minEigen, maxEigen float32) Mat3 {
+	if !(minEigen > 0 && minEigen <= maxEigen) {
+		panic("RandomSPD3 requires 0 < minEigen <= maxEigen")
+	}
+	rot := RandomRotation3(r)
+	var d Vec3
+	for i := range d {
+		d[i] = minEigen + r.Float32()*(maxEigen-minEigen)
+	}
+	return rot.Mul3(Diag3(d)).Mul3(rot.Transpose())
+}
+
+// RandomInvertible4 returns a random invertible matrix whose condition number
+// (the ratio of its largest to smallest singular value) is at most maxCond.
+// The matrix is built as U*S*V^T, with U and V uniformly distributed
+// orthogonal matrices and the singular values in S drawn uniformly from
+// [1, maxCond]. This panics if maxCond < 1.
+func RandomInvertible4(r *rand.Rand, maxCond float32) Mat4 {
+	if !(maxCond >= 1) {
+		panic("RandomInvertible4 requires maxCond >= 1")
+	}
+	var s Vec4
+	for i := range s {
+		s[i] = 1 + r.Float32()*(maxCond-1)
+	}
+	return randomOrthogonal4(r).Mul4(Diag4(s)).Mul4(randomOrthogonal4(r).Transpose())
+}
+
+// randomOrthogonal4 returns a uniformly distributed orthogonal matrix by
+// orthonormalizing columns with normally distributed entries.
+func randomOrthogonal4(r *rand.Rand) Mat4 {
+	var cols [4]Vec4
+	for i := 0; i < 4; i++ {
+		for {
+			var v Vec4
+			for j := range v {
+				v[j] = float32(r.NormFloat64())
+			}
+			for j := 0; j < i; j++ {
+				v = v.Sub(cols[j].Mul(v.Dot(cols[j])))
+			}
+			// Almost dependent columns lose precision, draw again.
+			if l := v.Len(); l > 1e-3 {
+				cols[i] = v.Mul(1 / l)
+				break
+			}
+		}
+	}
+	return Mat4FromCols(cols[0], cols[1], cols[2], cols[3])
+}
@@ -0,0 +1,90 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomQuat(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	var sum Vec3
+	const n = 2000
+	for i := 0; i < n; i++ {
+		q := RandomQuat(r)
+		if l := q.Len(); Abs(l-1) > 1e-4 {
+			t.Fatalf("RandomQuat returned a quaternion of length %v", l)
+		}
+		sum = sum.Add(q.Rotate(Vec3{0, 0, 1}))
+	}
+
+	// Uniform rotations move a fixed vector to uniformly distributed
+	// directions, whose mean tends to zero.
+	if mean := sum.Mul(1. / n); mean.Len() > 0.1 {
+		t.Errorf("Rotated vectors are biased, mean direction %v", mean)
+	}
+}
+
+func TestRandomOrthogonal3(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+	reflections := 0
+	for i := 0; i < 100; i++ {
+		m := RandomOrthogonal3(r)
+		if mtm := m.Transpose().Mul3(m); !mtm.ApproxFuncEqual(Ident3(), func(a, b float32) bool { return Abs(a-b) < 1e-4 }) {
+			t.Fatalf("RandomOrthogonal3 returned a non-orthogonal matrix %v", m)
+		}
+		if m.Det() < 0 {
+			reflections++
+		}
+		if rot := RandomRotation3(r); Abs(rot.Det()-1) > 1e-4 {
+			t.Fatalf("RandomRotation3 returned a matrix with determinant %v", rot.Det())
+		}
+	}
+	if reflections == 0 || reflections == 100 {
+		t.Errorf("RandomOrthogonal3 returned %d reflections out of 100", reflections)
+	}
+}
+
+func TestRandomSPD3(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 100; i++ {
+		m := RandomSPD3(r, 0.5, 4)
+		if !m.ApproxFuncEqual(m.Transpose(), func(a, b float32) bool { return Abs(a-b) < 1e-4 }) {
+			t.Fatalf("RandomSPD3 returned a non-symmetric matrix %v", m)
+		}
+		_, s, _ := m.SVD()
+		if s[0] > 4+1e-3 || s[2] < 0.5-1e-3 {
+			t.Fatalf("RandomSPD3 returned eigenvalues %v outside of [0.5, 4]", s)
+		}
+		if v := (Vec3{1, -2, 0.5}); v.Dot(m.Mul3x1(v)) <= 0 {
+			t.Fatalf("RandomSPD3 returned a matrix that is not positive definite: %v", m)
+		}
+	}
+}
+
+func TestRandomInvertible4(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < 100; i++ {
+		m := RandomInvertible4(r, 10)
+		if id := m.Mul4(m.Inv()); !id.ApproxFuncEqual(Ident4(), func(a, b float32) bool { return Abs(a-b) < 1e-3 }) {
+			t.Fatalf("RandomInvertible4 returned a badly inverted matrix, M*M^-1 = %v", id)
+		}
+
+		// Every singular value is in [1, 10], so is the stretch of any vector.
+		v := Vec4{r.Float32() - 0.5, r.Float32() - 0.5, r.Float32() - 0.5, r.Float32() - 0.5}.Normalize()
+		if l := m.Mul4x1(v).Len(); l < 1-1e-3 || l > 10+1e-3 {
+			t.Fatalf("RandomInvertible4 stretched a unit vector to length %v", l)
+		}
+	}
+}
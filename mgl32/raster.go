@@ -0,0 +1,66 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// LineCells2D returns the cells of the Bresenham line from a to b, both
+// included, in order from a. Consecutive cells share an edge or a corner, and
+// there is exactly one cell per step along the major axis, so the line is the
+// thinnest connected one, as for line of sight checks on tile maps.
+func LineCells2D(a, b Vec2i) []Vec2i {
+	dx, sx := int(b[0])-int(a[0]), 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+	dy, sy := int(a[1])-int(b[1]), 1
+	if dy > 0 {
+		dy, sy = -dy, -1
+	}
+
+	n := dx
+	if -dy > n {
+		n = -dy
+	}
+	cells := make([]Vec2i, 0, n+1)
+
+	x, y := int(a[0]), int(a[1])
+	err := dx + dy
+	for {
+		cells = append(cells, Vec2i{int32(x), int32(y)})
+		if x == int(b[0]) && y == int(b[1]) {
+			return cells
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y += sy
+		}
+	}
+}
+
+// A CellEntry is a cell crossed by a line, and the parameter along the line
+// at which the line enters it.
+type CellEntry struct {
+	Cell Vec3i
+	T    float32
+}
+
+// LineCells3D returns the unit voxels crossed by the segment from a to b, in
+// order from a, with the fraction of the segment in [0, 1] at which it enters
+// each, 0 for the voxel containing a. Unlike LineCells2D this is a full
+// traversal, which includes every voxel the segment passes through: cells the
+// segment only touches at an edge or corner may be included too, with the
+// same T as the next one. See Grid.Traverse for grids in world space.
+func LineCells3D(a, b Vec3) []CellEntry {
+	var cells []CellEntry
+	ddaTraverse(a, b.Sub(a), 1, func(cell Vec3i, tEnter, tExit float32) bool {
+		cells = append(cells, CellEntry{cell, tEnter})
+		return true
+	})
+	return cells
+}
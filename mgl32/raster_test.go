@@ -0,0 +1,69 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLineCells2D(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b Vec2i
+		want []Vec2i
+	}{
+		{Vec2i{2, 3}, Vec2i{2, 3}, []Vec2i{{2, 3}}},
+		{Vec2i{0, 0}, Vec2i{3, 0}, []Vec2i{{0, 0}, {1, 0}, {2, 0}, {3, 0}}},
+		{Vec2i{0, 0}, Vec2i{0, -2}, []Vec2i{{0, 0}, {0, -1}, {0, -2}}},
+		{Vec2i{0, 0}, Vec2i{3, 3}, []Vec2i{{0, 0}, {1, 1}, {2, 2}, {3, 3}}},
+		{Vec2i{0, 0}, Vec2i{4, 2}, []Vec2i{{0, 0}, {1, 1}, {2, 1}, {3, 2}, {4, 2}}},
+		{Vec2i{1, 5}, Vec2i{-1, 0}, []Vec2i{{1, 5}, {1, 4}, {0, 3}, {0, 2}, {-1, 1}, {-1, 0}}},
+	}
+
+	for _, test := range tests {
+		if got := LineCells2D(test.a, test.b); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("LineCells2D(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+
+	// Every line is connected and has one cell per step along its major axis.
+	for _, b := range []Vec2i{{7, 3}, {-5, 9}, {-8, -8}, {2, -11}} {
+		cells := LineCells2D(Vec2i{}, b)
+		if n := len(cells) - 1; int32(n) != b[0] && int32(n) != -b[0] && int32(n) != b[1] && int32(n) != -b[1] {
+			t.Errorf("LineCells2D(0, %v) has %d cells", b, len(cells))
+		}
+		for i := 1; i < len(cells); i++ {
+			if d := cells[i].Sub(cells[i-1]); d[0] < -1 || d[0] > 1 || d[1] < -1 || d[1] > 1 {
+				t.Errorf("LineCells2D(0, %v) jumps from %v to %v", b, cells[i-1], cells[i])
+			}
+		}
+	}
+}
+
+func TestLineCells3D(t *testing.T) {
+	t.Parallel()
+
+	got := LineCells3D(Vec3{0.5, 0.5, 0.5}, Vec3{2.5, 1.5, 0.5})
+	want := []CellEntry{
+		{Vec3i{0, 0, 0}, 0},
+		{Vec3i{1, 0, 0}, 0.25},
+		{Vec3i{1, 1, 0}, 0.5},
+		{Vec3i{2, 1, 0}, 0.75},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LineCells3D = %v, want %v", got, want)
+	}
+
+	if got := LineCells3D(Vec3{-0.5, 1, 2}, Vec3{-0.5, 1, 2}); !reflect.DeepEqual(got, []CellEntry{{Vec3i{-1, 1, 2}, 0}}) {
+		t.Errorf("LineCells3D of a point = %v", got)
+	}
+
+	// A segment ending on a boundary doesn't enter the next cell.
+	if got := LineCells3D(Vec3{0.5, 0.5, 0.5}, Vec3{0.5, 0.5, -1}); len(got) != 2 {
+		t.Errorf("LineCells3D to a boundary = %v, want 2 cells", got)
+	}
+}
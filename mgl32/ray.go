@@ -0,0 +1,210 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// Ray is the half line of points Origin + t*Dir for t >= 0. Dir doesn't need
+// to be normalized; the distances t returned by the intersection methods are
+// in units of its length, so they are actual distances only if it is.
+//
+// The intersection methods return the smallest t >= 0 at which the ray meets
+// the surface of the shape. If the origin is inside a solid shape, that is
+// where the ray exits it.
+type Ray struct {
+	Origin, Dir Vec3
+}
+
+// At returns the point Origin + t*Dir.
+func (r Ray) At(t float32) Vec3 {
+	return r.Origin.Add(r.Dir.Mul(t))
+}
+
+// IntersectSphere intersects the ray with the sphere.
+func (r Ray) IntersectSphere(s Sphere) (t float32, ok bool) {
+	m := r.Origin.Sub(s.Center)
+	rr := float64(s.Radius) * float64(s.Radius)
+	for _, tc := range solveQuadratic(dot64(r.Dir, r.Dir), 2*dot64(m, r.Dir), dot64(m, m)-rr) {
+		if tc >= 0 {
+			return float32(tc), true
+		}
+	}
+	return 0, false
+}
+
+// IntersectAABB intersects the ray with the box using the slab test.
+func (r Ray) IntersectAABB(box AABB) (t float32, ok bool) {
+	tmin, tmax, ok := rayAABBRange(r.Origin, r.Dir, box)
+	if !ok {
+		return 0, false
+	}
+	if tmin > 0 {
+		return tmin, true
+	}
+	return tmax, true
+}
+
+// IntersectTriangle intersects the ray with the triangle (a,b,c), from either
+// side, using the Möller-Trumbore algorithm. The hit point is
+// r.At(t), or a + u*(b-a) + v*(c-a) in barycentric coordinates, which can be
+// used to interpolate vertex attributes. Rays in the plane of the triangle
+// miss it.
+func (r Ray) IntersectTriangle(a, b, c Vec3) (t, u, v float32, ok bool) {
+	return intersectTriangle(r, a, b.Sub(a), c.Sub(a))
+}
+
+// IntersectPlaneEquation intersects the ray with the plane a*x + b*y + c*z +
+// d = 0 of the coefficients p, as returned by PlaneFromPoints. Like
+// IntersectPlane, it fails if the ray is parallel to the plane or points
+// away from it.
+func (r Ray) IntersectPlaneEquation(p Vec4) (t float32, ok bool) {
+	n := p.Vec3()
+	denom := r.Dir.Dot(n)
+	if denom == 0 {
+		return 0, false
+	}
+	t = -(r.Origin.Dot(n) + p[3]) / denom
+	return t, t >= 0
+}
+
+// IntersectCylinder intersects the ray with the closed cylinder of the given
+// radius around the segment [a,b], including its end caps.
+func (r Ray) IntersectCylinder(a, b Vec3, radius float32) (t float32, ok bool) {
+	ab, m, d := b.Sub(a), r.Origin.Sub(a), r.Dir
+	md, nd, dd := dot64(m, ab), dot64(d, ab), dot64(ab, ab)
+	rr := float64(radius) * float64(radius)
+	best := math.Inf(1)
+
+	// The side, where the distance to the axis is the radius
+	for _, tc := range solveQuadratic(dd*dot64(d, d)-nd*nd, 2*(dd*dot64(m, d)-nd*md), dd*(dot64(m, m)-rr)-md*md) {
+		if s := md + tc*nd; tc >= 0 && tc < best && s >= 0 && s <= dd {
+			best = tc
+		}
+	}
+
+	// The caps, where the ray crosses the planes through a and b
+	if nd != 0 {
+		for _, c := range [2]Vec3{a, b} {
+			tc := (dot64(c.Sub(r.Origin), ab)) / nd
+			if p := r.At(float32(tc)).Sub(c); tc >= 0 && tc < best && dot64(p, p) <= rr*(1+1e-6) {
+				best = tc
+			}
+		}
+	}
+
+	return rayResult(best)
+}
+
+// IntersectCone intersects the ray with the closed cone with its apex at
+// apex, and a base disk of the given radius centered at base, perpendicular
+// to the axis.
+func (r Ray) IntersectCone(apex, base Vec3, radius float32) (t float32, ok bool) {
+	axis := base.Sub(apex)
+	h := math.Sqrt(dot64(axis, axis))
+	if h == 0 {
+		return 0, false
+	}
+	v := axis.Normalize()
+	rr := float64(radius) * float64(radius)
+	cos2 := h * h / (h*h + rr)
+
+	co, d := r.Origin.Sub(apex), r.Dir
+	dv, cov := dot64(d, v), dot64(co, v)
+	best := math.Inf(1)
+
+	// The lateral surface, where (p-apex).v = |p-apex|*cos(angle), within
+	// the height of the cone
+	for _, tc := range solveQuadratic(dv*dv-cos2*dot64(d, d), 2*(dv*cov-cos2*dot64(d, co)), cov*cov-cos2*dot64(co, co)) {
+		if s := cov + tc*dv; tc >= 0 && tc < best && s >= 0 && s <= h {
+			best = tc
+		}
+	}
+
+	// The base disk
+	if dv != 0 {
+		tc := (h - cov) / dv
+		if p := r.At(float32(tc)).Sub(base); tc >= 0 && tc < best && dot64(p, p) <= rr*(1+1e-6) {
+			best = tc
+		}
+	}
+
+	return rayResult(best)
+}
+
+// IntersectTorus intersects the ray with the torus centered at center around
+// the given axis, with the distance from the center to the middle of the tube
+// majorRadius and the radius of the tube minorRadius. This requires solving a
+// quartic equation.
+func (r Ray) IntersectTorus(center, axis Vec3, majorRadius, minorRadius float32) (t float32, ok bool) {
+	o := r.Origin.Sub(center)
+	dl := math.Sqrt(dot64(r.Dir, r.Dir))
+	if dl == 0 || axis.Len() == 0 {
+		return 0, false
+	}
+	n := axis.Normalize()
+
+	// Solving with a unit direction keeps the coefficients well scaled.
+	R2 := float64(majorRadius) * float64(majorRadius)
+	r2 := float64(minorRadius) * float64(minorRadius)
+	od, oo := dot64(o, r.Dir)/dl, dot64(o, o)
+	dz, oz := dot64(r.Dir, n)/dl, dot64(o, n)
+	k := oo + R2 - r2
+
+	// (|p|^2 + R^2 - r^2)^2 = 4 R^2 (|p|^2 - (p.n)^2) with p = o + t*d
+	roots := solveQuartic(
+		1,
+		4*od,
+		4*od*od+2*k-4*R2*(1-dz*dz),
+		4*od*k-8*R2*(od-dz*oz),
+		k*k-4*R2*(oo-oz*oz),
+	)
+
+	for _, tc := range roots {
+		if tc >= 0 {
+			return rayResult(tc / dl)
+		}
+	}
+	return 0, false
+}
+
+// intersectTriangle is the Möller-Trumbore ray/triangle intersection for the
+// triangle with vertex a and edges ab and ac from it.
+func intersectTriangle(r Ray, a, ab, ac Vec3) (t, u, v float32, ok bool) {
+	p := r.Dir.Cross(ac)
+	det := ab.Dot(p)
+	if det == 0 {
+		return 0, 0, 0, false
+	}
+	inv := 1 / det
+
+	s := r.Origin.Sub(a)
+	u = s.Dot(p) * inv
+	if u < 0 || u > 1 {
+		return 0, 0, 0, false
+	}
+	q := s.Cross(ab)
+	v = r.Dir.Dot(q) * inv
+	if v < 0 || u+v > 1 {
+		return 0, 0, 0, false
+	}
+	t = ac.Dot(q) * inv
+	return t, u, v, t >= 0
+}
+
+// rayResult converts the best distance found by an intersection routine
+// into its results, where infinity means that nothing was hit.
+func rayResult(t float64) (float32, bool) {
+	if math.IsInf(t, 1) {
+		return 0, false
+	}
+	return float32(t), true
+}
+
+// dot64 returns the dot product of a and b computed in float64.
+func dot64(a, b Vec3) float64 {
+	return float64(a[0])*float64(b[0]) + float64(a[1])*float64(b[1]) + float64(a[2])*float64(b[2])
+}
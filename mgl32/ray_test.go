@@ -0,0 +1,153 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+type rayTest struct {
+	Description string
+	Ray         Ray
+	Hit         bool
+	T           float32
+}
+
+func checkRayTests(t *testing.T, tests []rayTest, intersect func(Ray) (float32, bool)) {
+	for _, c := range tests {
+		tc, hit := intersect(c.Ray)
+		if hit != c.Hit || hit && Abs(tc-c.T) > 1e-4 {
+			t.Errorf("%s: got %v, %v; expected %v, %v", c.Description, tc, hit, c.T, c.Hit)
+		}
+	}
+}
+
+func TestRayAt(t *testing.T) {
+	t.Parallel()
+
+	r := Ray{Vec3{1, 2, 3}, Vec3{0, 2, 0}}
+	if p := r.At(1.5); !p.ApproxEqual(Vec3{1, 5, 3}) {
+		t.Errorf("Ray.At(1.5) = %v, expected [1 5 3]", p)
+	}
+}
+
+func TestRayIntersectSphere(t *testing.T) {
+	t.Parallel()
+
+	s := Sphere{Vec3{0, 0, 5}, 2}
+	checkRayTests(t, []rayTest{
+		{"Through the center", Ray{Vec3{}, Vec3{0, 0, 1}}, true, 3},
+		{"Unnormalized direction", Ray{Vec3{}, Vec3{0, 0, 2}}, true, 1.5},
+		{"Tangent", Ray{Vec3{2, 0, 0}, Vec3{0, 0, 1}}, true, 5},
+		{"Beside", Ray{Vec3{2.5, 0, 0}, Vec3{0, 0, 1}}, false, 0},
+		{"From the inside", Ray{Vec3{0, 0, 5}, Vec3{1, 0, 0}}, true, 2},
+		{"Pointing away", Ray{Vec3{}, Vec3{0, 0, -1}}, false, 0},
+	}, func(r Ray) (float32, bool) { return r.IntersectSphere(s) })
+}
+
+func TestRayIntersectAABB(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -1, 2}, Vec3{1, 1, 4}}
+	checkRayTests(t, []rayTest{
+		{"Front face", Ray{Vec3{}, Vec3{0, 0, 1}}, true, 2},
+		{"Diagonal", Ray{Vec3{-3, -3, 0}, Vec3{1, 1, 1}}, true, 2},
+		{"Parallel to a face, inside its slab", Ray{Vec3{0.5, -5, 3}, Vec3{0, 1, 0}}, true, 4},
+		{"Parallel to a face, outside its slab", Ray{Vec3{2, -5, 3}, Vec3{0, 1, 0}}, false, 0},
+		{"Beside", Ray{Vec3{0, 2, 0}, Vec3{0, 0, 1}}, false, 0},
+		{"From the inside", Ray{Vec3{0, 0, 3}, Vec3{1, 0, 0}}, true, 1},
+		{"Pointing away", Ray{Vec3{}, Vec3{0, 0, -1}}, false, 0},
+	}, func(r Ray) (float32, bool) { return r.IntersectAABB(box) })
+}
+
+func TestRayIntersectTriangle(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := Vec3{0, 0, 2}, Vec3{2, 0, 2}, Vec3{0, 2, 2}
+	checkRayTests(t, []rayTest{
+		{"Front", Ray{Vec3{0.5, 0.5, 0}, Vec3{0, 0, 1}}, true, 2},
+		{"Back", Ray{Vec3{0.5, 0.5, 5}, Vec3{0, 0, -1}}, true, 3},
+		{"Edge", Ray{Vec3{1, 1, 0}, Vec3{0, 0, 1}}, true, 2},
+		{"Outside", Ray{Vec3{1.5, 1.5, 0}, Vec3{0, 0, 1}}, false, 0},
+		{"In the plane", Ray{Vec3{-1, 0.5, 2}, Vec3{1, 0, 0}}, false, 0},
+		{"Pointing away", Ray{Vec3{0.5, 0.5, 0}, Vec3{0, 0, -1}}, false, 0},
+	}, func(r Ray) (float32, bool) {
+		t, _, _, ok := r.IntersectTriangle(a, b, c)
+		return t, ok
+	})
+
+	r := Ray{Vec3{0.5, 0.25, -1}, Vec3{0, 0, 1}}
+	tc, u, v, ok := r.IntersectTriangle(a, b, c)
+	if p := a.Add(b.Sub(a).Mul(u)).Add(c.Sub(a).Mul(v)); !ok || !p.ApproxEqualThreshold(r.At(tc), 1e-6) {
+		t.Errorf("IntersectTriangle barycentric point %v (u %v, v %v), want the hit point %v", p, u, v, r.At(tc))
+	}
+}
+
+func TestRayIntersectPlaneEquation(t *testing.T) {
+	t.Parallel()
+
+	plane := PlaneFromPointNormal(Vec3{0, 0, 3}, Vec3{0, 0, 1})
+	checkRayTests(t, []rayTest{
+		{"Perpendicular", Ray{Vec3{1, 2, 0}, Vec3{0, 0, 1}}, true, 3},
+		{"From the front", Ray{Vec3{1, 2, 5}, Vec3{0, 0, -2}}, true, 1},
+		{"Oblique", Ray{Vec3{}, Vec3{1, 0, 1}}, true, 3},
+		{"Parallel", Ray{Vec3{}, Vec3{1, 0, 0}}, false, 0},
+		{"Pointing away", Ray{Vec3{}, Vec3{0, 0, -1}}, false, 0},
+	}, func(r Ray) (float32, bool) { return r.IntersectPlaneEquation(plane) })
+}
+
+func TestRayIntersectCylinder(t *testing.T) {
+	t.Parallel()
+
+	// A cylinder of radius 1 along the z axis, from z = 0 to 2.
+	a, b := Vec3{0, 0, 0}, Vec3{0, 0, 2}
+	checkRayTests(t, []rayTest{
+		{"Side", Ray{Vec3{-5, 0, 1}, Vec3{1, 0, 0}}, true, 4},
+		{"Side, unnormalized direction", Ray{Vec3{-5, 0, 1}, Vec3{2, 0, 0}}, true, 2},
+		{"Top cap", Ray{Vec3{0.5, 0, 5}, Vec3{0, 0, -1}}, true, 3},
+		{"Above", Ray{Vec3{-5, 0, 3}, Vec3{1, 0, 0}}, false, 0},
+		{"Beside", Ray{Vec3{-5, 1.5, 1}, Vec3{1, 0, 0}}, false, 0},
+		{"From the inside", Ray{Vec3{0, 0, 1}, Vec3{0, 1, 0}}, true, 1},
+		{"Pointing away", Ray{Vec3{-5, 0, 1}, Vec3{-1, 0, 0}}, false, 0},
+	}, func(r Ray) (float32, bool) { return r.IntersectCylinder(a, b, 1) })
+}
+
+func TestRayIntersectCone(t *testing.T) {
+	t.Parallel()
+
+	// A cone with its apex at z = 2, and a base of radius 1 at z = 0.
+	apex, base := Vec3{0, 0, 2}, Vec3{0, 0, 0}
+	checkRayTests(t, []rayTest{
+		{"Side", Ray{Vec3{-5, 0, 1}, Vec3{1, 0, 0}}, true, 4.5},
+		{"Near the apex", Ray{Vec3{0.25, 0, 5}, Vec3{0, 0, -1}}, true, 3.5},
+		{"Base", Ray{Vec3{0.5, 0, -3}, Vec3{0, 0, 1}}, true, 3},
+		{"Other nappe", Ray{Vec3{-5, 0, 3}, Vec3{1, 0, 0}}, false, 0},
+		{"Beside", Ray{Vec3{-5, 0.7, 1}, Vec3{1, 0, 0}}, false, 0},
+	}, func(r Ray) (float32, bool) { return r.IntersectCone(apex, base, 1) })
+}
+
+func TestRayIntersectTorus(t *testing.T) {
+	t.Parallel()
+
+	// A torus around the y axis, with its tube between 2 and 4 units from
+	// the center.
+	center, axis := Vec3{0, 1, 0}, Vec3{0, 1, 0}
+	checkRayTests(t, []rayTest{
+		{"Outer side", Ray{Vec3{-10, 1, 0}, Vec3{1, 0, 0}}, true, 6},
+		{"Through the hole", Ray{Vec3{0, 10, 0}, Vec3{0, -1, 0}}, false, 0},
+		{"From above", Ray{Vec3{3, 10, 0}, Vec3{0, -1, 0}}, true, 8},
+		{"Unnormalized direction", Ray{Vec3{3, 10, 0}, Vec3{0, -4, 0}}, true, 2},
+		{"From the hole", Ray{Vec3{0, 1, 0}, Vec3{0, 0, 1}}, true, 2},
+		{"Off center", Ray{Vec3{-10, 1.5, 0}, Vec3{1, 0, 0}}, true, 10 - float32(3.8660254)},
+		{"Above", Ray{Vec3{-10, 3.5, 0}, Vec3{1, 0, 0}}, false, 0},
+	}, func(r Ray) (float32, bool) { return r.IntersectTorus(center, axis, 3, 1) })
+
+	// Rays nearly perpendicular to the axis, whose quartics have roots in
+	// nearly opposite pairs.
+	checkRayTests(t, []rayTest{
+		{"Nearly perpendicular", Ray{Vec3{-10, 0.15, 0.2}, Vec3{1, 0, 1e-8}}, true, 7.5463231},
+		{"Perpendicular off center", Ray{Vec3{-10, 0.7, -0.3}, Vec3{1, 0, 0}}, true, 7.7043519},
+	}, func(r Ray) (float32, bool) { return r.IntersectTorus(Vec3{}, Vec3{0, 0, 1}, 2, 0.5) })
+}
@@ -0,0 +1,91 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// RayPacket is a set of rays stored as a structure of arrays: ray i has the
+// origin {OX[i], OY[i], OZ[i]} and the direction {DX[i], DY[i], DZ[i]}. This
+// layout lets batch code process one coordinate of many rays at a time.
+type RayPacket struct {
+	OX, OY, OZ []float32
+	DX, DY, DZ []float32
+}
+
+// Resize sets the number of rays in the packet to n, reallocating the arrays
+// only if they're too small. Like VecN.Resize, it can be called on a nil
+// packet, and returns a new one in that case. The contents of the arrays
+// are not preserved when reallocating.
+func (p *RayPacket) Resize(n int) *RayPacket {
+	if p == nil {
+		p = &RayPacket{}
+	}
+	if n > cap(p.OX) {
+		p.OX, p.OY, p.OZ = make([]float32, n), make([]float32, n), make([]float32, n)
+		p.DX, p.DY, p.DZ = make([]float32, n), make([]float32, n), make([]float32, n)
+		return p
+	}
+	p.OX, p.OY, p.OZ = p.OX[:n], p.OY[:n], p.OZ[:n]
+	p.DX, p.DY, p.DZ = p.DX[:n], p.DY[:n], p.DZ[:n]
+	return p
+}
+
+// Len returns the number of rays in the packet.
+func (p *RayPacket) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.OX)
+}
+
+// Ray returns ray i of the packet.
+func (p *RayPacket) Ray(i int) Ray {
+	return Ray{Vec3{p.OX[i], p.OY[i], p.OZ[i]}, Vec3{p.DX[i], p.DY[i], p.DZ[i]}}
+}
+
+// Set sets ray i of the packet to r.
+func (p *RayPacket) Set(i int, r Ray) {
+	p.OX[i], p.OY[i], p.OZ[i] = r.Origin[0], r.Origin[1], r.Origin[2]
+	p.DX[i], p.DY[i], p.DZ[i] = r.Dir[0], r.Dir[1], r.Dir[2]
+}
+
+// EyeRays stores in dst the eye rays through the centers of the pixels of the
+// tile with the lower left corner (x, y) and size w*h of a viewport of the
+// given size, in world space, reallocating as necessary. Pixels are in window
+// coordinates like those of Project, relative to the viewport, and rays are
+// in row-major order: the ray through pixel (x+i, y+j) is ray j*w+i. Pass a
+// tile covering the whole viewport to generate a ray per pixel.
+//
+// The rays are unprojected through invViewProj, the inverse of the
+// projection times the view matrix. They start on the near plane and their
+// directions, towards the far plane, are normalized, so their distances are
+// in world units. Both perspective and orthographic projections work.
+func EyeRays(dst *RayPacket, invViewProj Mat4, x, y, w, h, width, height int) *RayPacket {
+	dst = dst.Resize(w * h)
+
+	// Clip coordinates are linear in the pixel position, so the unprojected
+	// points only need a division by w for each pixel.
+	sx, sy := 2/float32(width), 2/float32(height)
+	ndcX, ndcY := (float32(x)+0.5)*sx-1, (float32(y)+0.5)*sy-1
+	near := invViewProj.Mul4x1(Vec4{ndcX, ndcY, -1, 1})
+	far := invViewProj.Mul4x1(Vec4{ndcX, ndcY, 1, 1})
+	dx := invViewProj.Col(0).Mul(sx)
+	dy := invViewProj.Col(1).Mul(sy)
+
+	for j := 0; j < h; j++ {
+		rowNear, rowFar := near.Add(dy.Mul(float32(j))), far.Add(dy.Mul(float32(j)))
+		for i := 0; i < w; i++ {
+			n, f := rowNear.Add(dx.Mul(float32(i))), rowFar.Add(dx.Mul(float32(i)))
+			o := n.Vec3().Mul(1 / n[3])
+			d := f.Vec3().Mul(1 / f[3]).Sub(o)
+			inv := float32(1 / math.Sqrt(float64(d.Dot(d))))
+
+			k := j*w + i
+			dst.OX[k], dst.OY[k], dst.OZ[k] = o[0], o[1], o[2]
+			dst.DX[k], dst.DY[k], dst.DZ[k] = d[0]*inv, d[1]*inv, d[2]*inv
+		}
+	}
+	return dst
+}
@@ -0,0 +1,77 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRayPacket(t *testing.T) {
+	t.Parallel()
+
+	var p *RayPacket
+	if p.Len() != 0 {
+		t.Errorf("nil packet has %d rays", p.Len())
+	}
+	p = p.Resize(3)
+	r := Ray{Vec3{1, 2, 3}, Vec3{4, 5, 6}}
+	p.Set(2, r)
+	if p.Len() != 3 || p.Ray(2) != r || p.OY[2] != 2 || p.DZ[2] != 6 {
+		t.Errorf("Set(2, %v) gives packet %+v", r, p)
+	}
+
+	ox := p.OX
+	if p = p.Resize(2); p.Len() != 2 || &p.OX[0] != &ox[0] {
+		t.Errorf("shrinking Resize reallocated the packet")
+	}
+}
+
+func TestEyeRays(t *testing.T) {
+	t.Parallel()
+
+	const width, height = 16, 8
+	eye := Vec3{1, 2, 5}
+	view := LookAtV(eye, Vec3{0, 0, 0}, Vec3{0, 1, 0})
+	approx := absEqual(1e-4)
+
+	for _, proj := range []Mat4{
+		Perspective(math.Pi/3, 2, 0.5, 50),
+		Ortho(-4, 4, -2, 2, 0.5, 50),
+	} {
+		inv := proj.Mul4(view).Inv()
+
+		// A tile, checked against UnProject at each pixel center.
+		x, y, w, h := 3, 2, 5, 4
+		p := EyeRays(nil, inv, x, y, w, h, width, height)
+		if p.Len() != w*h {
+			t.Fatalf("EyeRays returned %d rays, want %d", p.Len(), w*h)
+		}
+		for j := 0; j < h; j++ {
+			for i := 0; i < w; i++ {
+				win := Vec2{float32(x+i) + 0.5, float32(y+j) + 0.5}
+				near, _ := UnProject(win.Vec3(0), view, proj, 0, 0, width, height)
+				far, _ := UnProject(win.Vec3(1), view, proj, 0, 0, width, height)
+				r := p.Ray(j*w + i)
+				if !r.Origin.ApproxFuncEqual(near, approx) || !r.Dir.ApproxFuncEqual(far.Sub(near).Normalize(), approx) {
+					t.Errorf("ray through pixel %v = %v, want origin %v towards %v", win, r, near, far)
+				}
+				if !FloatEqualThreshold(r.Dir.Len(), 1, 1e-5) {
+					t.Errorf("ray through pixel %v isn't normalized: %v", win, r.Dir)
+				}
+			}
+		}
+
+		// The rays of the whole viewport project back to their pixels.
+		p = EyeRays(p, inv, 0, 0, width, height, width, height)
+		for k := 0; k < p.Len(); k++ {
+			win := Project(p.Ray(k).At(10), view, proj, 0, 0, width, height)
+			want := Vec2{float32(k%width) + 0.5, float32(k/width) + 0.5}
+			if !win.Vec2().ApproxFuncEqual(want, absEqual(1e-3)) {
+				t.Errorf("ray %d projects to %v, want %v", k, win.Vec2(), want)
+			}
+		}
+	}
+}
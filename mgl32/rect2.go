@@ -0,0 +1,36 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Rect2 is an axis-aligned rectangle described by its minimum and maximum
+// corners. A rectangle where any element of Min is bigger than the
+// corresponding element of Max is considered empty.
+type Rect2 struct {
+	Min, Max Vec2
+}
+
+// Rect2FromPoints returns the smallest Rect2 containing all of the given points.
+// If no points are given, the result is the zero Rect2.
+func Rect2FromPoints(points ...Vec2) Rect2 {
+	if len(points) == 0 {
+		return Rect2{}
+	}
+
+	r := Rect2{points[0], points[0]}
+	for _, p := range points[1:] {
+		for i := range p {
+			SetMin(&r.Min[i], &p[i])
+			SetMax(&r.Max[i], &p[i])
+		}
+	}
+
+	return r
+}
+
+// Empty returns whether the rectangle is empty, that is, whether its minimum
+// is bigger than its maximum along either axis.
+func (r Rect2) Empty() bool {
+	return r.Min[0] > r.Max[0] || r.Min[1] > r.Max[1]
+}
@@ -0,0 +1,50 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// A rotation vector is a rotation's axis scaled by its angle in radians, the
+// usual minimal parametrization of orientation errors and increments in
+// sensor fusion: a gyroscope sample times the sampling interval is one.
+
+// QuatFromRotationVector returns the rotation by |v| radians about the axis v,
+// exp(v/2). Unlike QuatRotate, it needs no normalized axis and stays exact as
+// |v| goes to 0, where it is the identity.
+func QuatFromRotationVector(v Vec3) Quat {
+	return QuatExp(v.Mul(0.5))
+}
+
+// QuatFromSmallRotationVector returns the first order approximation of
+// QuatFromRotationVector, the normalized {1, v/2}. It avoids trigonometry, and
+// its angle errs by about |v|^3/12 radians, so it is only a good approximation for the
+// small increments of high rate sensors.
+func QuatFromSmallRotationVector(v Vec3) Quat {
+	return Quat{1, v.Mul(0.5)}.Normalize()
+}
+
+// ToRotationVector returns the rotation vector of the quaternion, the inverse
+// of QuatFromRotationVector, for the shorter one of the two ways around: its
+// length is in [0, Pi]. The quaternion is normalized first.
+func (q1 Quat) ToRotationVector() Vec3 {
+	q := q1.Normalize()
+	if q.W < 0 {
+		q = q.Scale(-1)
+	}
+	return q.Log().Mul(2)
+}
+
+// BoxPlus applies the error or increment delta, a rotation vector in the local
+// frame of q, to the orientation q: q * QuatFromRotationVector(delta). This is
+// the "⊞" of error-state filters, which estimate delta instead of the
+// quaternion, so the estimate always stays a rotation.
+func (q1 Quat) BoxPlus(delta Vec3) Quat {
+	return q1.Mul(QuatFromRotationVector(delta)).Normalize()
+}
+
+// BoxMinus returns the rotation vector in the local frame of ref that takes
+// ref to q1, the inverse of BoxPlus ("⊟"): ref.BoxPlus(q1.BoxMinus(ref)) is q1
+// (up to sign). This is the error between an estimate and a reference.
+func (q1 Quat) BoxMinus(ref Quat) Vec3 {
+	return ref.Conjugate().Mul(q1).ToRotationVector()
+}
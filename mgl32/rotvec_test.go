@@ -0,0 +1,84 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRotationVector(t *testing.T) {
+	t.Parallel()
+
+	approx := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+	tests := []Vec3{
+		{0, 0, 0},
+		{1e-6, 0, -2e-6},
+		{0.3, -0.2, 0.1},
+		{0, math.Pi / 2, 0},
+		{-2, 1, 1.5},
+	}
+
+	for _, v := range tests {
+		q := QuatFromRotationVector(v)
+		if l := v.Len(); l > 0 {
+			if want := QuatRotate(l, v.Mul(1/l)); !q.OrientationEqualThreshold(want, 1e-6) {
+				t.Errorf("QuatFromRotationVector(%v) = %v, want %v", v, q, want)
+			}
+		} else if q != QuatIdent() {
+			t.Errorf("QuatFromRotationVector(0) = %v, want the identity", q)
+		}
+		if r := q.ToRotationVector(); !r.ApproxFuncEqual(v, approx) {
+			t.Errorf("QuatFromRotationVector(%v).ToRotationVector() = %v", v, r)
+		}
+		if r := q.Scale(-1).ToRotationVector(); !r.ApproxFuncEqual(v, approx) {
+			t.Errorf("-QuatFromRotationVector(%v).ToRotationVector() = %v", v, r)
+		}
+	}
+
+	// More than half a turn comes back the short way.
+	r := QuatRotate(3*math.Pi/2, Vec3{0, 0, 1}).ToRotationVector()
+	if !r.ApproxFuncEqual(Vec3{0, 0, -math.Pi / 2}, approx) {
+		t.Errorf("ToRotationVector of 3Pi/2 about z = %v, want {0, 0, -Pi/2}", r)
+	}
+}
+
+func TestQuatFromSmallRotationVector(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []Vec3{{1e-3, 0, 0}, {0.01, -0.02, 0.005}} {
+		approx, exact := QuatFromSmallRotationVector(v), QuatFromRotationVector(v)
+		l := float64(v.Len())
+		if d := approx.BoxMinus(exact).Len(); float64(d) > l*l*l/12*1.1+1e-7 {
+			t.Errorf("QuatFromSmallRotationVector(%v) errs by %v", v, d)
+		}
+	}
+}
+
+func TestBoxPlusMinus(t *testing.T) {
+	t.Parallel()
+
+	approx := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+	q := QuatRotate(1.2, Vec3{1, 2, -1}.Normalize())
+	delta := Vec3{0.05, -0.1, 0.2}
+
+	p := q.BoxPlus(delta)
+	if want := q.Mul(QuatFromRotationVector(delta)); !p.OrientationEqualThreshold(want, 1e-6) {
+		t.Errorf("BoxPlus = %v, want %v", p, want)
+	}
+	if d := p.BoxMinus(q); !d.ApproxFuncEqual(delta, approx) {
+		t.Errorf("(q ⊞ delta) ⊟ q = %v, want %v", d, delta)
+	}
+	if r := q.BoxPlus(p.BoxMinus(q)); !r.OrientationEqualThreshold(p, 1e-6) {
+		t.Errorf("q ⊞ (p ⊟ q) = %v, want %v", r, p)
+	}
+
+	// delta is in the local frame: rotating the local x axis about itself
+	// leaves its world direction alone.
+	x := q.Rotate(Vec3{1, 0, 0})
+	if r := q.BoxPlus(Vec3{0.3, 0, 0}).Rotate(Vec3{1, 0, 0}); !r.ApproxFuncEqual(x, approx) {
+		t.Errorf("BoxPlus about the local x axis moved it from %v to %v", x, r)
+	}
+}
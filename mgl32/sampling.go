@@ -0,0 +1,113 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// The sampling functions in this file take their random numbers as a
+// parameter u, with both components in [0,1), so that the caller chooses the
+// source: math/rand, a low discrepancy sequence such as Hammersley, or the
+// same sequence as a shader. Directions and normals are unit vectors in world
+// space.
+
+// SampleGGX samples a microfacet normal (half vector) around normal from the
+// GGX (Trowbridge-Reitz) distribution, proportionally to D(h)*dot(normal, h).
+// Roughness is the perceptual roughness; the distribution uses
+// alpha = roughness^2, as in most real-time renderers.
+//
+// The light direction for a view direction v (pointing away from the surface)
+// is the reflection of v about the returned half vector h:
+// l = h.Mul(2*v.Dot(h)).Sub(v).
+func SampleGGX(normal Vec3, roughness float32, u Vec2) Vec3 {
+	a := float64(roughness) * float64(roughness)
+	u0 := float64(u[0])
+	cosTheta := math.Sqrt((1 - u0) / (1 + (a*a-1)*u0))
+	sinTheta := math.Sqrt(1 - cosTheta*cosTheta)
+	sinPhi, cosPhi := math.Sincos(2 * math.Pi * float64(u[1]))
+	return tangentToWorld(normal, Vec3{float32(sinTheta * cosPhi), float32(sinTheta * sinPhi), float32(cosTheta)})
+}
+
+// GGXDistribution evaluates the GGX normal distribution function D(h) for the
+// given half vector and perceptual roughness.
+func GGXDistribution(normal, h Vec3, roughness float32) float32 {
+	cosTheta := float64(normal.Dot(h))
+	if cosTheta <= 0 {
+		return 0
+	}
+	a2 := math.Pow(float64(roughness), 4)
+	d := cosTheta*cosTheta*(a2-1) + 1
+	return float32(a2 / (math.Pi * d * d))
+}
+
+// GGXPDF returns the probability density, with respect to solid angle, of
+// SampleGGX returning the half vector h.
+func GGXPDF(normal, h Vec3, roughness float32) float32 {
+	return GGXDistribution(normal, h, roughness) * Clamp(normal.Dot(h), 0, 1)
+}
+
+// GGXReflectionPDF returns the probability density, with respect to solid
+// angle, of sampling the light direction l by reflecting the view direction v
+// about a half vector from SampleGGX. This is the density to use when
+// weighting the sample in a Monte Carlo estimate.
+func GGXReflectionPDF(normal, v, l Vec3, roughness float32) float32 {
+	h := v.Add(l)
+	if h.Len() == 0 {
+		return 0
+	}
+	h = h.Normalize()
+	vh := v.Dot(h)
+	if vh <= 0 {
+		return 0
+	}
+	return GGXPDF(normal, h, roughness) / (4 * vh)
+}
+
+// SampleCosineHemisphere samples a direction in the hemisphere around normal
+// with a density proportional to the cosine of its angle to the normal, which
+// is the ideal distribution for Lambertian (diffuse) surfaces.
+func SampleCosineHemisphere(normal Vec3, u Vec2) Vec3 {
+	r := math.Sqrt(float64(u[0]))
+	sinPhi, cosPhi := math.Sincos(2 * math.Pi * float64(u[1]))
+	z := math.Sqrt(math.Max(0, 1-float64(u[0])))
+	return tangentToWorld(normal, Vec3{float32(r * cosPhi), float32(r * sinPhi), float32(z)})
+}
+
+// CosineHemispherePDF returns the probability density, with respect to solid
+// angle, of SampleCosineHemisphere returning dir: cos(theta)/pi.
+func CosineHemispherePDF(normal, dir Vec3) float32 {
+	return Clamp(normal.Dot(dir), 0, 1) / math.Pi
+}
+
+// SampleUniformHemisphere samples a direction uniformly in the hemisphere
+// around normal.
+func SampleUniformHemisphere(normal Vec3, u Vec2) Vec3 {
+	z := float64(u[0])
+	r := math.Sqrt(math.Max(0, 1-z*z))
+	sinPhi, cosPhi := math.Sincos(2 * math.Pi * float64(u[1]))
+	return tangentToWorld(normal, Vec3{float32(r * cosPhi), float32(r * sinPhi), float32(z)})
+}
+
+// UniformHemispherePDF returns the probability density, with respect to solid
+// angle, of SampleUniformHemisphere returning any direction: 1/(2*pi).
+func UniformHemispherePDF() float32 {
+	return 1 / (2 * math.Pi)
+}
+
+// tangentToWorld transforms v from a tangent space where normal is the Z
+// axis to world space, using the orthonormal basis of Duff et al., "Building
+// an Orthonormal Basis, Revisited" (2017).
+func tangentToWorld(normal, v Vec3) Vec3 {
+	sign := float32(1)
+	if normal[2] < 0 {
+		sign = -1
+	}
+	a := -1 / (sign + normal[2])
+	b := normal[0] * normal[1] * a
+	t := Vec3{1 + sign*normal[0]*normal[0]*a, sign * b, -sign * normal[0]}
+	bt := Vec3{b, sign + normal[1]*normal[1]*a, -normal[1]}
+	return t.Mul(v[0]).Add(bt.Mul(v[1])).Add(normal.Mul(v[2]))
+}
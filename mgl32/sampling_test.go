@@ -0,0 +1,111 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var samplingNormals = []Vec3{
+	{0, 0, 1},
+	{0, 0, -1},
+	{1, 0, 0},
+	Vec3{1, -2, 0.3}.Normalize(),
+}
+
+func TestTangentToWorld(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range samplingNormals {
+		x, y, z := tangentToWorld(n, Vec3{1, 0, 0}), tangentToWorld(n, Vec3{0, 1, 0}), tangentToWorld(n, Vec3{0, 0, 1})
+		m := Mat3FromCols(x, y, z)
+		if mtm := m.Transpose().Mul3(m); !mtm.ApproxFuncEqual(Ident3(), func(a, b float32) bool { return Abs(a-b) < 1e-5 }) {
+			t.Errorf("Tangent basis for %v is not orthonormal: %v", n, m)
+		}
+		if !z.ApproxEqualThreshold(n, 1e-5) || Abs(m.Det()-1) > 1e-5 {
+			t.Errorf("Tangent basis for %v is not right handed around the normal: %v", n, m)
+		}
+	}
+}
+
+func TestSampleHemisphere(t *testing.T) {
+	t.Parallel()
+
+	const n = 20000
+	r := rand.New(rand.NewSource(1))
+	for _, normal := range samplingNormals {
+		var cosineMean, uniformMean, ggxIntegral float32
+		for i := 0; i < n; i++ {
+			u := Vec2{r.Float32(), r.Float32()}
+
+			d := SampleCosineHemisphere(normal, u)
+			if Abs(d.Len()-1) > 1e-4 || d.Dot(normal) < -1e-6 {
+				t.Fatalf("SampleCosineHemisphere returned %v for normal %v", d, normal)
+			}
+			cosineMean += d.Dot(normal) / n
+
+			d = SampleUniformHemisphere(normal, u)
+			if Abs(d.Len()-1) > 1e-4 || d.Dot(normal) < -1e-6 {
+				t.Fatalf("SampleUniformHemisphere returned %v for normal %v", d, normal)
+			}
+			uniformMean += d.Dot(normal) / n
+
+			// Uniform samples estimate the integral of the GGX pdf, which
+			// must be 1.
+			ggxIntegral += GGXPDF(normal, d, 0.7) / UniformHemispherePDF() / n
+
+			h := SampleGGX(normal, 0.3, u)
+			if Abs(h.Len()-1) > 1e-4 || h.Dot(normal) < -1e-6 {
+				t.Fatalf("SampleGGX returned %v for normal %v", h, normal)
+			}
+		}
+
+		// The mean cosine is 2/3 for cosine weighted samples and 1/2 for
+		// uniform ones.
+		if Abs(cosineMean-2./3) > 0.01 {
+			t.Errorf("Mean cosine of cosine weighted samples is %v, expected 2/3", cosineMean)
+		}
+		if Abs(uniformMean-0.5) > 0.01 {
+			t.Errorf("Mean cosine of uniform samples is %v, expected 1/2", uniformMean)
+		}
+		if Abs(ggxIntegral-1) > 0.05 {
+			t.Errorf("GGXPDF integrates to %v, expected 1", ggxIntegral)
+		}
+	}
+}
+
+func TestGGXSamplesMatchPDF(t *testing.T) {
+	t.Parallel()
+
+	// Estimate the integral of f = D(h)*cos(theta)^2 over the hemisphere
+	// both with uniform samples and with samples importance sampled by
+	// SampleGGX. The estimates only agree if SampleGGX follows GGXPDF.
+	const n = 50000
+	const roughness = 0.7
+	r := rand.New(rand.NewSource(2))
+	normal := Vec3{0, 0, 1}
+	f := func(h Vec3) float32 {
+		cos := h.Dot(normal)
+		return GGXDistribution(normal, h, roughness) * cos * cos
+	}
+	var uniform, importance float32
+	for i := 0; i < n; i++ {
+		u := Vec2{r.Float32(), r.Float32()}
+		d := SampleUniformHemisphere(normal, u)
+		uniform += f(d) / UniformHemispherePDF() / n
+		h := SampleGGX(normal, roughness, u)
+		importance += f(h) / GGXPDF(normal, h, roughness) / n
+	}
+	if Abs(uniform-importance) > 0.02 {
+		t.Errorf("SampleGGX does not follow GGXPDF: %v != %v", importance, uniform)
+	}
+
+	v := Vec3{0.6, 0, 0.8}
+	l := Vec3{-0.6, 0, 0.8}
+	if got, want := GGXReflectionPDF(normal, v, l, 0.5), GGXPDF(normal, normal, 0.5)/(4*0.8); Abs(got-want) > 1e-4 {
+		t.Errorf("GGXReflectionPDF = %v, expected %v", got, want)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// ProjectSphereBounds computes the window-space rectangle covered by the
+// sphere with the given center (in world space) and radius, as seen through
+// the given view and projection matrices. Window coordinates are the same as
+// for Project.
+//
+// For perspective projections this uses the exact tangent-plane bounds of
+// Mara and McGuire's "2D Polyhedral Bounds of a Clipped, Perspective-Projected
+// 3D Sphere", so spheres crossing the near plane are clipped correctly instead
+// of blowing up. The near plane is recovered from the projection matrix, which
+// is assumed to be of the form produced by Perspective or Frustum. Any projection
+// with a bottom row of (0,0,0,1) is treated as orthographic.
+//
+// The result is clamped to the viewport. If the sphere is not visible at all
+// (entirely behind the near plane, or off screen), visible will be false.
+func ProjectSphereBounds(center Vec3, radius float32, view, projection Mat4, initialX, initialY, width, height int) (bounds Rect2, visible bool) {
+	c := view.Mul4x1(center.Vec4(1)).Vec3()
+
+	var ndc Rect2
+	if projection[3] == 0 && projection[7] == 0 && projection[11] == 0 && projection[15] == 1 {
+		lo := projection.Mul4x1(c.Sub(Vec3{radius, radius, 0}).Vec4(1))
+		hi := projection.Mul4x1(c.Add(Vec3{radius, radius, 0}).Vec4(1))
+		ndc = Rect2FromPoints(lo.Vec2(), hi.Vec2())
+	} else {
+		nearZ := -projection[14] / (projection[10] - 1)
+		if c[2]-radius > nearZ {
+			return Rect2{}, false
+		}
+
+		left, right := sphereBoundsForAxis(0, c, radius, nearZ)
+		bottom, top := sphereBoundsForAxis(1, c, radius, nearZ)
+
+		ndc = Rect2FromPoints(
+			Vec2{projectToNDC(projection, left)[0], projectToNDC(projection, bottom)[1]},
+			Vec2{projectToNDC(projection, right)[0], projectToNDC(projection, top)[1]},
+		)
+	}
+
+	return ndcToWindowRect(ndc, initialX, initialY, width, height)
+}
+
+// ProjectAABBBounds computes the window-space rectangle covered by the given
+// world-space box, as seen through the given view and projection matrices.
+// Window coordinates are the same as for Project.
+//
+// The box is clipped against the near plane before being projected, so boxes
+// that cross it (e.g. because the camera is inside of them) still produce a
+// correct, conservative rectangle.
+//
+// The result is clamped to the viewport. If the box is not visible at all
+// (entirely behind the near plane, or off screen) visible will be false.
+func ProjectAABBBounds(box AABB, view, projection Mat4, initialX, initialY, width, height int) (bounds Rect2, visible bool) {
+	mvp := projection.Mul4(view)
+
+	var clip [8]Vec4
+	for i := range clip {
+		corner := box.Min
+		for axis := 0; axis < 3; axis++ {
+			if i&(1<<uint(axis)) != 0 {
+				corner[axis] = box.Max[axis]
+			}
+		}
+		clip[i] = mvp.Mul4x1(corner.Vec4(1))
+	}
+
+	// The near plane in clip space is z = -w, so a point is in front of it
+	// whenever z + w >= 0.
+	points := make([]Vec2, 0, 20)
+	for i := range clip {
+		if clip[i][2]+clip[i][3] >= 0 {
+			points = append(points, clip[i].Vec2().Mul(1/clip[i][3]))
+		}
+
+		// Edges go from each corner to the corners that differ in exactly
+		// one (higher) bit.
+		for axis := uint(0); axis < 3; axis++ {
+			j := i | 1<<axis
+			if j == i {
+				continue
+			}
+
+			di, dj := clip[i][2]+clip[i][3], clip[j][2]+clip[j][3]
+			if (di < 0) != (dj < 0) {
+				p := clip[i].Add(clip[j].Sub(clip[i]).Mul(di / (di - dj)))
+				points = append(points, p.Vec2().Mul(1/p[3]))
+			}
+		}
+	}
+
+	if len(points) == 0 {
+		return Rect2{}, false
+	}
+
+	return ndcToWindowRect(Rect2FromPoints(points...), initialX, initialY, width, height)
+}
+
+// sphereBoundsForAxis returns the two view-space points where the planes
+// through the eye that are tangent to the sphere touch it along the given axis
+// (0 for x, 1 for y). If the tangent points are behind the near plane, the
+// points where the sphere intersects the near plane are used instead.
+func sphereBoundsForAxis(axis int, c Vec3, r, nearZ float32) (lo, hi Vec3) {
+	trivialAccept := c[2]+r < nearZ
+	center := Vec2{c[axis], c[2]}
+
+	tSquared := center.Dot(center) - r*r
+	var cosTheta, sinTheta float32
+	if tSquared > 0 {
+		cLength := center.Len()
+		cosTheta = float32(math.Sqrt(float64(tSquared))) / cLength
+		sinTheta = r / cLength
+	}
+
+	var sqrtPart float32
+	if !trivialAccept {
+		sqrtPart = float32(math.Sqrt(float64(r*r - (nearZ-c[2])*(nearZ-c[2]))))
+	}
+
+	var bounds [2]Vec2
+	for i := range bounds {
+		if tSquared > 0 {
+			bounds[i] = Vec2{
+				cosTheta*center[0] + sinTheta*center[1],
+				-sinTheta*center[0] + cosTheta*center[1],
+			}.Mul(cosTheta)
+		}
+		if !trivialAccept && (tSquared <= 0 || bounds[i][1] > nearZ) {
+			bounds[i] = Vec2{center[0] + sqrtPart, nearZ}
+		}
+
+		sinTheta, sqrtPart = -sinTheta, -sqrtPart
+	}
+
+	lo[axis], lo[2] = bounds[0][0], bounds[0][1]
+	hi[axis], hi[2] = bounds[1][0], bounds[1][1]
+
+	return lo, hi
+}
+
+func projectToNDC(projection Mat4, p Vec3) Vec3 {
+	clip := projection.Mul4x1(p.Vec4(1))
+	return clip.Vec3().Mul(1 / clip[3])
+}
+
+// ndcToWindowRect maps a rectangle in normalized device coordinates to window
+// coordinates, clamped to the viewport.
+func ndcToWindowRect(ndc Rect2, initialX, initialY, width, height int) (Rect2, bool) {
+	if ndc.Max[0] < -1 || ndc.Min[0] > 1 || ndc.Max[1] < -1 || ndc.Min[1] > 1 {
+		return Rect2{}, false
+	}
+
+	x0, y0 := float32(initialX), float32(initialY)
+	w, h := float32(width), float32(height)
+
+	return Rect2{
+		Vec2{x0 + w*(Clamp(ndc.Min[0], -1, 1)+1)/2, y0 + h*(Clamp(ndc.Min[1], -1, 1)+1)/2},
+		Vec2{x0 + w*(Clamp(ndc.Max[0], -1, 1)+1)/2, y0 + h*(Clamp(ndc.Max[1], -1, 1)+1)/2},
+	}, true
+}
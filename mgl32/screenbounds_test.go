@@ -0,0 +1,95 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProjectSphereBounds(t *testing.T) {
+	t.Parallel()
+
+	view := LookAtV(Vec3{0, 2, 10}, Vec3{0, 0, 0}, Vec3{0, 1, 0})
+	proj := Perspective(DegToRad(60), 4.0/3.0, 0.1, 100)
+
+	tests := []struct {
+		Center Vec3
+		Radius float32
+	}{
+		{Vec3{0, 0, 0}, 1},
+		{Vec3{3, -1, -4}, 0.5},
+		{Vec3{-2, 1, 5}, 2},
+	}
+
+	for _, test := range tests {
+		bounds, visible := ProjectSphereBounds(test.Center, test.Radius, view, proj, 0, 0, 800, 600)
+		if !visible {
+			t.Errorf("Sphere %v should be visible", test)
+			continue
+		}
+
+		// Brute force the projection of points on the silhouette and
+		// check that they're contained in, and touch, the bounds.
+		var samples []Vec2
+		for i := 0; i < 64; i++ {
+			for j := 0; j <= 32; j++ {
+				p := SphericalToCartesian(test.Radius, float32(j)*math.Pi/32, float32(i)*2*math.Pi/64)
+				samples = append(samples, Project(test.Center.Add(p), view, proj, 0, 0, 800, 600).Vec2())
+			}
+		}
+		expected := Rect2FromPoints(samples...)
+		for i := 0; i < 2; i++ {
+			expected.Min[i] = Clamp(expected.Min[i], 0, [2]float32{800, 600}[i])
+			expected.Max[i] = Clamp(expected.Max[i], 0, [2]float32{800, 600}[i])
+		}
+
+		if !bounds.Min.ApproxEqualThreshold(expected.Min, 1e-2) || !bounds.Max.ApproxEqualThreshold(expected.Max, 1e-2) {
+			t.Errorf("ProjectSphereBounds(%v) = %v, expected roughly %v", test, bounds, expected)
+		}
+	}
+}
+
+func TestProjectSphereBoundsClipped(t *testing.T) {
+	t.Parallel()
+
+	proj := Perspective(DegToRad(90), 1, 1, 100)
+
+	if _, visible := ProjectSphereBounds(Vec3{0, 0, 5}, 1, Ident4(), proj, 0, 0, 100, 100); visible {
+		t.Errorf("Sphere behind the camera should not be visible")
+	}
+
+	// The camera is inside of the sphere, so it should cover the screen.
+	bounds, visible := ProjectSphereBounds(Vec3{0, 0, -0.5}, 2, Ident4(), proj, 0, 0, 100, 100)
+	if !visible || bounds != (Rect2{Vec2{0, 0}, Vec2{100, 100}}) {
+		t.Errorf("Sphere around the camera gives bounds %v (visible: %v), expected the full viewport", bounds, visible)
+	}
+}
+
+func TestProjectAABBBounds(t *testing.T) {
+	t.Parallel()
+
+	view := LookAtV(Vec3{0, 0, 10}, Vec3{0, 0, 0}, Vec3{0, 1, 0})
+	proj := Perspective(DegToRad(90), 1, 1, 100)
+
+	box := AABB{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+	bounds, visible := ProjectAABBBounds(box, view, proj, 0, 0, 100, 100)
+	// The closest face is at distance 9, and it projects to [-1/9,1/9] in NDC.
+	expected := Rect2{Vec2{50 - 50.0/9, 50 - 50.0/9}, Vec2{50 + 50.0/9, 50 + 50.0/9}}
+	if !visible || !bounds.Min.ApproxEqualThreshold(expected.Min, 1e-4) || !bounds.Max.ApproxEqualThreshold(expected.Max, 1e-4) {
+		t.Errorf("ProjectAABBBounds = %v (visible: %v), expected %v", bounds, visible, expected)
+	}
+
+	inside := AABB{Vec3{-5, -5, 5}, Vec3{5, 5, 15}}
+	bounds, visible = ProjectAABBBounds(inside, view, proj, 0, 0, 100, 100)
+	if !visible || bounds != (Rect2{Vec2{0, 0}, Vec2{100, 100}}) {
+		t.Errorf("Box around the camera gives bounds %v (visible: %v), expected the full viewport", bounds, visible)
+	}
+
+	behind := AABB{Vec3{-1, -1, 12}, Vec3{1, 1, 14}}
+	if _, visible = ProjectAABBBounds(behind, view, proj, 0, 0, 100, 100); visible {
+		t.Errorf("Box behind the camera should not be visible")
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Segment3 is the line segment between the points A and B.
+type Segment3 struct {
+	A, B Vec3
+}
+
+// Len returns the length of the segment.
+func (s Segment3) Len() float32 {
+	return s.B.Sub(s.A).Len()
+}
+
+// At returns the point A + t*(B-A); t = 0 is A and t = 1 is B.
+func (s Segment3) At(t float32) Vec3 {
+	return s.A.Add(s.B.Sub(s.A).Mul(t))
+}
+
+// ClosestPoint returns the point on the segment closest to p, along with its
+// parameter as for At. See ClosestPointSegment.
+func (s Segment3) ClosestPoint(p Vec3) (c Vec3, t float32) {
+	return ClosestPointSegment(p, s.A, s.B)
+}
@@ -0,0 +1,24 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestSegment3(t *testing.T) {
+	t.Parallel()
+
+	s := Segment3{Vec3{1, 0, 0}, Vec3{1, 4, 3}}
+	if l := s.Len(); !FloatEqualThreshold(l, 5, 1e-6) {
+		t.Errorf("Segment length = %v, expected 5", l)
+	}
+	if p := s.At(0.5); !p.ApproxEqualThreshold(Vec3{1, 2, 1.5}, 1e-6) {
+		t.Errorf("Segment midpoint = %v, expected %v", p, Vec3{1, 2, 1.5})
+	}
+	if c, tc := s.ClosestPoint(Vec3{5, 8, 6}); c != s.B || tc != 1 {
+		t.Errorf("Closest point = %v (t = %v), expected the end point %v", c, tc, s.B)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestVecValues(t *testing.T) {
+	t.Parallel()
+
+	var got []float32
+	for x := range (Vec3{1, 2, 3}).Values() {
+		got = append(got, x)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("Vec3.Values yielded %v, expected [1 2 3]", got)
+	}
+
+	// Stopping early
+	n := 0
+	for range (Vec4{1, 2, 3, 4}).Values() {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("Breaking out of Vec4.Values after 2 elements visited %d", n)
+	}
+}
+
+func TestMatElements(t *testing.T) {
+	t.Parallel()
+
+	m := Mat2x3FromRows(
+		Vec3{1, 2, 3},
+		Vec3{4, 5, 6},
+	)
+
+	count := 0
+	for idx, x := range m.Elements() {
+		if want := m.At(idx[0], idx[1]); x != want {
+			t.Errorf("Element %v = %v, expected %v", idx, x, want)
+		}
+		count++
+	}
+	if count != 6 {
+		t.Errorf("Mat2x3.Elements yielded %d elements, expected 6", count)
+	}
+
+	var sum float32
+	for _, x := range Ident4().Elements() {
+		sum += x
+	}
+	if sum != 4 {
+		t.Errorf("Sum of the elements of Ident4 is %v, expected 4", sum)
+	}
+}
+
+func TestDynamicElements(t *testing.T) {
+	t.Parallel()
+
+	vn := NewVecNFromData([]float32{3, 1, 4, 1, 5})
+	var got []float32
+	for x := range vn.Values() {
+		got = append(got, x)
+	}
+	if len(got) != 5 || got[2] != 4 {
+		t.Errorf("VecN.Values yielded %v, expected [3 1 4 1 5]", got)
+	}
+
+	mat := NewMatrixFromData([]float32{1, 2, 3, 4, 5, 6}, 3, 2)
+	count := 0
+	for idx, x := range mat.Elements() {
+		if want := mat.At(idx[0], idx[1]); x != want {
+			t.Errorf("Element %v = %v, expected %v", idx, x, want)
+		}
+		count++
+	}
+	if count != 6 {
+		t.Errorf("MatMxN.Elements yielded %d elements, expected 6", count)
+	}
+
+	for range (*VecN)(nil).Values() {
+		t.Errorf("A nil VecN yielded an element")
+	}
+	for range (*MatMxN)(nil).Elements() {
+		t.Errorf("A nil MatMxN yielded an element")
+	}
+}
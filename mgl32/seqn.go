@@ -0,0 +1,43 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package mgl32
+
+import (
+	"iter"
+)
+
+// Values returns an iterator over the elements of the vector, in order. A nil
+// vector has no elements.
+func (vn *VecN) Values() iter.Seq[float32] {
+	return func(yield func(float32) bool) {
+		if vn == nil {
+			return
+		}
+		for _, x := range vn.vec {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Elements returns an iterator over the elements of the matrix, along with
+// their [row, column] index, in storage (column major) order. A nil matrix has
+// no elements.
+func (mat *MatMxN) Elements() iter.Seq2[[2]int, float32] {
+	return func(yield func([2]int, float32) bool) {
+		if mat == nil {
+			return
+		}
+		for i, x := range mat.dat {
+			if !yield([2]int{i % mat.m, i / mat.m}, x) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SingularPolicy selects what Inv and Normalize do when given input that has
+// no inverse or no direction: a matrix whose determinant is zero, or a
+// zero-length vector or quaternion.
+type SingularPolicy int
+
+const (
+	// SingularDefault keeps the results these functions have always had: Inv
+	// returns the zero matrix, vector Normalize returns infinite elements
+	// and Quat.Normalize returns the identity.
+	SingularDefault SingularPolicy = iota
+	// SingularPanic panics with the name of the operation and the offending value.
+	SingularPanic
+)
+
+// Singular is the policy applied by Inv, Normalize and Quat.Inverse. Building with
+// the mgl_debug tag makes SingularPanic the default, so that singular input is caught
+// where it happens in debug builds while release builds keep the cheap results.
+// Callers that want to handle the case themselves can use the Try variants (e.g.
+// Mat4.TryInv) instead, which report it as an error whatever the policy.
+//
+// Like Epsilon, this is not mutex protected, so don't change it while any function
+// that uses it may be running.
+var Singular = SingularDefault
+
+// Errors returned by the Try variants of Inv and Normalize.
+var (
+	ErrSingular   = errors.New("matrix is singular")
+	ErrZeroLength = errors.New("zero-length vector has no direction")
+)
+
+// singular applies the Singular policy to the operation op, which was given
+// the value v.
+func singular(op string, v interface{}) {
+	if Singular == SingularPanic {
+		panic(fmt.Sprintf("%s: singular input %v", op, v))
+	}
+}
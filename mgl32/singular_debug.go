@@ -0,0 +1,12 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mgl_debug
+// +build mgl_debug
+
+package mgl32
+
+func init() {
+	Singular = SingularPanic
+}
@@ -0,0 +1,118 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+// Not parallel: the policy is package state, and parallel tests only start
+// once every sequential test has returned.
+func TestSingularPolicy(t *testing.T) {
+	defer func(p SingularPolicy) { Singular = p }(Singular)
+
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"Mat2.Inv", func() { Mat2{}.Inv() }},
+		{"Mat3.Inv", func() { Mat3{1, 2, 3, 2, 4, 6, 0, 0, 1}.Inv() }},
+		{"Mat4.Inv", func() { Mat4{}.Inv() }},
+		{"Vec2.Normalize", func() { Vec2{}.Normalize() }},
+		{"Vec3.Normalize", func() { Vec3{}.Normalize() }},
+		{"Vec4.Normalize", func() { Vec4{}.Normalize() }},
+		{"Quat.Normalize", func() { Quat{}.Normalize() }},
+		{"Quat.Inverse", func() { Quat{}.Inverse() }},
+		{"VecN.Normalize", func() { NewVecN(3).Normalize(nil) }},
+	}
+
+	panics := func(f func()) (p bool) {
+		defer func() { p = recover() != nil }()
+		f()
+		return false
+	}
+
+	for _, test := range tests {
+		Singular = SingularDefault
+		if panics(test.f) {
+			t.Errorf("%s panics with SingularDefault", test.name)
+		}
+		Singular = SingularPanic
+		if !panics(test.f) {
+			t.Errorf("%s doesn't panic with SingularPanic", test.name)
+		}
+	}
+
+	Singular = SingularPanic
+	if panics(func() { Ident4().Inv(); Vec3{1, 2, 3}.Normalize(); QuatIdent().Inverse() }) {
+		t.Errorf("SingularPanic panics on regular input")
+	}
+	if panics(func() { Mat4{}.TransformPlane(Vec4{0, 0, 1, 0}) }) {
+		t.Errorf("TransformPlane by a singular matrix panics with SingularPanic")
+	}
+	if panics(func() { TileAABB(Mat4{}, -1, -1, 1, 1, 1, 10) }) {
+		t.Errorf("TileAABB of a singular projection panics with SingularPanic")
+	}
+	if panics(func() { Scale3D(0, 1, 1).Decompose() }) {
+		t.Errorf("Decompose of a singular matrix panics with SingularPanic")
+	}
+}
+
+func TestSingularDefaultResults(t *testing.T) {
+	t.Parallel()
+	if Singular != SingularDefault {
+		t.Skip("built with mgl_debug")
+	}
+
+	if m := (Mat3{1, 2, 3, 2, 4, 6, 0, 0, 1}).Inv(); m != (Mat3{}) {
+		t.Errorf("Inv of a singular matrix = %v, want the zero matrix", m)
+	}
+	if q := (Quat{}).Normalize(); q != QuatIdent() {
+		t.Errorf("Normalize of the zero quaternion = %v, want the identity", q)
+	}
+}
+
+func TestTryInv(t *testing.T) {
+	t.Parallel()
+
+	if _, err := (Mat4{}).TryInv(); err != ErrSingular {
+		t.Errorf("TryInv of the zero matrix returned error %v, want ErrSingular", err)
+	}
+	if _, err := (Mat2{1, 2, 2, 4}).TryInv(); err != ErrSingular {
+		t.Errorf("TryInv of a singular matrix returned error %v, want ErrSingular", err)
+	}
+
+	m := Mat3{2, 0, 0, 0, 4, 0, 1, 0, 1}
+	inv, err := m.TryInv()
+	if err != nil {
+		t.Fatalf("TryInv of %v returned error %v", m, err)
+	}
+	if !inv.ApproxEqual(m.Inv()) {
+		t.Errorf("TryInv of %v = %v, want %v", m, inv, m.Inv())
+	}
+}
+
+func TestTryNormalize(t *testing.T) {
+	t.Parallel()
+
+	if _, err := (Vec3{}).TryNormalize(); err != ErrZeroLength {
+		t.Errorf("TryNormalize of the zero vector returned error %v, want ErrZeroLength", err)
+	}
+	if _, err := (Quat{}).TryNormalize(); err != ErrZeroLength {
+		t.Errorf("TryNormalize of the zero quaternion returned error %v, want ErrZeroLength", err)
+	}
+	if _, err := (Quat{}).TryInverse(); err != ErrZeroLength {
+		t.Errorf("TryInverse of the zero quaternion returned error %v, want ErrZeroLength", err)
+	}
+
+	v, err := Vec2{3, 4}.TryNormalize()
+	if err != nil || !v.ApproxEqual(Vec2{0.6, 0.8}) {
+		t.Errorf("TryNormalize of {3, 4} = %v, %v; want {0.6, 0.8}, nil", v, err)
+	}
+	q, err := Quat{2, Vec3{}}.TryInverse()
+	if err != nil || !q.ApproxEqual(Quat{0.5, Vec3{}}) {
+		t.Errorf("TryInverse of {2, {0, 0, 0}} = %v, %v; want {0.5, {0, 0, 0}}, nil", q, err)
+	}
+}
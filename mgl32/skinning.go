@@ -0,0 +1,84 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// ComputeSkinningPalette stores in dst the skinning matrix of each joint,
+// world[i].Mul4(invBind[i]), which takes a vertex from the bind pose to its
+// posed world position through joint i. World holds the world transforms of
+// the joints and invBind the inverses of their bind pose transforms.
+//
+// It panics if the three slices don't have the same length.
+func ComputeSkinningPalette(invBind []Mat4, world []Mat4, dst []Mat4) {
+	checkPaletteLen(len(invBind), len(world), len(dst))
+	for i := range dst {
+		dst[i] = world[i].Mul4(invBind[i])
+	}
+}
+
+// ComputeDualQuatSkinningPalette is the version of ComputeSkinningPalette for
+// dual quaternion skinning. The skinning transforms must be rigid; any scale is
+// lost.
+//
+// It panics if the three slices don't have the same length.
+func ComputeDualQuatSkinningPalette(invBind []Mat4, world []Mat4, dst []DualQuat) {
+	checkPaletteLen(len(invBind), len(world), len(dst))
+	for i := range dst {
+		dst[i] = Mat4ToDualQuat(world[i].Mul4(invBind[i]))
+	}
+}
+
+func checkPaletteLen(invBind, world, dst int) {
+	if invBind != world || world != dst {
+		panic("Mismatched skinning palette lengths")
+	}
+}
+
+// SkinPositions stores in dst the bind pose positions skinned with the
+// palette by linear blend skinning: each position i is transformed by up to
+// four joints, joints[i][k] with weight weights[i][k], and the results are
+// summed. The weights of a vertex should add up to 1; unused influences have
+// a weight of 0. Dst is reallocated if it is too short and may be positions
+// itself.
+func SkinPositions(dst, positions []Vec3, joints [][4]uint16, weights []Vec4, palette []Mat4) []Vec3 {
+	if len(dst) < len(positions) {
+		dst = make([]Vec3, len(positions))
+	}
+	dst = dst[:len(positions)]
+
+	for i, p := range positions {
+		var m Mat4
+		for k, w := range weights[i] {
+			if w != 0 {
+				m = m.Add(palette[joints[i][k]].Mul(w))
+			}
+		}
+		dst[i] = m.Mul4x1(p.Vec4(1)).Vec3()
+	}
+	return dst
+}
+
+// SkinPositionsDualQuat is the version of SkinPositions for dual quaternion
+// skinning, which blends the joint transforms linearly as dual quaternions
+// and normalizes the result (Kavan et al., "Skinning with Dual Quaternions",
+// 2007). Unlike linear blend skinning, it doesn't collapse volume around
+// twisting joints.
+func SkinPositionsDualQuat(dst, positions []Vec3, joints [][4]uint16, weights []Vec4, palette []DualQuat) []Vec3 {
+	if len(dst) < len(positions) {
+		dst = make([]Vec3, len(positions))
+	}
+	dst = dst[:len(positions)]
+
+	for i, p := range positions {
+		var b DualQuat
+		var pivot Quat
+		for k, w := range weights[i] {
+			if w != 0 {
+				b = dlbAdd(b, &pivot, palette[joints[i][k]], w)
+			}
+		}
+		dst[i] = b.Normalize().TransformPoint(p)
+	}
+	return dst
+}
@@ -0,0 +1,93 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComputeSkinningPalette(t *testing.T) {
+	t.Parallel()
+
+	bind := []Mat4{Ident4(), Translate3D(0, 1, 0), Translate3D(0, 2, 0).Mul4(HomogRotate3DX(0.5))}
+	world := []Mat4{
+		HomogRotate3DZ(0.3),
+		HomogRotate3DZ(0.3).Mul4(Translate3D(0, 1, 0)).Mul4(HomogRotate3DY(1)),
+		Translate3D(5, 0, 0).Mul4(bind[2]),
+	}
+	invBind := make([]Mat4, len(bind))
+	for i := range bind {
+		invBind[i] = bind[i].Inv()
+	}
+
+	palette := make([]Mat4, len(bind))
+	ComputeSkinningPalette(invBind, world, palette)
+	dqPalette := make([]DualQuat, len(bind))
+	ComputeDualQuatSkinningPalette(invBind, world, dqPalette)
+
+	for i := range bind {
+		// The bind pose origin of each joint lands on its world position.
+		origin := bind[i].Col(3).Vec3()
+		want := world[i].Col(3).Vec3()
+		if got := TransformCoordinate(origin, palette[i]); !got.ApproxFuncEqual(want, absEqual(1e-5)) {
+			t.Errorf("palette[%d] moves the joint to %v, want %v", i, got, want)
+		}
+		if got := dqPalette[i].TransformPoint(origin); !got.ApproxFuncEqual(want, absEqual(1e-5)) {
+			t.Errorf("dual quaternion palette[%d] moves the joint to %v, want %v", i, got, want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("ComputeSkinningPalette with mismatched lengths doesn't panic")
+		}
+	}()
+	ComputeSkinningPalette(invBind, world, palette[:2])
+}
+
+func TestSkinPositions(t *testing.T) {
+	t.Parallel()
+
+	palette := []Mat4{Ident4(), HomogRotate3DZ(math.Pi / 2), Translate3D(0, 0, 2)}
+	dqPalette := make([]DualQuat, len(palette))
+	for i, m := range palette {
+		dqPalette[i] = Mat4ToDualQuat(m)
+	}
+
+	positions := []Vec3{{1, 0, 0}, {1, 0, 0}, {1, 0, 0}, {0, 1, 1}}
+	joints := [][4]uint16{{1}, {0, 1}, {0, 2}, {2, 1, 0, 0}}
+	weights := []Vec4{{1}, {0.5, 0.5}, {0.25, 0.75}, {0.5, 0.5}}
+
+	// Both agree for a single joint and for pure translations.
+	lbs := SkinPositions(nil, positions, joints, weights, palette)
+	dqs := SkinPositionsDualQuat(nil, positions, joints, weights, dqPalette)
+	for _, i := range []int{0, 2} {
+		want := []Vec3{{0, 1, 0}, {}, {1, 0, 1.5}}[i]
+		if !lbs[i].ApproxFuncEqual(want, absEqual(1e-5)) || !dqs[i].ApproxFuncEqual(want, absEqual(1e-5)) {
+			t.Errorf("skinned position %d = %v (linear), %v (dual quaternion), want %v", i, lbs[i], dqs[i], want)
+		}
+	}
+
+	// Halfway through a quarter turn, linear blending shrinks the vertex
+	// towards the joint while dual quaternions keep it on the circle.
+	s := float32(math.Sqrt(0.5))
+	if want := (Vec3{0.5, 0.5, 0}); !lbs[1].ApproxFuncEqual(want, absEqual(1e-5)) {
+		t.Errorf("linear blend skinned position = %v, want %v", lbs[1], want)
+	}
+	if want := (Vec3{s, s, 0}); !dqs[1].ApproxFuncEqual(want, absEqual(1e-5)) {
+		t.Errorf("dual quaternion skinned position = %v, want %v", dqs[1], want)
+	}
+	if l := dqs[3].Vec2().Len(); !FloatEqualThreshold(l, 1, 1e-5) {
+		t.Errorf("dual quaternion skinning changed the distance to the axis to %v", l)
+	}
+
+	// In place, with an antipodal joint that must not cancel out.
+	dqPalette[0].Real, dqPalette[0].Dual = dqPalette[0].Real.Scale(-1), dqPalette[0].Dual.Scale(-1)
+	got := SkinPositionsDualQuat(positions, positions, joints, weights, dqPalette)
+	if &got[0] != &positions[0] || !got[1].ApproxFuncEqual(dqs[1], absEqual(1e-5)) {
+		t.Errorf("dual quaternion skinning in place with a flipped joint = %v, want %v", got, dqs)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Sphere is the solid ball of the given radius around Center.
+type Sphere struct {
+	Center Vec3
+	Radius float32
+}
+
+// ContainsPoint reports whether p is inside the sphere or on its surface.
+func (s Sphere) ContainsPoint(p Vec3) bool {
+	return p.Sub(s.Center).LenSqr() <= s.Radius*s.Radius
+}
+
+// IntersectsTriangle tests whether the sphere intersects the triangle
+// (a,b,c). The contact point is the point of the triangle closest to the
+// center of the sphere, and is returned even when there is no intersection.
+//
+// For collision response, the contact normal is
+// s.Center.Sub(contact).Normalize() and the penetration depth is s.Radius
+// minus the distance between the center and the contact point.
+func (s Sphere) IntersectsTriangle(a, b, c Vec3) (contact Vec3, ok bool) {
+	contact = ClosestPointTriangle(s.Center, a, b, c)
+	return contact, s.ContainsPoint(contact)
+}
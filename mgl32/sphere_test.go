@@ -0,0 +1,33 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestSphereIntersectsTriangle(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := Vec3{0, 0, 0}, Vec3{4, 0, 0}, Vec3{0, 4, 0}
+
+	tests := []struct {
+		Sphere  Sphere
+		Hit     bool
+		Contact Vec3
+	}{
+		{Sphere{Vec3{1, 1, 0.5}, 1}, true, Vec3{1, 1, 0}},
+		{Sphere{Vec3{1, 1, 1.5}, 1}, false, Vec3{1, 1, 0}},
+		{Sphere{Vec3{-1, -1, 0}, 1.5}, true, a},
+		{Sphere{Vec3{3, 3, 0}, 1}, false, Vec3{2, 2, 0}},
+	}
+
+	for _, test := range tests {
+		contact, hit := test.Sphere.IntersectsTriangle(a, b, c)
+		if hit != test.Hit || !contact.ApproxFuncEqual(test.Contact, func(x, y float32) bool { return Abs(x-y) < 1e-5 }) {
+			t.Errorf("%v.IntersectsTriangle = %v, %v, expected %v, %v", test.Sphere, contact, hit, test.Contact, test.Hit)
+		}
+	}
+}
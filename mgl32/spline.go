@@ -0,0 +1,247 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// These are piecewise cubic splines through or near many points, for paths
+// that Bezier curves of shapes.go would have to be stitched together for by
+// hand, such as camera paths and animation channels. Unlike the Bezier
+// functions, t runs over the whole spline: each segment between consecutive
+// keys spans an interval of length 1, so the key i is reached at t = i, and t
+// is clamped to the range of the spline. The tangent functions return the
+// derivative of the position with respect to t.
+
+// CatmullRomSpline2D returns the point at t along the uniform Catmull-Rom
+// spline through points, which passes through the point i at t = i, with the
+// tangent there being half the difference between its neighbours. The first
+// and last points are repeated to define the ends of the spline, as
+// CatmullRomSplineQuat does, and t is clamped to [0, len(points)-1].
+//
+// This panics if there are no points.
+func CatmullRomSpline2D(t float32, points []Vec2) Vec2 {
+	return sum2D(points, catmullRomWeights(t, len(points), false))
+}
+
+// CatmullRomSpline3D same as the 2D version, except the spline is in 3D space.
+func CatmullRomSpline3D(t float32, points []Vec3) Vec3 {
+	return sum3D(points, catmullRomWeights(t, len(points), false))
+}
+
+// CatmullRomSpline4D same as the 2D version, except the spline is in 4D space.
+func CatmullRomSpline4D(t float32, points []Vec4) Vec4 {
+	return sum4D(points, catmullRomWeights(t, len(points), false))
+}
+
+// CatmullRomSplineTangent2D returns the tangent at t of the spline of
+// CatmullRomSpline2D.
+func CatmullRomSplineTangent2D(t float32, points []Vec2) Vec2 {
+	return sum2D(points, catmullRomWeights(t, len(points), true))
+}
+
+// CatmullRomSplineTangent3D returns the tangent at t of the spline of
+// CatmullRomSpline3D.
+func CatmullRomSplineTangent3D(t float32, points []Vec3) Vec3 {
+	return sum3D(points, catmullRomWeights(t, len(points), true))
+}
+
+// CatmullRomSplineTangent4D returns the tangent at t of the spline of
+// CatmullRomSpline4D.
+func CatmullRomSplineTangent4D(t float32, points []Vec4) Vec4 {
+	return sum4D(points, catmullRomWeights(t, len(points), true))
+}
+
+// BSpline2D returns the point at t along the uniform cubic B-spline with the
+// control points cPoints, where t is clamped to [0, len(cPoints)-3]. The
+// B-spline is smoother than a Catmull-Rom spline, its curvature being
+// continuous too, but it only approximates its control points: the segment
+// for t in [i, i+1] is shaped by the points i to i+3, and starts at
+// (cPoints[i] + 4*cPoints[i+1] + cPoints[i+2]) / 6. Repeat the first and last
+// points three times for the spline to start and end on them.
+//
+// This panics if there are fewer than four control points.
+func BSpline2D(t float32, cPoints []Vec2) Vec2 {
+	return sum2D(cPoints, bSplineWeights(t, len(cPoints), false))
+}
+
+// BSpline3D same as the 2D version, except the spline is in 3D space.
+func BSpline3D(t float32, cPoints []Vec3) Vec3 {
+	return sum3D(cPoints, bSplineWeights(t, len(cPoints), false))
+}
+
+// BSpline4D same as the 2D version, except the spline is in 4D space.
+func BSpline4D(t float32, cPoints []Vec4) Vec4 {
+	return sum4D(cPoints, bSplineWeights(t, len(cPoints), false))
+}
+
+// BSplineTangent2D returns the tangent at t of the spline of BSpline2D.
+func BSplineTangent2D(t float32, cPoints []Vec2) Vec2 {
+	return sum2D(cPoints, bSplineWeights(t, len(cPoints), true))
+}
+
+// BSplineTangent3D returns the tangent at t of the spline of BSpline3D.
+func BSplineTangent3D(t float32, cPoints []Vec3) Vec3 {
+	return sum3D(cPoints, bSplineWeights(t, len(cPoints), true))
+}
+
+// BSplineTangent4D returns the tangent at t of the spline of BSpline4D.
+func BSplineTangent4D(t float32, cPoints []Vec4) Vec4 {
+	return sum4D(cPoints, bSplineWeights(t, len(cPoints), true))
+}
+
+// CubicHermiteSpline2D returns the point at t along the cubic Hermite spline
+// through points, which passes through the point i at t = i with the tangent
+// tangents[i]. t is clamped to [0, len(points)-1]. Keys sampled from an
+// animation channel along with their velocities make such a spline.
+//
+// This panics if there are no points, or if there isn't a tangent for each
+// point.
+func CubicHermiteSpline2D(t float32, points, tangents []Vec2) Vec2 {
+	i, j, h := hermiteWeights(t, len(points), len(tangents), false)
+	return points[i].Mul(h[0]).Add(tangents[i].Mul(h[1])).Add(points[j].Mul(h[2])).Add(tangents[j].Mul(h[3]))
+}
+
+// CubicHermiteSpline3D same as the 2D version, except the spline is in 3D
+// space.
+func CubicHermiteSpline3D(t float32, points, tangents []Vec3) Vec3 {
+	i, j, h := hermiteWeights(t, len(points), len(tangents), false)
+	return points[i].Mul(h[0]).Add(tangents[i].Mul(h[1])).Add(points[j].Mul(h[2])).Add(tangents[j].Mul(h[3]))
+}
+
+// CubicHermiteSpline4D same as the 2D version, except the spline is in 4D
+// space.
+func CubicHermiteSpline4D(t float32, points, tangents []Vec4) Vec4 {
+	i, j, h := hermiteWeights(t, len(points), len(tangents), false)
+	return points[i].Mul(h[0]).Add(tangents[i].Mul(h[1])).Add(points[j].Mul(h[2])).Add(tangents[j].Mul(h[3]))
+}
+
+// CubicHermiteSplineTangent2D returns the tangent at t of the spline of
+// CubicHermiteSpline2D.
+func CubicHermiteSplineTangent2D(t float32, points, tangents []Vec2) Vec2 {
+	i, j, h := hermiteWeights(t, len(points), len(tangents), true)
+	return points[i].Mul(h[0]).Add(tangents[i].Mul(h[1])).Add(points[j].Mul(h[2])).Add(tangents[j].Mul(h[3]))
+}
+
+// CubicHermiteSplineTangent3D returns the tangent at t of the spline of
+// CubicHermiteSpline3D.
+func CubicHermiteSplineTangent3D(t float32, points, tangents []Vec3) Vec3 {
+	i, j, h := hermiteWeights(t, len(points), len(tangents), true)
+	return points[i].Mul(h[0]).Add(tangents[i].Mul(h[1])).Add(points[j].Mul(h[2])).Add(tangents[j].Mul(h[3]))
+}
+
+// CubicHermiteSplineTangent4D returns the tangent at t of the spline of
+// CubicHermiteSpline4D.
+func CubicHermiteSplineTangent4D(t float32, points, tangents []Vec4) Vec4 {
+	i, j, h := hermiteWeights(t, len(points), len(tangents), true)
+	return points[i].Mul(h[0]).Add(tangents[i].Mul(h[1])).Add(points[j].Mul(h[2])).Add(tangents[j].Mul(h[3]))
+}
+
+// splineWeights are the indices of the four points a spline is evaluated from
+// at some t, and the weight of each.
+type splineWeights struct {
+	Index  [4]int
+	Weight [4]float32
+}
+
+func sum2D(points []Vec2, w splineWeights) Vec2 {
+	var p Vec2
+	for k, i := range w.Index {
+		p = p.Add(points[i].Mul(w.Weight[k]))
+	}
+	return p
+}
+
+func sum3D(points []Vec3, w splineWeights) Vec3 {
+	var p Vec3
+	for k, i := range w.Index {
+		p = p.Add(points[i].Mul(w.Weight[k]))
+	}
+	return p
+}
+
+func sum4D(points []Vec4, w splineWeights) Vec4 {
+	var p Vec4
+	for k, i := range w.Index {
+		p = p.Add(points[i].Mul(w.Weight[k]))
+	}
+	return p
+}
+
+// splineSegment returns the segment of a spline with the given number of
+// segments that t falls in, once clamped to [0, segments], and the parameter
+// u in [0, 1] within it.
+func splineSegment(t float32, segments int) (i int, u float32) {
+	t = Clamp(t, 0, float32(segments))
+	i = int(t)
+	if i == segments && segments > 0 {
+		i--
+	}
+	return i, t - float32(i)
+}
+
+// hermiteBasis returns the cubic Hermite basis functions at u, weighting the
+// start point, start tangent, end point and end tangent of a segment, or their
+// derivatives.
+func hermiteBasis(u float32, derivative bool) [4]float32 {
+	uu := u * u
+	if derivative {
+		return [4]float32{6*uu - 6*u, 3*uu - 4*u + 1, 6*u - 6*uu, 3*uu - 2*u}
+	}
+	uuu := uu * u
+	return [4]float32{2*uuu - 3*uu + 1, uuu - 2*uu + u, 3*uu - 2*uuu, uuu - uu}
+}
+
+func hermiteWeights(t float32, points, tangents int, derivative bool) (i, j int, h [4]float32) {
+	if points == 0 {
+		panic("CubicHermiteSpline needs at least one point")
+	}
+	if points != tangents {
+		panic("Each point of a CubicHermiteSpline needs a tangent")
+	}
+
+	i, u := splineSegment(t, points-1)
+	j = intMin(i+1, points-1)
+	return i, j, hermiteBasis(u, derivative)
+}
+
+// catmullRomWeights expresses the Catmull-Rom segment for t in [i, i+1] as the
+// Hermite segment from points[i] to points[i+1] with the tangents
+// (points[i+1]-points[i-1]) / 2 and (points[i+2]-points[i]) / 2, weighting
+// points[i-1] to points[i+2], with indices clamped to the ends.
+func catmullRomWeights(t float32, n int, derivative bool) splineWeights {
+	if n == 0 {
+		panic("CatmullRomSpline needs at least one point")
+	}
+
+	i, u := splineSegment(t, n-1)
+	h := hermiteBasis(u, derivative)
+	w := splineWeights{Weight: [4]float32{-h[1] / 2, h[0] - h[3]/2, h[2] + h[1]/2, h[3] / 2}}
+	for k := range w.Index {
+		j := i + k - 1
+		if j < 0 {
+			j = 0
+		} else if j > n-1 {
+			j = n - 1
+		}
+		w.Index[k] = j
+	}
+	return w
+}
+
+// bSplineWeights returns the uniform cubic B-spline basis functions for
+// cPoints[i] to cPoints[i+3], or their derivatives.
+func bSplineWeights(t float32, n int, derivative bool) splineWeights {
+	if n < 4 {
+		panic("BSpline needs at least four control points")
+	}
+
+	i, u := splineSegment(t, n-3)
+	v := 1 - u
+	w := splineWeights{Index: [4]int{i, i + 1, i + 2, i + 3}}
+	if derivative {
+		w.Weight = [4]float32{-v * v / 2, (3*u - 4) * u / 2, (1 + 2*u - 3*u*u) / 2, u * u / 2}
+	} else {
+		w.Weight = [4]float32{v * v * v / 6, (4 - 6*u*u + 3*u*u*u) / 6, (1 + 3*u + 3*u*u - 3*u*u*u) / 6, u * u * u / 6}
+	}
+	return w
+}
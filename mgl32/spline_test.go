@@ -0,0 +1,188 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+var splinePoints = []Vec3{{0, 0, 0}, {1, 2, 0}, {3, 2, 1}, {4, -1, 2}, {6, 0, 0}}
+
+func TestCatmullRomSpline(t *testing.T) {
+	t.Parallel()
+
+	for i, p := range splinePoints {
+		if got := CatmullRomSpline3D(float32(i), splinePoints); !got.ApproxEqualThreshold(p, 1e-6) {
+			t.Errorf("CatmullRomSpline3D(%d) = %v, want %v", i, got, p)
+		}
+	}
+	for i := 1; i < len(splinePoints)-1; i++ {
+		want := splinePoints[i+1].Sub(splinePoints[i-1]).Mul(0.5)
+		if got := CatmullRomSplineTangent3D(float32(i), splinePoints); !got.ApproxEqualThreshold(want, 1e-6) {
+			t.Errorf("CatmullRomSplineTangent3D(%d) = %v, want %v", i, got, want)
+		}
+	}
+
+	if got := CatmullRomSpline3D(-1, splinePoints); got != splinePoints[0] {
+		t.Errorf("CatmullRomSpline3D before the start = %v, want %v", got, splinePoints[0])
+	}
+	if got := CatmullRomSpline3D(10, splinePoints); got != splinePoints[4] {
+		t.Errorf("CatmullRomSpline3D after the end = %v, want %v", got, splinePoints[4])
+	}
+
+	// Evenly spaced points on a line are interpolated linearly.
+	line := []Vec3{{0, 0, 0}, {1, 2, 3}, {2, 4, 6}, {3, 6, 9}}
+	for _, s := range []float32{1.25, 1.5, 1.9} {
+		if got, want := CatmullRomSpline3D(s, line), (Vec3{1, 2, 3}).Mul(s); !got.ApproxEqualThreshold(want, 1e-5) {
+			t.Errorf("CatmullRomSpline3D(%v) on a line = %v, want %v", s, got, want)
+		}
+	}
+
+	single := []Vec3{{1, 2, 3}}
+	if got := CatmullRomSpline3D(0.5, single); got != single[0] {
+		t.Errorf("CatmullRomSpline3D of a single point = %v, want %v", got, single[0])
+	}
+	if got := CatmullRomSplineTangent3D(0.5, single); got != (Vec3{}) {
+		t.Errorf("CatmullRomSplineTangent3D of a single point = %v, want 0", got)
+	}
+}
+
+func TestBSpline(t *testing.T) {
+	t.Parallel()
+
+	p := splinePoints
+	want := p[0].Add(p[1].Mul(4)).Add(p[2]).Mul(1.0 / 6)
+	if got := BSpline3D(0, p); !got.ApproxEqualThreshold(want, 1e-6) {
+		t.Errorf("BSpline3D(0) = %v, want %v", got, want)
+	}
+	want = p[1].Add(p[2].Mul(4)).Add(p[3]).Mul(1.0 / 6)
+	if got := BSpline3D(1, p); !got.ApproxEqualThreshold(want, 1e-6) {
+		t.Errorf("BSpline3D(1) = %v, want %v", got, want)
+	}
+	want = p[4].Sub(p[2]).Mul(0.5)
+	if got := BSplineTangent3D(2, p); !got.ApproxEqualThreshold(want, 1e-6) {
+		t.Errorf("BSplineTangent3D(2) = %v, want %v", got, want)
+	}
+
+	clamped := append([]Vec3{p[0], p[0]}, append(p, p[4], p[4])...)
+	end := float32(len(clamped) - 3)
+	if got := BSpline3D(0, clamped); !got.ApproxEqualThreshold(p[0], 1e-6) {
+		t.Errorf("BSpline3D at the start of a clamped spline = %v, want %v", got, p[0])
+	}
+	if got := BSpline3D(end+1, clamped); !got.ApproxEqualThreshold(p[4], 1e-6) {
+		t.Errorf("BSpline3D after the end of a clamped spline = %v, want %v", got, p[4])
+	}
+}
+
+func TestCubicHermiteSpline(t *testing.T) {
+	t.Parallel()
+
+	tangents := []Vec3{{1, 0, 0}, {0, 1, 0}, {2, 0, -1}, {0, 0, 3}, {-1, -1, -1}}
+	for i := range splinePoints {
+		if got := CubicHermiteSpline3D(float32(i), splinePoints, tangents); !got.ApproxEqualThreshold(splinePoints[i], 1e-6) {
+			t.Errorf("CubicHermiteSpline3D(%d) = %v, want %v", i, got, splinePoints[i])
+		}
+		if got := CubicHermiteSplineTangent3D(float32(i), splinePoints, tangents); !got.ApproxEqualThreshold(tangents[i], 1e-6) {
+			t.Errorf("CubicHermiteSplineTangent3D(%d) = %v, want %v", i, got, tangents[i])
+		}
+	}
+
+	single := []Vec3{{1, 2, 3}}
+	if got := CubicHermiteSplineTangent3D(0, single, tangents[:1]); got != tangents[0] {
+		t.Errorf("CubicHermiteSplineTangent3D of a single point = %v, want %v", got, tangents[0])
+	}
+}
+
+func TestSplineTangents(t *testing.T) {
+	t.Parallel()
+
+	tangents := []Vec3{{1, 0, 0}, {0, 1, 0}, {2, 0, -1}, {0, 0, 3}, {-1, -1, -1}}
+	tests := []struct {
+		Description string
+		Position    func(float32) Vec3
+		Tangent     func(float32) Vec3
+	}{
+		{"Catmull-Rom",
+			func(s float32) Vec3 { return CatmullRomSpline3D(s, splinePoints) },
+			func(s float32) Vec3 { return CatmullRomSplineTangent3D(s, splinePoints) }},
+		{"B-spline",
+			func(s float32) Vec3 { return BSpline3D(s, splinePoints) },
+			func(s float32) Vec3 { return BSplineTangent3D(s, splinePoints) }},
+		{"Hermite",
+			func(s float32) Vec3 { return CubicHermiteSpline3D(s, splinePoints, tangents) },
+			func(s float32) Vec3 { return CubicHermiteSplineTangent3D(s, splinePoints, tangents) }},
+	}
+
+	const h = 1e-2
+	for _, c := range tests {
+		for _, s := range []float32{0.3, 0.75, 1.5, 1.8} {
+			want := c.Position(s + h).Sub(c.Position(s - h)).Mul(1 / (2 * h))
+			if got := c.Tangent(s); !got.ApproxEqualThreshold(want, 1e-2) {
+				t.Errorf("%s: tangent at %v = %v, want about %v", c.Description, s, got, want)
+			}
+		}
+	}
+}
+
+func TestSplineDimensions(t *testing.T) {
+	t.Parallel()
+
+	points2 := make([]Vec2, len(splinePoints))
+	points4 := make([]Vec4, len(splinePoints))
+	for i, p := range splinePoints {
+		points2[i], points4[i] = p.Vec2(), p.Vec4(0)
+	}
+
+	for _, s := range []float32{0, 0.4, 1.5, 3.2} {
+		want := CatmullRomSpline3D(s, splinePoints)
+		if got := CatmullRomSpline2D(s, points2); got != want.Vec2() {
+			t.Errorf("CatmullRomSpline2D(%v) = %v, want %v", s, got, want.Vec2())
+		}
+		if got := CatmullRomSpline4D(s, points4); got != want.Vec4(0) {
+			t.Errorf("CatmullRomSpline4D(%v) = %v, want %v", s, got, want.Vec4(0))
+		}
+
+		want = BSplineTangent3D(s, splinePoints)
+		if got := BSplineTangent2D(s, points2); got != want.Vec2() {
+			t.Errorf("BSplineTangent2D(%v) = %v, want %v", s, got, want.Vec2())
+		}
+		if got := BSplineTangent4D(s, points4); got != want.Vec4(0) {
+			t.Errorf("BSplineTangent4D(%v) = %v, want %v", s, got, want.Vec4(0))
+		}
+
+		want = CubicHermiteSpline3D(s, splinePoints, splinePoints)
+		if got := CubicHermiteSpline2D(s, points2, points2); got != want.Vec2() {
+			t.Errorf("CubicHermiteSpline2D(%v) = %v, want %v", s, got, want.Vec2())
+		}
+		if got := CubicHermiteSpline4D(s, points4, points4); got != want.Vec4(0) {
+			t.Errorf("CubicHermiteSpline4D(%v) = %v, want %v", s, got, want.Vec4(0))
+		}
+	}
+}
+
+func TestSplinePanics(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Description string
+		F           func()
+	}{
+		{"Catmull-Rom without points", func() { CatmullRomSpline3D(0, nil) }},
+		{"B-spline with three points", func() { BSpline3D(0, splinePoints[:3]) }},
+		{"Hermite without points", func() { CubicHermiteSpline3D(0, nil, nil) }},
+		{"Hermite with missing tangents", func() { CubicHermiteSpline3D(0, splinePoints, splinePoints[:2]) }},
+	}
+
+	for _, c := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: didn't panic", c.Description)
+				}
+			}()
+			c.F()
+		}()
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// QuatSpring advances the orientation current by one step of dt seconds of a
+// damped spring pulling it towards target, the usual driver of procedural
+// secondary motion such as turrets, heads looking at things or dangling
+// props. velocity is the angular velocity in world space, in radians per
+// second, and is updated in place; start it at zero. The spring pulls with an
+// angular acceleration of stiffness times the angle to the target (the
+// shorter way around) and brakes with damping times the velocity; a damping
+// of 2*sqrt(stiffness) is critical, reaching the target fastest without
+// overshooting.
+//
+// The step is implicit (backward Euler), so it is stable for any stiffness,
+// damping and dt, at the cost of a little extra damping for large steps.
+func QuatSpring(current, target Quat, velocity *Vec3, stiffness, damping, dt float32) Quat {
+	e := target.Mul(current.Conjugate()).ToRotationVector()
+	*velocity = velocity.Add(e.Mul(dt * stiffness)).Mul(1 / (1 + dt*damping + dt*dt*stiffness))
+	return QuatFromRotationVector(velocity.Mul(dt)).Mul(current).Normalize()
+}
+
+// TorqueFreeStep advances the orientation and world space angular velocity of
+// a free spinning rigid body by dt seconds. inertia is its inertia tensor in
+// body space (see MassProperties). Without torque the angular momentum is
+// constant, but unless the body is symmetric the angular velocity is not:
+// bodies tumble and precess, like a thrown book or phone.
+//
+// The body is rotated by the current velocity, which is then recomputed from
+// the conserved momentum, so the momentum is preserved exactly and the motion
+// stays stable.
+func TorqueFreeStep(orientation Quat, angularVelocity Vec3, inertia Mat3, dt float32) (Quat, Vec3) {
+	r := orientation.Mat4().Mat3()
+	momentum := r.Mul3(inertia).MulTranspose3(r).Mul3x1(angularVelocity)
+
+	orientation = QuatFromRotationVector(angularVelocity.Mul(dt)).Mul(orientation).Normalize()
+	r = orientation.Mat4().Mat3()
+	return orientation, r.Mul3(inertia.Inv()).MulTranspose3(r).Mul3x1(momentum)
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuatSpring(t *testing.T) {
+	t.Parallel()
+
+	target := QuatRotate(2, Vec3{1, 1, 0}.Normalize())
+	const k = 40
+	tests := []struct {
+		damping, dt float32
+	}{
+		{2 * 6.3245554, 1. / 60}, // critical
+		{2, 1. / 60},             // underdamped
+		{2 * 6.3245554, 0.5},     // huge steps
+	}
+
+	for _, test := range tests {
+		q, v := QuatIdent(), Vec3{}
+		prev := float32(math.Inf(1))
+		monotonic := true
+		for i := 0; i < int(10/test.dt); i++ {
+			q = QuatSpring(q, target, &v, k, test.damping, test.dt)
+			angle := q.BoxMinus(target).Len()
+			if angle > prev+1e-6 {
+				monotonic = false
+			}
+			prev = angle
+		}
+		if prev > 1e-3 || v.Len() > 1e-2 {
+			t.Errorf("QuatSpring(damping %v, dt %v) settled %v from the target with velocity %v", test.damping, test.dt, prev, v)
+		}
+		if critical := test.damping > 10; critical && !monotonic {
+			t.Errorf("critically damped QuatSpring(dt %v) overshot", test.dt)
+		} else if !critical && monotonic {
+			t.Errorf("underdamped QuatSpring(dt %v) didn't overshoot", test.dt)
+		}
+	}
+
+	// The target is reached the shorter way around.
+	q, v := QuatIdent(), Vec3{}
+	far := QuatRotate(-3, Vec3{0, 0, 1})
+	q = QuatSpring(q, far, &v, k, 12, 0.01)
+	if v[2] >= 0 {
+		t.Errorf("QuatSpring towards a rotation of %v turns with velocity %v", far, v)
+	}
+}
+
+func TestTorqueFreeStep(t *testing.T) {
+	t.Parallel()
+
+	// A sphere keeps spinning about the same axis.
+	q, w := QuatIdent(), Vec3{0.3, 2, -1}
+	for i := 0; i < 100; i++ {
+		q, w = TorqueFreeStep(q, w, Diag3(Vec3{2, 2, 2}), 0.01)
+	}
+	if !w.ApproxEqualThreshold(Vec3{0.3, 2, -1}, 1e-5) {
+		t.Errorf("a sphere's angular velocity changed to %v", w)
+	}
+	if want := QuatFromRotationVector(Vec3{0.3, 2, -1}); !q.OrientationEqualThreshold(want, 1e-6) {
+		t.Errorf("a sphere rotated to %v, want %v", q, want)
+	}
+
+	// A box tumbles, but its angular momentum and energy are conserved.
+	inertia := Diag3(Vec3{1, 2, 3})
+	q, w = QuatRotate(0.4, Vec3{1, 0, 0}), Vec3{0.1, 3, 0.2}
+	momentum := func(q Quat, w Vec3) Vec3 {
+		r := q.Mat4().Mat3()
+		return r.Mul3(inertia).MulTranspose3(r).Mul3x1(w)
+	}
+	l0, e0 := momentum(q, w), momentum(q, w).Dot(w)
+	w0 := w
+	changed := false
+	for i := 0; i < 1000; i++ {
+		q, w = TorqueFreeStep(q, w, inertia, 0.001)
+		changed = changed || !w.ApproxEqualThreshold(w0, 1e-2)
+	}
+	if l := momentum(q, w); !l.ApproxFuncEqual(l0, absEqual(5e-3)) {
+		t.Errorf("angular momentum changed from %v to %v", l0, l)
+	}
+	if e := momentum(q, w).Dot(w); !FloatEqualThreshold(e, e0, 1e-2) {
+		t.Errorf("kinetic energy changed from %v to %v", e0, e)
+	}
+	if !changed {
+		t.Errorf("the angular velocity of a box spinning about its middle axis stayed %v", w)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The vectors implement driver.Valuer and sql.Scanner, so they can be stored
+// in and read from text columns of a database directly. A vector is stored as
+// its elements separated by commas, "1,2.5,-3", each with the fewest digits
+// that represent it exactly. Scan also accepts the elements in brackets, as a
+// JSON array "[1, 2.5, -3]", a Postgres array "{1,2.5,-3}" or a Postgres point
+// "(1,2.5)", and with spaces around them.
+
+// Value implements driver.Valuer.
+func (v Vec2) Value() (driver.Value, error) {
+	return formatElements(v[:]), nil
+}
+
+// Value implements driver.Valuer.
+func (v Vec3) Value() (driver.Value, error) {
+	return formatElements(v[:]), nil
+}
+
+// Value implements driver.Valuer.
+func (v Vec4) Value() (driver.Value, error) {
+	return formatElements(v[:]), nil
+}
+
+// Scan implements sql.Scanner. It accepts strings and byte slices.
+func (v *Vec2) Scan(src interface{}) error {
+	return scanElements(v[:], "Vec2", src)
+}
+
+// Scan implements sql.Scanner. It accepts strings and byte slices.
+func (v *Vec3) Scan(src interface{}) error {
+	return scanElements(v[:], "Vec3", src)
+}
+
+// Scan implements sql.Scanner. It accepts strings and byte slices.
+func (v *Vec4) Scan(src interface{}) error {
+	return scanElements(v[:], "Vec4", src)
+}
+
+func formatElements(s []float32) string {
+	fields := make([]string, len(s))
+	for i, v := range s {
+		fields[i] = formatElement(v)
+	}
+	return strings.Join(fields, ",")
+}
+
+// scanElements parses the elements of the vector type typ from src into s. It
+// doesn't modify s if src isn't a valid vector.
+func scanElements(s []float32, typ string, src interface{}) error {
+	var text string
+	switch src := src.(type) {
+	case string:
+		text = src
+	case []byte:
+		text = string(src)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into a %s", typ)
+	default:
+		return fmt.Errorf("cannot scan a %T into a %s", src, typ)
+	}
+
+	trimmed := strings.TrimSpace(text)
+	if len(trimmed) >= 2 {
+		if closing, ok := map[byte]byte{'[': ']', '{': '}', '(': ')'}[trimmed[0]]; ok {
+			if trimmed[len(trimmed)-1] != closing {
+				return fmt.Errorf("%s %q has no closing bracket", typ, text)
+			}
+			trimmed = trimmed[1 : len(trimmed)-1]
+		}
+	}
+
+	fields := strings.Split(trimmed, ",")
+	if len(fields) != len(s) {
+		return fmt.Errorf("%s %q has %d elements, expected %d", typ, text, len(fields), len(s))
+	}
+	parsed := make([]float32, len(s))
+	for i, field := range fields {
+		v, err := strconv.ParseFloat(strings.TrimSpace(field), scalarBits)
+		if err != nil {
+			return fmt.Errorf("element %d of %s %q: %v", i, typ, text, err)
+		}
+		parsed[i] = float32(v)
+	}
+	copy(s, parsed)
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Vec2{}
+	_ driver.Valuer = Vec3{}
+	_ driver.Valuer = Vec4{}
+	_ sql.Scanner   = &Vec2{}
+	_ sql.Scanner   = &Vec3{}
+	_ sql.Scanner   = &Vec4{}
+)
+
+func TestVecValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Value driver.Valuer
+		Want  string
+	}{
+		{Vec2{1, -2}, "1,-2"},
+		{Vec3{0.1, 2.5, 1e10}, "0.1,2.5,1e+10"},
+		{Vec4{0, 0, 0, 1}, "0,0,0,1"},
+	}
+
+	for _, c := range tests {
+		if got, err := c.Value.Value(); err != nil || got != c.Want {
+			t.Errorf("%v.Value() = %q, %v, want %q", c.Value, got, err, c.Want)
+		}
+	}
+
+	v := Vec3{1.0 / 3, -7.25, 1e-20}
+	val, _ := v.Value()
+	var back Vec3
+	if err := back.Scan(val); err != nil || back != v {
+		t.Errorf("Scan(%q) = %v, %v, want %v", val, back, err, v)
+	}
+}
+
+func TestVecScan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Src  interface{}
+		Want Vec3
+	}{
+		{"1,2,3", Vec3{1, 2, 3}},
+		{[]byte("-1.5,0,2e3"), Vec3{-1.5, 0, 2000}},
+		{" [1, 2.5, -3] ", Vec3{1, 2.5, -3}},
+		{"{4,5,6}", Vec3{4, 5, 6}},
+		{"(0.5, 0.25, 0)", Vec3{0.5, 0.25, 0}},
+	}
+
+	for _, c := range tests {
+		var v Vec3
+		if err := v.Scan(c.Src); err != nil || v != c.Want {
+			t.Errorf("Scan(%q) = %v, %v, want %v", c.Src, v, err, c.Want)
+		}
+	}
+
+	var v2 Vec2
+	if err := v2.Scan("(3,4)"); err != nil || v2 != (Vec2{3, 4}) {
+		t.Errorf("Vec2 Scan of a point = %v, %v", v2, err)
+	}
+	var v4 Vec4
+	if err := v4.Scan("[1,0,0,1]"); err != nil || v4 != (Vec4{1, 0, 0, 1}) {
+		t.Errorf("Vec4 Scan = %v, %v", v4, err)
+	}
+}
+
+func TestVecScanErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, src := range []interface{}{nil, 42, "1,2", "1,2,3,4", "[1,2,3", "1,x,3", "", "{1,2,3]"} {
+		v := Vec3{7, 8, 9}
+		if err := v.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) succeeded with %v", src, v)
+		} else if v != (Vec3{7, 8, 9}) {
+			t.Errorf("Scan(%#v) failed with %v but changed the vector to %v", src, err, v)
+		}
+	}
+}
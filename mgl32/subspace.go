@@ -0,0 +1,52 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// ProjectOntoLine returns the orthogonal projection of v onto the line through
+// the origin with direction dir, (v.dir / dir.dir) * dir. The direction doesn't
+// need to be normalized, but must not be zero.
+func ProjectOntoLine(v, dir Vec3) Vec3 {
+	return dir.Mul(v.Dot(dir) / dir.Dot(dir))
+}
+
+// RejectFrom returns the rejection of v from the direction n: the component of
+// v perpendicular to n, such that v = ProjectOntoLine(v, n) + RejectFrom(v, n).
+// The direction doesn't need to be normalized, but must not be zero.
+func RejectFrom(v, n Vec3) Vec3 {
+	return v.Sub(ProjectOntoLine(v, n))
+}
+
+// ProjectOntoPlane returns the orthogonal projection of v onto the plane through
+// the origin with normal n, which is the rejection of v from n. The normal
+// doesn't need to be normalized, but must not be zero.
+func ProjectOntoPlane(v, n Vec3) Vec3 {
+	return RejectFrom(v, n)
+}
+
+// ProjectionMat3 returns the matrix of ProjectOntoPlane for the normal n,
+// I - n*n^T/(n.n), which for instance flattens the columns of a basis onto
+// the plane in one multiplication.
+func ProjectionMat3(n Vec3) Mat3 {
+	return Ident3().Sub(LineProjectionMat3(n))
+}
+
+// LineProjectionMat3 returns the matrix of ProjectOntoLine for the direction
+// dir, dir*dir^T/(dir.dir).
+func LineProjectionMat3(dir Vec3) Mat3 {
+	return dir.OuterProd3(dir).Mul(1 / dir.Dot(dir))
+}
+
+// ShadowMat4 returns the planar shadow matrix, which projects points onto the
+// plane given as (a,b,c,d), where ax + by + cz + d = 0, along the rays from the
+// light at the homogeneous position light. For a directional light, light is
+// the direction towards it with w = 0. Rendering geometry transformed by it
+// draws its shadow flattened on the plane (Blinn, "Me and My (Fake) Shadow").
+//
+// The matrix is (plane.light)*I - light*plane^T; the results have to be divided
+// by their w, which the perspective division does when rendering.
+func ShadowMat4(plane, light Vec4) Mat4 {
+	m := Ident4().Mul(plane.Dot(light))
+	return m.Sub(light.OuterProd4(plane))
+}
@@ -0,0 +1,78 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestProjectOntoSubspaces(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		v, n      Vec3
+		onLine    Vec3
+		rejection Vec3
+	}{
+		{Vec3{3, 4, 5}, Vec3{0, 0, 2}, Vec3{0, 0, 5}, Vec3{3, 4, 0}},
+		{Vec3{1, 1, 0}, Vec3{1, 0, 0}, Vec3{1, 0, 0}, Vec3{0, 1, 0}},
+		{Vec3{2, 0, 0}, Vec3{1, 1, 0}, Vec3{1, 1, 0}, Vec3{1, -1, 0}},
+		{Vec3{0, 3, 0}, Vec3{-1, 0, 0}, Vec3{0, 0, 0}, Vec3{0, 3, 0}},
+	}
+
+	approx := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+	for _, c := range tests {
+		if p := ProjectOntoLine(c.v, c.n); !p.ApproxFuncEqual(c.onLine, approx) {
+			t.Errorf("ProjectOntoLine(%v, %v) = %v, want %v", c.v, c.n, p, c.onLine)
+		}
+		if r := RejectFrom(c.v, c.n); !r.ApproxFuncEqual(c.rejection, approx) {
+			t.Errorf("RejectFrom(%v, %v) = %v, want %v", c.v, c.n, r, c.rejection)
+		}
+		if p := ProjectOntoPlane(c.v, c.n); !p.ApproxFuncEqual(c.rejection, approx) {
+			t.Errorf("ProjectOntoPlane(%v, %v) = %v, want %v", c.v, c.n, p, c.rejection)
+		}
+		if p := ProjectionMat3(c.n).Mul3x1(c.v); !p.ApproxFuncEqual(c.rejection, approx) {
+			t.Errorf("ProjectionMat3(%v) * %v = %v, want %v", c.n, c.v, p, c.rejection)
+		}
+		if p := LineProjectionMat3(c.n).Mul3x1(c.v); !p.ApproxFuncEqual(c.onLine, approx) {
+			t.Errorf("LineProjectionMat3(%v) * %v = %v, want %v", c.n, c.v, p, c.onLine)
+		}
+	}
+
+	// Projections are idempotent.
+	m := ProjectionMat3(Vec3{1, 2, 3})
+	if !m.Mul3(m).ApproxFuncEqual(m, approx) {
+		t.Errorf("ProjectionMat3 isn't idempotent: %v", m.Mul3(m))
+	}
+}
+
+func TestShadowMat4(t *testing.T) {
+	t.Parallel()
+
+	// The ground plane y = 1.
+	plane := Vec4{0, 1, 0, -1}
+	approx := func(a, b float32) bool { return Abs(a-b) < 1e-5 }
+
+	// A point light at (0, 5, 0): the point (1, 3, 2) is halfway down, so its
+	// shadow is twice as far out.
+	m := ShadowMat4(plane, Vec4{0, 5, 0, 1})
+	s := m.Mul4x1(Vec4{1, 3, 2, 1})
+	if p := s.Vec3().Mul(1 / s[3]); !p.ApproxFuncEqual(Vec3{2, 1, 4}, approx) {
+		t.Errorf("Point light shadow = %v, want {2, 1, 4}", p)
+	}
+
+	// A directional light shining along -y offset by -x.
+	m = ShadowMat4(plane, Vec4{1, 1, 0, 0})
+	s = m.Mul4x1(Vec4{3, 4, 0, 1})
+	if p := s.Vec3().Mul(1 / s[3]); !p.ApproxFuncEqual(Vec3{0, 1, 0}, approx) {
+		t.Errorf("Directional light shadow = %v, want {0, 1, 0}", p)
+	}
+
+	// Points on the plane are their own shadows.
+	s = m.Mul4x1(Vec4{-2, 1, 7, 1})
+	if p := s.Vec3().Mul(1 / s[3]); !p.ApproxFuncEqual(Vec3{-2, 1, 7}, approx) {
+		t.Errorf("Shadow of a point on the plane = %v, want {-2, 1, 7}", p)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// SVD computes the singular value decomposition of the matrix, such that
+// m = u * Diag3(s) * v^T, where u and v are orthogonal and the singular values
+// in s are non-negative and sorted in decreasing order.
+//
+// This uses the one-sided Jacobi method (repeatedly rotating pairs of columns
+// of m until they are orthogonal), which is accurate even for nearly singular
+// matrices. Note that u and v may be reflections (have a determinant of -1);
+// algorithms that need proper rotations must fix up the signs themselves.
+func (m Mat3) SVD() (u Mat3, s Vec3, v Mat3) {
+	const maxSweeps = 32
+
+	a := [3]Vec3{m.Col(0), m.Col(1), m.Col(2)}
+	vc := [3]Vec3{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+	for sweep := 0; sweep < maxSweeps; sweep++ {
+		rotated := false
+		for p := 0; p < 2; p++ {
+			for q := p + 1; q < 3; q++ {
+				alpha, beta, gamma := a[p].Dot(a[p]), a[q].Dot(a[q]), a[p].Dot(a[q])
+				if gamma == 0 || Abs(gamma) <= 1e-7*float32(math.Sqrt(float64(alpha*beta))) {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (Abs(zeta) + float32(math.Sqrt(float64(1+zeta*zeta))))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / float32(math.Sqrt(float64(1+t*t)))
+				sn := c * t
+
+				a[p], a[q] = a[p].Mul(c).Sub(a[q].Mul(sn)), a[p].Mul(sn).Add(a[q].Mul(c))
+				vc[p], vc[q] = vc[p].Mul(c).Sub(vc[q].Mul(sn)), vc[p].Mul(sn).Add(vc[q].Mul(c))
+			}
+		}
+		if !rotated {
+			break
+		}
+	}
+
+	// Sort by decreasing singular value
+	order := [3]int{0, 1, 2}
+	for i := range s {
+		s[i] = a[i].Len()
+	}
+	for i := 0; i < 3; i++ {
+		for j := i + 1; j < 3; j++ {
+			if s[order[j]] > s[order[i]] {
+				order[i], order[j] = order[j], order[i]
+			}
+		}
+	}
+
+	var uc [3]Vec3
+	var sorted Vec3
+	for i, j := range order {
+		sorted[i] = s[j]
+		v.SetCol(i, vc[j])
+		if s[j] > 0 {
+			uc[i] = a[j].Mul(1 / s[j])
+		}
+	}
+
+	// Columns corresponding to zero singular values are arbitrary, as long as
+	// they complete an orthonormal basis.
+	if sorted[0] == 0 {
+		uc[0] = Vec3{1, 0, 0}
+	}
+	if sorted[1] == 0 {
+		uc[1] = anyPerpendicular(uc[0])
+	}
+	if sorted[2] == 0 {
+		uc[2] = uc[0].Cross(uc[1]).Normalize()
+	}
+	u = Mat3FromCols(uc[0], uc[1], uc[2])
+
+	return u, sorted, v
+}
+
+// anyPerpendicular returns some unit vector perpendicular to the unit vector v.
+func anyPerpendicular(v Vec3) Vec3 {
+	if Abs(v[0]) < 0.6 {
+		return Vec3{1, 0, 0}.Cross(v).Normalize()
+	}
+	return Vec3{0, 1, 0}.Cross(v).Normalize()
+}
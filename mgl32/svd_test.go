@@ -0,0 +1,42 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestMat3SVD(t *testing.T) {
+	t.Parallel()
+
+	tests := []Mat3{
+		Ident3(),
+		{1, 2, 3, 4, 5, 6, 7, 8, 10},
+		{2, 0, 0, 0, -3, 0, 0, 0, 0.5},
+		{1, 2, 3, 2, 4, 6, 3, 6, 9}, // Rank 1
+		{},
+		Rotate3DX(0.3).Mul3(Diag3(Vec3{5, 1, 0.001})).Mul3(Rotate3DZ(1.2)),
+	}
+
+	near := func(a, b float32) bool { return Abs(a-b) < 1e-4 }
+	for _, m := range tests {
+		u, s, v := m.SVD()
+
+		if s[0] < s[1] || s[1] < s[2] || s[2] < 0 {
+			t.Errorf("Singular values of %v are not sorted and non-negative: %v", m, s)
+		}
+		if !u.Transpose().Mul3(u).ApproxFuncEqual(Ident3(), near) {
+			t.Errorf("U is not orthogonal for %v: %v", m, u)
+		}
+		if !v.Transpose().Mul3(v).ApproxFuncEqual(Ident3(), near) {
+			t.Errorf("V is not orthogonal for %v: %v", m, v)
+		}
+
+		recon := u.Mul3(Diag3(s)).Mul3(v.Transpose())
+		if !recon.ApproxFuncEqual(m, near) {
+			t.Errorf("U*S*V^T = %v, expected %v", recon, m)
+		}
+	}
+}
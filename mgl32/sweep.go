@@ -0,0 +1,235 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// SweptSphere is a sphere of the given radius moving from Origin along the
+// unit direction Dir, up to a distance of MaxDist. It is used for continuous
+// collision queries, which find the first contact of the moving sphere with
+// an obstacle instead of only testing its final position, and so cannot
+// tunnel through thin obstacles.
+//
+// The queries return the time of impact toi, the distance travelled along Dir
+// until the first contact, in [0, MaxDist], along with the contact normal: the
+// unit vector from the contact point on the obstacle to the center of the
+// sphere at that time. If the sphere initially overlaps the obstacle, toi is
+// 0.
+type SweptSphere struct {
+	Origin  Vec3
+	Radius  float32
+	Dir     Vec3
+	MaxDist float32
+}
+
+// SphereCast returns the sphere of the given radius swept from origin along
+// dir, up to a distance of maxDist. Dir doesn't need to be normalized.
+func SphereCast(origin Vec3, radius float32, dir Vec3, maxDist float32) SweptSphere {
+	return SweptSphere{origin, radius, dir.Normalize(), maxDist}
+}
+
+// Sphere finds the first contact of the swept sphere with the sphere target.
+func (s SweptSphere) Sphere(target Sphere) (toi float32, normal Vec3, hit bool) {
+	toi, hit = raySphere(s.Origin, s.Dir, target.Center, s.Radius+target.Radius)
+	if !hit || toi > s.MaxDist {
+		return 0, Vec3{}, false
+	}
+	return toi, s.contactNormal(toi, target.Center), true
+}
+
+// Triangle finds the first contact of the swept sphere with the triangle
+// (a,b,c), from either side.
+func (s SweptSphere) Triangle(a, b, c Vec3) (toi float32, normal Vec3, hit bool) {
+	if p := ClosestPointTriangle(s.Origin, a, b, c); p.Sub(s.Origin).LenSqr() <= s.Radius*s.Radius {
+		return 0, s.contactNormal(0, p), true
+	}
+
+	// The space swept by the sphere meets the triangle either on its face,
+	// or on the rounded edges of its Minkowski sum with the sphere.
+	toi, hit = InfPos, false
+	if n := b.Sub(a).Cross(c.Sub(a)); n.LenSqr() != 0 {
+		n = n.Normalize()
+		dist := n.Dot(s.Origin.Sub(a))
+		if dist < 0 {
+			n, dist = n.Mul(-1), -dist
+		}
+		// A sphere already cutting the plane outside the triangle can only
+		// touch it on an edge first, so t < 0 leaves it to the edge tests.
+		if denom := n.Dot(s.Dir); denom < 0 {
+			t := (s.Radius - dist) / denom
+			q := s.Origin.Add(s.Dir.Mul(t)).Sub(n.Mul(s.Radius))
+			if t >= 0 && pointInTriangle(q, a, b, c, n) {
+				toi, hit = t, true
+			}
+		}
+	}
+	for i, v := range [3]Vec3{a, b, c} {
+		w := [3]Vec3{b, c, a}[i]
+		if t, ok := rayCapsule(s.Origin, s.Dir, v, w, s.Radius); ok && t < toi {
+			toi, hit = t, true
+		}
+	}
+
+	if !hit || toi > s.MaxDist {
+		return 0, Vec3{}, false
+	}
+	center := s.Origin.Add(s.Dir.Mul(toi))
+	return toi, s.contactNormal(toi, ClosestPointTriangle(center, a, b, c)), true
+}
+
+// AABB finds the first contact of the swept sphere with the box.
+func (s SweptSphere) AABB(box AABB) (toi float32, normal Vec3, hit bool) {
+	if p := clampToAABB(s.Origin, box); p.Sub(s.Origin).LenSqr() <= s.Radius*s.Radius {
+		return 0, s.contactNormal(0, p), true
+	}
+
+	// The Minkowski sum of the box and the sphere is the union of the box
+	// grown by the radius along each axis, and capsules around the twelve
+	// edges of the box.
+	toi, hit = InfPos, false
+	for i := 0; i < 3; i++ {
+		grown := box
+		grown.Min[i] -= s.Radius
+		grown.Max[i] += s.Radius
+		if t, ok := rayAABB(s.Origin, s.Dir, grown); ok && t < toi {
+			toi, hit = t, true
+		}
+	}
+	for i := 0; i < 8; i++ {
+		corner := Vec3{box.Min[0], box.Min[1], box.Min[2]}
+		for k := 0; k < 3; k++ {
+			if i&(1<<uint(k)) != 0 {
+				corner[k] = box.Max[k]
+			}
+		}
+		// Edges from the corners on the min side of each axis
+		for k := 0; k < 3; k++ {
+			if i&(1<<uint(k)) != 0 {
+				continue
+			}
+			other := corner
+			other[k] = box.Max[k]
+			if t, ok := rayCapsule(s.Origin, s.Dir, corner, other, s.Radius); ok && t < toi {
+				toi, hit = t, true
+			}
+		}
+	}
+
+	if !hit || toi > s.MaxDist {
+		return 0, Vec3{}, false
+	}
+	center := s.Origin.Add(s.Dir.Mul(toi))
+	return toi, s.contactNormal(toi, clampToAABB(center, box)), true
+}
+
+// contactNormal returns the unit vector from the contact point p to the center
+// of the sphere after travelling toi. If they coincide, i.e. the sphere's
+// center starts inside the obstacle, the normal opposes the motion.
+func (s SweptSphere) contactNormal(toi float32, p Vec3) Vec3 {
+	n := s.Origin.Add(s.Dir.Mul(toi)).Sub(p)
+	if n.LenSqr() == 0 {
+		return s.Dir.Mul(-1)
+	}
+	return n.Normalize()
+}
+
+// raySphere returns the distance along the ray from o with unit direction d to
+// the first intersection with the sphere at c of radius r, or 0 if o is inside
+// the sphere.
+func raySphere(o, d, c Vec3, r float32) (float32, bool) {
+	m := o.Sub(c)
+	b, cc := m.Dot(d), m.LenSqr()-r*r
+	if cc <= 0 {
+		return 0, true
+	}
+	if b > 0 {
+		return 0, false
+	}
+	disc := b*b - cc
+	if disc < 0 {
+		return 0, false
+	}
+	return -b - float32(math.Sqrt(float64(disc))), true
+}
+
+// rayCapsule returns the distance along the ray from o with unit direction d
+// to the first intersection with the capsule of radius r around the segment
+// [a,b]. The origin must be outside of the capsule.
+func rayCapsule(o, d, a, b Vec3, r float32) (float32, bool) {
+	ab, m := b.Sub(a), o.Sub(a)
+	md, nd, dd := m.Dot(ab), d.Dot(ab), ab.LenSqr()
+
+	t, hit := InfPos, false
+	// The side of the cylinder
+	if qa := dd - nd*nd; qa > 0 {
+		qb := dd*m.Dot(d) - nd*md
+		qc := dd*(m.LenSqr()-r*r) - md*md
+		if disc := qb*qb - qa*qc; disc >= 0 {
+			tc := (-qb - float32(math.Sqrt(float64(disc)))) / qa
+			if s := md + tc*nd; tc >= 0 && s >= 0 && s <= dd {
+				t, hit = tc, true
+			}
+		}
+	}
+	// The spherical caps
+	for _, c := range [2]Vec3{a, b} {
+		if ts, ok := raySphere(o, d, c, r); ok && ts < t {
+			t, hit = ts, true
+		}
+	}
+	return t, hit
+}
+
+// rayAABB returns the distance along the ray from o with direction d to its
+// entry point in box, or 0 if o is inside the box, using the slab test.
+func rayAABB(o, d Vec3, box AABB) (float32, bool) {
+	tmin, _, ok := rayAABBRange(o, d, box)
+	return tmin, ok
+}
+
+// rayAABBRange returns the range of distances along the ray from o with
+// direction d, clipped to t >= 0, within which it is inside box.
+func rayAABBRange(o, d Vec3, box AABB) (tmin, tmax float32, ok bool) {
+	tmin, tmax = 0, InfPos
+	for i := 0; i < 3; i++ {
+		if d[i] == 0 {
+			if o[i] < box.Min[i] || o[i] > box.Max[i] {
+				return 0, 0, false
+			}
+			continue
+		}
+		inv := 1 / d[i]
+		t1, t2 := (box.Min[i]-o[i])*inv, (box.Max[i]-o[i])*inv
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		SetMax(&tmin, &t1)
+		SetMin(&tmax, &t2)
+		if tmin > tmax {
+			return 0, 0, false
+		}
+	}
+	return tmin, tmax, true
+}
+
+// clampToAABB returns the point of the box closest to p.
+func clampToAABB(p Vec3, box AABB) Vec3 {
+	return Vec3{
+		Clamp(p[0], box.Min[0], box.Max[0]),
+		Clamp(p[1], box.Min[1], box.Max[1]),
+		Clamp(p[2], box.Min[2], box.Max[2]),
+	}
+}
+
+// pointInTriangle reports whether p, which lies in the plane of the triangle
+// (a,b,c) with unit normal n, is inside the triangle.
+func pointInTriangle(p, a, b, c, n Vec3) bool {
+	e0 := b.Sub(a).Cross(p.Sub(a)).Dot(n)
+	e1 := c.Sub(b).Cross(p.Sub(b)).Dot(n)
+	e2 := a.Sub(c).Cross(p.Sub(c)).Dot(n)
+	return e0 >= 0 && e1 >= 0 && e2 >= 0 || e0 <= 0 && e1 <= 0 && e2 <= 0
+}
@@ -0,0 +1,83 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestSphereCastSphere(t *testing.T) {
+	t.Parallel()
+
+	cast := SphereCast(Vec3{-10, 0, 0}, 1, Vec3{2, 0, 0}, 20)
+	toi, n, hit := cast.Sphere(Sphere{Vec3{0, 0, 0}, 2})
+	if !hit || !FloatEqualThreshold(toi, 7, 1e-5) || !n.ApproxEqualThreshold(Vec3{-1, 0, 0}, 1e-5) {
+		t.Errorf("Sphere cast against a sphere = %v, %v, %v; expected 7, [-1 0 0], true", toi, n, hit)
+	}
+
+	if _, _, hit := SphereCast(Vec3{-10, 0, 0}, 1, Vec3{1, 0, 0}, 5).Sphere(Sphere{Vec3{}, 2}); hit {
+		t.Errorf("Sphere cast hit a sphere beyond its maximum distance")
+	}
+	if _, _, hit := SphereCast(Vec3{-10, 3.5, 0}, 1, Vec3{1, 0, 0}, 20).Sphere(Sphere{Vec3{}, 2}); hit {
+		t.Errorf("Sphere cast hit a sphere it passes beside")
+	}
+}
+
+func TestSphereCastTriangle(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := Vec3{0, 0, 0}, Vec3{4, 0, 0}, Vec3{0, 4, 0}
+
+	tests := []struct {
+		Description string
+		Cast        SweptSphere
+		Hit         bool
+		TOI         float32
+		Normal      Vec3
+	}{
+		{"Face", SphereCast(Vec3{1, 1, 5}, 1, Vec3{0, 0, -1}, 10), true, 4, Vec3{0, 0, 1}},
+		{"Back face", SphereCast(Vec3{1, 1, -5}, 1, Vec3{0, 0, 1}, 10), true, 4, Vec3{0, 0, -1}},
+		{"Edge", SphereCast(Vec3{2, -3, 0}, 1, Vec3{0, 1, 0}, 10), true, 2, Vec3{0, -1, 0}},
+		{"Vertex", SphereCast(Vec3{-5, 0, 0}, 1, Vec3{1, 0, 0}, 10), true, 4, Vec3{-1, 0, 0}},
+		{"Initially overlapping", SphereCast(Vec3{1, 1, 0.5}, 1, Vec3{1, 0, 0}, 10), true, 0, Vec3{0, 0, 1}},
+		{"Out of reach", SphereCast(Vec3{1, 1, 5}, 1, Vec3{0, 0, -1}, 3), false, 0, Vec3{}},
+		{"Parallel", SphereCast(Vec3{-5, -5, 2}, 1, Vec3{1, 1, 0}, 20), false, 0, Vec3{}},
+		{"Cutting the plane, moving away", SphereCast(Vec3{2, -1.5, 0.5}, 1, Vec3{0, -1, -0.2}, 10), false, 0, Vec3{}},
+	}
+
+	for _, test := range tests {
+		toi, n, hit := test.Cast.Triangle(a, b, c)
+		if hit != test.Hit || hit && (Abs(toi-test.TOI) > 1e-4 || !n.ApproxFuncEqual(test.Normal, func(x, y float32) bool { return Abs(x-y) < 1e-4 })) {
+			t.Errorf("%s: got %v, %v, %v; expected %v, %v, %v", test.Description, toi, n, hit, test.TOI, test.Normal, test.Hit)
+		}
+	}
+}
+
+func TestSphereCastAABB(t *testing.T) {
+	t.Parallel()
+
+	box := AABB{Vec3{-1, -1, -1}, Vec3{1, 1, 1}}
+
+	tests := []struct {
+		Description string
+		Cast        SweptSphere
+		Hit         bool
+		TOI         float32
+		Normal      Vec3
+	}{
+		{"Face", SphereCast(Vec3{-5, 0, 0}, 1, Vec3{1, 0, 0}, 10), true, 3, Vec3{-1, 0, 0}},
+		{"Edge", SphereCast(Vec3{-5, 1.5, 0}, 1, Vec3{1, 0, 0}, 10), true, 4 - float32(0.8660254), Vec3{-0.8660254, 0.5, 0}},
+		{"Corner", SphereCast(Vec3{5, 5, 5}, 1, Vec3{-1, -1, -1}, 10), true, float32(0.8660254)*8 - 1, Vec3{1, 1, 1}.Normalize()},
+		{"Missing the rounded edge", SphereCast(Vec3{-5, 1.9, 1.9}, 1, Vec3{1, 0, 0}, 10), false, 0, Vec3{}},
+		{"Initially overlapping", SphereCast(Vec3{0, 0, 1.5}, 1, Vec3{1, 0, 0}, 10), true, 0, Vec3{0, 0, 1}},
+	}
+
+	for _, test := range tests {
+		toi, n, hit := test.Cast.AABB(box)
+		if hit != test.Hit || hit && (Abs(toi-test.TOI) > 1e-4 || !n.ApproxFuncEqual(test.Normal, func(x, y float32) bool { return Abs(x-y) < 1e-4 })) {
+			t.Errorf("%s: got %v, %v, %v; expected %v, %v, %v", test.Description, toi, n, hit, test.TOI, test.Normal, test.Hit)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// Strides returns the strides of a densely packed 3D array with the given
+// dimensions, x varying fastest: {1, dims[0], dims[0]*dims[1]}. This is the
+// layout of 3D textures and of texture arrays, with z as the layer, and of
+// compute shader dispatches flattened with gl_GlobalInvocationID.
+func (dims Vec3i) Strides() Vec3i {
+	return Vec3i{1, dims[0], dims[0] * dims[1]}
+}
+
+// LinearIndex returns the index of the element at v in an array with the
+// given strides, as returned by Strides for a densely packed one.
+func (v Vec3i) LinearIndex(strides Vec3i) int {
+	return int(v[0])*int(strides[0]) + int(v[1])*int(strides[1]) + int(v[2])*int(strides[2])
+}
+
+// Vec3iFromLinearIndex returns the coordinates of the element at index in a
+// densely packed array with the given dimensions, the inverse of
+// v.LinearIndex(dims.Strides()). Index must be non-negative.
+func Vec3iFromLinearIndex(index int, dims Vec3i) Vec3i {
+	x, y := int(dims[0]), int(dims[1])
+	return Vec3i{int32(index % x), int32(index / x % y), int32(index / (x * y))}
+}
+
+// MipDims returns the dimensions of the given mip level of a texture with the
+// given base dimensions: each is halved, rounding down, per level, but never
+// drops below 1. 2D textures have a depth of 1; for texture arrays, whose
+// layer count doesn't shrink, pass a depth of 1 and keep the layer count.
+func MipDims(dims Vec3i, level int) Vec3i {
+	for i := range dims {
+		dims[i] >>= uint(level)
+		if dims[i] < 1 {
+			dims[i] = 1
+		}
+	}
+	return dims
+}
+
+// MipLevels returns the number of levels in the full mip chain of a texture
+// with the given base dimensions, down to the level where every dimension is
+// 1. As with MipDims, texture arrays should pass a depth of 1.
+func MipLevels(dims Vec3i) int {
+	m := dims[0]
+	if dims[1] > m {
+		m = dims[1]
+	}
+	if dims[2] > m {
+		m = dims[2]
+	}
+
+	levels := 1
+	for ; m > 1; m >>= 1 {
+		levels++
+	}
+	return levels
+}
+
+// TexelCenterUV returns the normalized texture coordinates of the center of
+// the given texel of a texture of the given size, (texel + 0.5) / size, which
+// sample it exactly without filtering with its neighbours.
+func TexelCenterUV(texel, size Vec2i) Vec2 {
+	return Vec2{
+		(float32(texel[0]) + 0.5) / float32(size[0]),
+		(float32(texel[1]) + 0.5) / float32(size[1]),
+	}
+}
+
+// TexelCenterUVW is the 3D texture version of TexelCenterUV.
+func TexelCenterUVW(texel, size Vec3i) Vec3 {
+	return Vec3{
+		(float32(texel[0]) + 0.5) / float32(size[0]),
+		(float32(texel[1]) + 0.5) / float32(size[1]),
+		(float32(texel[2]) + 0.5) / float32(size[2]),
+	}
+}
+
+// UVToTexel returns the texel containing the normalized texture coordinates
+// uv of a texture of the given size, the inverse of TexelCenterUV. The result
+// isn't clamped or wrapped to the texture.
+func UVToTexel(uv Vec2, size Vec2i) Vec2i {
+	return Vec2i{
+		int32(math.Floor(float64(uv[0]) * float64(size[0]))),
+		int32(math.Floor(float64(uv[1]) * float64(size[1]))),
+	}
+}
+
+// UVWToTexel is the 3D texture version of UVToTexel.
+func UVWToTexel(uvw Vec3, size Vec3i) Vec3i {
+	return Vec3i{
+		int32(math.Floor(float64(uvw[0]) * float64(size[0]))),
+		int32(math.Floor(float64(uvw[1]) * float64(size[1]))),
+		int32(math.Floor(float64(uvw[2]) * float64(size[2]))),
+	}
+}
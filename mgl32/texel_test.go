@@ -0,0 +1,107 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "testing"
+
+func TestLinearIndex(t *testing.T) {
+	t.Parallel()
+
+	dims := Vec3i{4, 3, 2}
+	strides := dims.Strides()
+	if want := (Vec3i{1, 4, 12}); strides != want {
+		t.Errorf("%v.Strides() = %v, want %v", dims, strides, want)
+	}
+
+	i := 0
+	for z := int32(0); z < dims[2]; z++ {
+		for y := int32(0); y < dims[1]; y++ {
+			for x := int32(0); x < dims[0]; x++ {
+				v := Vec3i{x, y, z}
+				if got := v.LinearIndex(strides); got != i {
+					t.Errorf("%v.LinearIndex(%v) = %d, want %d", v, strides, got, i)
+				}
+				if got := Vec3iFromLinearIndex(i, dims); got != v {
+					t.Errorf("Vec3iFromLinearIndex(%d, %v) = %v, want %v", i, dims, got, v)
+				}
+				i++
+			}
+		}
+	}
+
+	// Padded rows.
+	if got := (Vec3i{1, 2, 3}).LinearIndex(Vec3i{1, 256, 1024}); got != 3585 {
+		t.Errorf("LinearIndex with padded strides = %d, want 3585", got)
+	}
+}
+
+func TestMipDims(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dims   Vec3i
+		level  int
+		want   Vec3i
+		levels int
+	}{
+		{Vec3i{256, 256, 1}, 0, Vec3i{256, 256, 1}, 9},
+		{Vec3i{256, 256, 1}, 3, Vec3i{32, 32, 1}, 9},
+		{Vec3i{256, 64, 16}, 5, Vec3i{8, 2, 1}, 9},
+		{Vec3i{300, 17, 1}, 4, Vec3i{18, 1, 1}, 9},
+		{Vec3i{300, 17, 1}, 8, Vec3i{1, 1, 1}, 9},
+		{Vec3i{1, 1, 1}, 0, Vec3i{1, 1, 1}, 1},
+		{Vec3i{5, 3, 9}, 1, Vec3i{2, 1, 4}, 4},
+	}
+
+	for _, test := range tests {
+		if got := MipDims(test.dims, test.level); got != test.want {
+			t.Errorf("MipDims(%v, %d) = %v, want %v", test.dims, test.level, got, test.want)
+		}
+		if got := MipLevels(test.dims); got != test.levels {
+			t.Errorf("MipLevels(%v) = %d, want %d", test.dims, got, test.levels)
+		}
+		if got := MipDims(test.dims, test.levels-1); got != (Vec3i{1, 1, 1}) {
+			t.Errorf("MipDims(%v, MipLevels-1) = %v, want {1, 1, 1}", test.dims, got)
+		}
+	}
+}
+
+func TestTexelCenterUV(t *testing.T) {
+	t.Parallel()
+
+	size := Vec2i{4, 8}
+	tests := []struct {
+		texel Vec2i
+		uv    Vec2
+	}{
+		{Vec2i{0, 0}, Vec2{0.125, 0.0625}},
+		{Vec2i{3, 7}, Vec2{0.875, 0.9375}},
+		{Vec2i{2, 4}, Vec2{0.625, 0.5625}},
+	}
+
+	for _, test := range tests {
+		uv := TexelCenterUV(test.texel, size)
+		if !uv.ApproxEqual(test.uv) {
+			t.Errorf("TexelCenterUV(%v, %v) = %v, want %v", test.texel, size, uv, test.uv)
+		}
+		if got := UVToTexel(uv, size); got != test.texel {
+			t.Errorf("UVToTexel(%v, %v) = %v, want %v", uv, size, got, test.texel)
+		}
+	}
+
+	if got := UVToTexel(Vec2{-0.1, 1}, size); got != (Vec2i{-1, 8}) {
+		t.Errorf("UVToTexel outside the texture = %v, want {-1, 8}", got)
+	}
+
+	size3 := Vec3i{2, 4, 8}
+	texel := Vec3i{1, 2, 3}
+	uvw := TexelCenterUVW(texel, size3)
+	if want := (Vec3{0.75, 0.625, 0.4375}); !uvw.ApproxEqual(want) {
+		t.Errorf("TexelCenterUVW(%v, %v) = %v, want %v", texel, size3, uvw, want)
+	}
+	if got := UVWToTexel(uvw, size3); got != texel {
+		t.Errorf("UVWToTexel(%v, %v) = %v, want %v", uvw, size3, got, texel)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+)
+
+// BallisticPosition returns the position at time t of a projectile launched
+// from origin with the given velocity, under constant gravity and no drag:
+// origin + velocity*t + gravity*t^2/2.
+func BallisticPosition(origin, velocity, gravity Vec3, t float32) Vec3 {
+	return origin.Add(velocity.Mul(t)).Add(gravity.Mul(t * t / 2))
+}
+
+// BallisticLaunchAngles returns the two angles above the horizontal, in
+// radians, at which a projectile launched at the given speed hits a target dx
+// away horizontally and dy above the launch point, under a gravity of the
+// given (positive) magnitude. The low angle is the direct, faster shot and
+// the high one the lob; they are equal if the target is at the limit of the
+// range, and ok is false beyond it.
+func BallisticLaunchAngles(speed, gravity, dx, dy float32) (low, high float32, ok bool) {
+	v2, g, x := float64(speed)*float64(speed), float64(gravity), math.Abs(float64(dx))
+	disc := v2*v2 - g*(g*x*x+2*float64(dy)*v2)
+	if disc < 0 {
+		return 0, 0, false
+	}
+	root := math.Sqrt(disc)
+
+	// tan(angle) = (v^2 -+ root) / (g*x), without dividing by x.
+	return float32(math.Atan2(v2-root, g*x)), float32(math.Atan2(v2+root, g*x)), true
+}
+
+// BallisticLaunchVelocity returns the launch velocity of the given speed at
+// which a projectile from origin hits target under the constant acceleration
+// gravity, and its flight time. If high is true, the result is the lob,
+// otherwise the direct shot; ok is false if the target is out of range.
+//
+// Solving for the flight time t first, from |target-origin - gravity*t^2/2| =
+// speed*t, handles any direction of gravity and targets straight above or
+// below alike. Without gravity, the shot is straight at the target.
+func BallisticLaunchVelocity(origin, target Vec3, speed float32, gravity Vec3, high bool) (velocity Vec3, flightTime float32, ok bool) {
+	d := target.Sub(origin)
+	v2, g2 := float64(speed)*float64(speed), dot64(gravity, gravity)
+	if g2 == 0 {
+		dist := float32(math.Sqrt(dot64(d, d)))
+		if dist == 0 || speed <= 0 {
+			return Vec3{}, 0, false
+		}
+		return d.Mul(speed / dist), dist / speed, true
+	}
+
+	// With b = v^2 + d.g, t^2 = 2 (b -+ sqrt(b^2 - g^2 |d|^2)) / g^2.
+	b := v2 + dot64(d, gravity)
+	disc := b*b - g2*dot64(d, d)
+	if disc < 0 {
+		return Vec3{}, 0, false
+	}
+	root := math.Sqrt(disc)
+	if !high {
+		root = -root
+	}
+	t2 := 2 * (b + root) / g2
+	if t2 <= 0 {
+		return Vec3{}, 0, false
+	}
+
+	t := math.Sqrt(t2)
+	return d.Mul(float32(1 / t)).Sub(gravity.Mul(float32(t / 2))), float32(t), true
+}
+
+// Catenary is the curve y = A*cosh((x-X0)/A) + Y0 of a uniform chain or cable
+// hanging in a vertical plane, with its lowest point at x = X0. A is the ratio
+// of the horizontal tension to the weight per unit length; the smaller it is,
+// the more it sags.
+type Catenary struct {
+	A, X0, Y0 float32
+}
+
+// CatenaryThrough returns the catenary of the given length hanging between
+// the points p0 and p1 of a vertical plane, with y up. ok is false if the
+// length is too short to span the points, or if they are vertically aligned,
+// in which case the cable hangs straight down.
+func CatenaryThrough(p0, p1 Vec2, length float32) (c Catenary, ok bool) {
+	if p1[0] < p0[0] {
+		p0, p1 = p1, p0
+	}
+	h, v, l := float64(p1[0]-p0[0]), float64(p1[1]-p0[1]), float64(length)
+	if h <= 0 || l*l <= h*h+v*v {
+		return Catenary{}, false
+	}
+
+	// With xi = h/(2A), sinh(xi)/xi = r. Newton's method from the right of the
+	// root converges monotonically, as sinh(xi) - r*xi is convex; both bounds
+	// are right of it.
+	r := math.Sqrt(l*l-v*v) / h
+	xi := math.Min(math.Sqrt(6*(r-1)), 2*math.Log(2*r)+1)
+	for i := 0; i < 100; i++ {
+		step := (math.Sinh(xi) - r*xi) / (math.Cosh(xi) - r)
+		xi -= step
+		if step < 1e-12*xi {
+			break
+		}
+	}
+
+	a := h / (2 * xi)
+	x0 := (float64(p0[0])+float64(p1[0]))/2 - a*math.Atanh(v/l)
+	y0 := float64(p0[1]) - a*math.Cosh((float64(p0[0])-x0)/a)
+	return Catenary{float32(a), float32(x0), float32(y0)}, true
+}
+
+// Y returns the height of the catenary at x.
+func (c Catenary) Y(x float32) float32 {
+	return c.A*float32(math.Cosh(float64((x-c.X0)/c.A))) + c.Y0
+}
+
+// ArcLength returns the length of the catenary between x0 and x1, negative
+// if x1 < x0.
+func (c Catenary) ArcLength(x0, x1 float32) float32 {
+	s := func(x float32) float64 { return math.Sinh(float64((x - c.X0) / c.A)) }
+	return c.A * float32(s(x1)-s(x0))
+}
+
+// CatenaryPoints returns n points, evenly spaced horizontally, of a cable of
+// the given length hanging between p0 and p1 under a gravity pulling against
+// the up direction, to draw power lines, ropes or chains. ok is false in the
+// cases CatenaryThrough rejects, or if n < 2.
+func CatenaryPoints(p0, p1, up Vec3, length float32, n int) (points []Vec3, ok bool) {
+	if n < 2 {
+		return nil, false
+	}
+	up = up.Normalize()
+	d := p1.Sub(p0)
+	dy := d.Dot(up)
+	across := d.Sub(up.Mul(dy))
+	h := across.Len()
+	if h == 0 {
+		return nil, false
+	}
+
+	c, ok := CatenaryThrough(Vec2{0, 0}, Vec2{h, dy}, length)
+	if !ok {
+		return nil, false
+	}
+
+	across = across.Mul(1 / h)
+	points = make([]Vec3, n)
+	for i := range points {
+		x := h * float32(i) / float32(n-1)
+		points[i] = p0.Add(across.Mul(x)).Add(up.Mul(c.Y(x)))
+	}
+	points[n-1] = p1
+	return points, true
+}
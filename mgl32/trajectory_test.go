@@ -0,0 +1,144 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBallisticLaunchAngles(t *testing.T) {
+	t.Parallel()
+
+	// At the maximum range on flat ground, both angles are 45 degrees.
+	low, high, ok := BallisticLaunchAngles(10, 10, 10, 0)
+	if !ok || !FloatEqualThreshold(low, math.Pi/4, 1e-6) || !FloatEqualThreshold(high, math.Pi/4, 1e-6) {
+		t.Errorf("BallisticLaunchAngles at maximum range = %v, %v, %v", low, high, ok)
+	}
+	if _, _, ok := BallisticLaunchAngles(10, 10, 10.1, 0); ok {
+		t.Errorf("BallisticLaunchAngles beyond maximum range succeeded")
+	}
+
+	// The angles hit the target.
+	low, high, ok = BallisticLaunchAngles(20, 9.81, 15, 3)
+	if !ok || low >= high {
+		t.Fatalf("BallisticLaunchAngles = %v, %v, %v", low, high, ok)
+	}
+	for _, a := range []float32{low, high} {
+		s, c := math.Sincos(float64(a))
+		tf := 15 / (20 * c)
+		if y := 20*s*tf - 9.81*tf*tf/2; math.Abs(y-3) > 1e-4 {
+			t.Errorf("launch angle %v reaches a height of %v at the target", a, y)
+		}
+	}
+}
+
+func TestBallisticLaunchVelocity(t *testing.T) {
+	t.Parallel()
+
+	gravity := Vec3{0, -9.81, 0}
+	tests := []struct {
+		origin, target Vec3
+		gravity        Vec3
+	}{
+		{Vec3{0, 0, 0}, Vec3{15, 3, -4}, gravity},
+		{Vec3{1, 2, 3}, Vec3{-10, -5, 8}, gravity},
+		{Vec3{0, 0, 0}, Vec3{0, 12, 0}, gravity},
+		{Vec3{0, 0, 0}, Vec3{0, -12, 0}, gravity},
+		{Vec3{0, 0, 0}, Vec3{10, 0, 5}, Vec3{0, 0, -3}},
+	}
+
+	for _, test := range tests {
+		for _, high := range []bool{false, true} {
+			v, tf, ok := BallisticLaunchVelocity(test.origin, test.target, 20, test.gravity, high)
+			if !ok {
+				t.Errorf("BallisticLaunchVelocity(%v, %v, high=%v) found no solution", test.origin, test.target, high)
+				continue
+			}
+			if !FloatEqualThreshold(v.Len(), 20, 1e-5) {
+				t.Errorf("BallisticLaunchVelocity(%v, %v, high=%v) = %v, of speed %v", test.origin, test.target, high, v, v.Len())
+			}
+			if p := BallisticPosition(test.origin, v, test.gravity, tf); !p.ApproxFuncEqual(test.target, absEqual(1e-3)) {
+				t.Errorf("BallisticLaunchVelocity(%v, %v, high=%v) lands at %v", test.origin, test.target, high, p)
+			}
+		}
+
+		vl, tl, _ := BallisticLaunchVelocity(test.origin, test.target, 20, test.gravity, false)
+		vh, th, _ := BallisticLaunchVelocity(test.origin, test.target, 20, test.gravity, true)
+		if tl > th || vl.Dot(test.gravity) < vh.Dot(test.gravity)-1e-3 {
+			t.Errorf("the direct shot %v (%vs) is slower or higher than the lob %v (%vs)", vl, tl, vh, th)
+		}
+	}
+
+	if _, _, ok := BallisticLaunchVelocity(Vec3{}, Vec3{100, 0, 0}, 20, gravity, false); ok {
+		t.Errorf("BallisticLaunchVelocity out of range succeeded")
+	}
+	if v, tf, ok := BallisticLaunchVelocity(Vec3{}, Vec3{3, 4, 0}, 10, Vec3{}, false); !ok || v != (Vec3{6, 8, 0}) || tf != 0.5 {
+		t.Errorf("BallisticLaunchVelocity without gravity = %v, %v, %v", v, tf, ok)
+	}
+}
+
+func TestCatenary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		p0, p1 Vec2
+		length float32
+	}{
+		{Vec2{0, 0}, Vec2{10, 0}, 12},
+		{Vec2{-3, 2}, Vec2{5, 6}, 9.5},
+		{Vec2{4, 1}, Vec2{0, -1}, 40},
+		{Vec2{0, 0}, Vec2{10, 0}, 10.001},
+	}
+
+	for _, test := range tests {
+		c, ok := CatenaryThrough(test.p0, test.p1, test.length)
+		if !ok {
+			t.Errorf("CatenaryThrough(%v, %v, %v) failed", test.p0, test.p1, test.length)
+			continue
+		}
+		for _, p := range []Vec2{test.p0, test.p1} {
+			if y := c.Y(p[0]); Abs(y-p[1]) > 1e-3 {
+				t.Errorf("%v.Y(%v) = %v, want %v", c, p[0], y, p[1])
+			}
+		}
+		if l := Abs(c.ArcLength(test.p0[0], test.p1[0])); !FloatEqualThreshold(l, test.length, 1e-4) {
+			t.Errorf("%v has length %v between the points, want %v", c, l, test.length)
+		}
+	}
+
+	c, _ := CatenaryThrough(Vec2{0, 0}, Vec2{10, 0}, 12)
+	if !FloatEqualThreshold(c.X0, 5, 1e-5) || c.Y(5) >= 0 {
+		t.Errorf("symmetric catenary %v doesn't sag in the middle", c)
+	}
+
+	if _, ok := CatenaryThrough(Vec2{0, 0}, Vec2{3, 4}, 5); ok {
+		t.Errorf("CatenaryThrough with a taut cable succeeded")
+	}
+	if _, ok := CatenaryThrough(Vec2{1, 0}, Vec2{1, 4}, 5); ok {
+		t.Errorf("CatenaryThrough of vertically aligned points succeeded")
+	}
+}
+
+func TestCatenaryPoints(t *testing.T) {
+	t.Parallel()
+
+	p0, p1 := Vec3{0, 10, 0}, Vec3{6, 12, 8}
+	points, ok := CatenaryPoints(p0, p1, Vec3{0, 1, 0}, 14, 200)
+	if !ok || len(points) != 200 || points[0] != p0 || points[199] != p1 {
+		t.Fatalf("CatenaryPoints = %v, %v", points, ok)
+	}
+
+	var length float32
+	for i := 1; i < len(points); i++ {
+		length += points[i].Sub(points[i-1]).Len()
+		if points[i].Y() < 10-5 {
+			t.Errorf("point %v hangs too low", points[i])
+		}
+	}
+	if !FloatEqualThreshold(length, 14, 1e-3) {
+		t.Errorf("CatenaryPoints has length %v, want 14", length)
+	}
+}
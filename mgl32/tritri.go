@@ -0,0 +1,173 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// TriTriIntersect tests whether the triangles (a0,a1,a2) and (b0,b1,b2)
+// intersect, and returns their intersection.
+//
+// When the triangles cross, their intersection is a segment along the line
+// where their planes meet, which is returned as is; it degenerates to a
+// single point if the triangles only touch at a vertex or edge. When the
+// triangles are coplanar and overlap, their intersection is a convex polygon
+// and the returned segment is its diameter: the two vertices of the polygon
+// farthest apart.
+//
+// Vertices closer than a small tolerance, relative to the size of the
+// triangles, to the plane of the other triangle are considered to lie on it.
+// Degenerate triangles never intersect anything.
+func TriTriIntersect(a0, a1, a2, b0, b1, b2 Vec3) (Segment3, bool) {
+	a := [3]Vec3{a0, a1, a2}
+	b := [3]Vec3{b0, b1, b2}
+
+	na := a1.Sub(a0).Cross(a2.Sub(a0))
+	nb := b1.Sub(b0).Cross(b2.Sub(b0))
+	if na.LenSqr() == 0 || nb.LenSqr() == 0 {
+		return Segment3{}, false
+	}
+	na, nb = na.Normalize(), nb.Normalize()
+
+	eps := 1e-5 * triTriScale(a, b)
+
+	// Signed distances of each triangle's vertices to the other's plane.
+	da, ok := triPlaneDistances(a, nb, b0, eps)
+	if !ok {
+		return Segment3{}, false
+	}
+	db, ok := triPlaneDistances(b, na, a0, eps)
+	if !ok {
+		return Segment3{}, false
+	}
+
+	dir := na.Cross(nb)
+	if da == [3]float32{} || db == [3]float32{} || dir.LenSqr() == 0 {
+		return triTriCoplanar(a, b, nb, eps)
+	}
+
+	// Both triangles cross the line where the planes meet, within an interval
+	// each; the intersection is the overlap of the intervals.
+	dir = dir.Normalize()
+	a0t, a1t := triPlaneCrossing(a, da, dir)
+	b0t, b1t := triPlaneCrossing(b, db, dir)
+
+	lo, hi := a0t, a1t
+	if dir.Dot(b0t) > dir.Dot(lo) {
+		lo = b0t
+	}
+	if dir.Dot(b1t) < dir.Dot(hi) {
+		hi = b1t
+	}
+	if dir.Dot(lo) > dir.Dot(hi)+eps {
+		return Segment3{}, false
+	}
+	if dir.Dot(lo) > dir.Dot(hi) {
+		hi = lo
+	}
+	return Segment3{lo, hi}, true
+}
+
+// triTriScale returns the largest edge length of the two triangles.
+func triTriScale(a, b [3]Vec3) float32 {
+	var scale float32
+	for _, t := range [2][3]Vec3{a, b} {
+		for i := 0; i < 3; i++ {
+			if l := t[(i+1)%3].Sub(t[i]).Len(); l > scale {
+				scale = l
+			}
+		}
+	}
+	return scale
+}
+
+// triPlaneDistances returns the signed distances of the vertices of t to the
+// plane through p with unit normal n, snapping distances smaller than eps to
+// zero. It returns false if all vertices are strictly on the same side.
+func triPlaneDistances(t [3]Vec3, n, p Vec3, eps float32) (d [3]float32, ok bool) {
+	pos, neg, zero := false, false, false
+	for i := range t {
+		d[i] = t[i].Sub(p).Dot(n)
+		switch {
+		case Abs(d[i]) < eps:
+			d[i] = 0
+			zero = true
+		case d[i] > 0:
+			pos = true
+		default:
+			neg = true
+		}
+	}
+	return d, zero || pos && neg
+}
+
+// triPlaneCrossing returns the points where the triangle t, whose vertices
+// are at signed distances d from a plane, meets the plane, ordered along dir.
+// The triangle must touch the plane without lying in it.
+func triPlaneCrossing(t [3]Vec3, d [3]float32, dir Vec3) (lo, hi Vec3) {
+	var pts [3]Vec3
+	n := 0
+	for i := 0; i < 3; i++ {
+		j := (i + 1) % 3
+		if d[i] == 0 {
+			pts[n] = t[i]
+			n++
+		} else if d[i]*d[j] < 0 {
+			pts[n] = t[i].Add(t[j].Sub(t[i]).Mul(d[i] / (d[i] - d[j])))
+			n++
+		}
+	}
+
+	lo, hi = pts[0], pts[0]
+	for _, p := range pts[1:n] {
+		if dir.Dot(p) < dir.Dot(lo) {
+			lo = p
+		}
+		if dir.Dot(p) > dir.Dot(hi) {
+			hi = p
+		}
+	}
+	return lo, hi
+}
+
+// triTriCoplanar intersects two coplanar triangles by clipping a against the
+// edges of b, and returns the diameter of the resulting polygon.
+func triTriCoplanar(a, b [3]Vec3, nb Vec3, eps float32) (Segment3, bool) {
+	poly := append(make([]Vec3, 0, 9), a[:]...)
+	next := make([]Vec3, 0, 9)
+	for i := 0; i < 3 && len(poly) > 0; i++ {
+		edge := b[(i+1)%3].Sub(b[i])
+		inward := nb.Cross(edge).Normalize()
+		next = next[:0]
+		for j, p := range poly {
+			q := poly[(j+1)%len(poly)]
+			dp, dq := p.Sub(b[i]).Dot(inward), q.Sub(b[i]).Dot(inward)
+			if Abs(dp) < eps {
+				dp = 0
+			}
+			if Abs(dq) < eps {
+				dq = 0
+			}
+			if dp >= 0 {
+				next = append(next, p)
+			}
+			if dp*dq < 0 {
+				next = append(next, p.Add(q.Sub(p).Mul(dp/(dp-dq))))
+			}
+		}
+		poly, next = next, poly
+	}
+	if len(poly) == 0 {
+		return Segment3{}, false
+	}
+
+	s := Segment3{poly[0], poly[0]}
+	var best float32
+	for i := range poly {
+		for j := i + 1; j < len(poly); j++ {
+			if d := poly[j].Sub(poly[i]).LenSqr(); d > best {
+				best, s = d, Segment3{poly[i], poly[j]}
+			}
+		}
+	}
+	return s, true
+}
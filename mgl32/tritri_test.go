@@ -0,0 +1,92 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+// sameSegment reports whether s1 and s2 have the same end points, in either
+// order.
+func sameSegment(s1, s2 Segment3) bool {
+	eq := func(a, b Vec3) bool { return a.Sub(b).Len() < 1e-4 }
+	return eq(s1.A, s2.A) && eq(s1.B, s2.B) || eq(s1.A, s2.B) && eq(s1.B, s2.A)
+}
+
+func TestTriTriIntersect(t *testing.T) {
+	t.Parallel()
+
+	// A triangle in the z = 0 plane.
+	a0, a1, a2 := Vec3{-2, -2, 0}, Vec3{2, -2, 0}, Vec3{0, 2, 0}
+
+	tests := []struct {
+		Description string
+		B           [3]Vec3
+		Hit         bool
+		Want        Segment3
+	}{
+		{
+			"Crossing",
+			[3]Vec3{{0, -1, -1}, {0, 1, -1}, {0, 0, 1}},
+			true,
+			Segment3{Vec3{0, -0.5, 0}, Vec3{0, 0.5, 0}},
+		},
+		{
+			"Crossing beyond the edge",
+			[3]Vec3{{0, -4, -1}, {0, 4, -1}, {0, 0, 3}},
+			true,
+			Segment3{Vec3{0, -2, 0}, Vec3{0, 2, 0}},
+		},
+		{
+			"Separated along the common line",
+			[3]Vec3{{3, 0, -1}, {3, 1, 1}, {3, -1, 1}},
+			false,
+			Segment3{},
+		},
+		{
+			"Above the plane",
+			[3]Vec3{{0, 0, 1}, {1, 0, 2}, {0, 1, 2}},
+			false,
+			Segment3{},
+		},
+		{
+			"Touching at a vertex",
+			[3]Vec3{{0, 0, 0}, {1, 0, 2}, {0, 1, 2}},
+			true,
+			Segment3{Vec3{0, 0, 0}, Vec3{0, 0, 0}},
+		},
+		{
+			"Coplanar overlap",
+			[3]Vec3{{-1, -2, 0}, {1, -2, 0}, {0, -4, 0}},
+			true,
+			Segment3{Vec3{-1, -2, 0}, Vec3{1, -2, 0}},
+		},
+		{
+			"Coplanar disjoint",
+			[3]Vec3{{5, 5, 0}, {6, 5, 0}, {5, 6, 0}},
+			false,
+			Segment3{},
+		},
+		{
+			"Degenerate",
+			[3]Vec3{{0, 0, -1}, {0, 0, 0}, {0, 0, 1}},
+			false,
+			Segment3{},
+		},
+	}
+
+	for _, c := range tests {
+		s, hit := TriTriIntersect(a0, a1, a2, c.B[0], c.B[1], c.B[2])
+		if hit != c.Hit || hit && !sameSegment(s, c.Want) {
+			t.Errorf("%s: got %v, %v, expected %v, %v", c.Description, s, hit, c.Want, c.Hit)
+		}
+
+		// The test is symmetric.
+		s, hit = TriTriIntersect(c.B[0], c.B[1], c.B[2], a0, a1, a2)
+		if hit != c.Hit || hit && !sameSegment(s, c.Want) {
+			t.Errorf("%s (swapped): got %v, %v, expected %v, %v", c.Description, s, hit, c.Want, c.Hit)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// Transform is an affine transformation decomposed into a translation, a
+// rotation and a (possibly non-uniform) scale, as commonly stored by scene
+// graphs and physics engines. The transformation is applied to points in the
+// order scale, rotation, translation.
+type Transform struct {
+	Translation Vec3
+	Rotation    Quat
+	Scale       Vec3
+}
+
+// TransformIdent returns the identity transform: no translation, the identity
+// rotation and a scale of 1 on every axis.
+func TransformIdent() Transform {
+	return Transform{Rotation: QuatIdent(), Scale: Vec3{1, 1, 1}}
+}
+
+// Mat4 returns the matrix equivalent to the transform, that is
+// Translate3D(t.Translation) * t.Rotation.Mat4() * Scale3D(t.Scale).
+func (t Transform) Mat4() Mat4 {
+	m := t.Rotation.Mat4()
+	for col := 0; col < 3; col++ {
+		for row := 0; row < 3; row++ {
+			m[col*4+row] *= t.Scale[col]
+		}
+	}
+	m[12], m[13], m[14] = t.Translation[0], t.Translation[1], t.Translation[2]
+	return m
+}
+
+// TransformPoint applies the transform to the point p.
+func (t Transform) TransformPoint(p Vec3) Vec3 {
+	s := Vec3{p[0] * t.Scale[0], p[1] * t.Scale[1], p[2] * t.Scale[2]}
+	return t.Rotation.Rotate(s).Add(t.Translation)
+}
+
+// ComposeTRS returns the matrix that scales, then rotates, then translates,
+// Translate3D(translation) * rotation.Mat4() * Scale3D(scale). It is the
+// inverse of Mat4.Decompose.
+func ComposeTRS(translation Vec3, rotation Quat, scale Vec3) Mat4 {
+	return Transform{translation, rotation, scale}.Mat4()
+}
+
+// Decompose splits the affine matrix m into a translation, a rotation and a
+// scale such that ComposeTRS(translation, rotation, scale) is m, as when
+// importing node matrices from glTF.
+//
+// A mirroring matrix, with a negative determinant, gets a negative x scale.
+// If m has shear it can't be reproduced exactly: the rotation is then that of
+// the Gram-Schmidt orthonormalization of the columns of m, in order, and the
+// scale is the part of each column along its rotated axis, which keeps the
+// first column's direction and the determinant of m. If m isn't affine (its
+// last row isn't 0, 0, 0, 1) or its linear part is singular, ok is false and
+// the other results are meaningless.
+func (m Mat4) Decompose() (translation Vec3, rotation Quat, scale Vec3, ok bool) {
+	if m[3] != 0 || m[7] != 0 || m[11] != 0 || m[15] != 1 {
+		return Vec3{}, Quat{}, Vec3{}, false
+	}
+	translation = Vec3{m[12], m[13], m[14]}
+
+	c0, c1, c2 := m.Col(0).Vec3(), m.Col(1).Vec3(), m.Col(2).Vec3()
+	sign := float32(1)
+	if c0.Dot(c1.Cross(c2)) < 0 {
+		sign, c0 = -1, c0.Mul(-1)
+	}
+
+	// Gram-Schmidt, i.e. a QR decomposition of the linear part, whose
+	// triangular factor has the scales on its diagonal.
+	x, err := c0.TryNormalize()
+	if err != nil {
+		return Vec3{}, Quat{}, Vec3{}, false
+	}
+	y, err := c1.Sub(x.Mul(x.Dot(c1))).TryNormalize()
+	if err != nil {
+		return Vec3{}, Quat{}, Vec3{}, false
+	}
+	z := x.Cross(y)
+	scale = Vec3{c0.Len(), y.Dot(c1), z.Dot(c2)}
+	if !(scale[0] > 0 && scale[1] > 0 && scale[2] > 0) {
+		return Vec3{}, Quat{}, Vec3{}, false
+	}
+	scale[0] *= sign
+
+	rotation = Mat4ToQuat(Mat3FromCols(x, y, z).Mat4()).Normalize()
+	return translation, rotation, scale, true
+}
@@ -0,0 +1,86 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestTransformMat4(t *testing.T) {
+	t.Parallel()
+
+	tr := Transform{
+		Translation: Vec3{1, 2, 3},
+		Rotation:    QuatRotate(0.7, Vec3{1, 2, -1}.Normalize()),
+		Scale:       Vec3{2, 0.5, 3},
+	}
+
+	correct := Translate3D(1, 2, 3).Mul4(tr.Rotation.Mat4()).Mul4(Scale3D(2, 0.5, 3))
+	if m := tr.Mat4(); !m.ApproxEqualThreshold(correct, 1e-4) {
+		t.Errorf("Transform.Mat4 not correct. Got: %v, expected: %v", m, correct)
+	}
+
+	p := Vec3{-1, 4, 0.5}
+	want := TransformCoordinate(p, correct)
+	if got := tr.TransformPoint(p); !got.ApproxEqualThreshold(want, 1e-4) {
+		t.Errorf("TransformPoint(%v) = %v, expected %v", p, got, want)
+	}
+
+	if m := TransformIdent().Mat4(); !m.ApproxEqual(Ident4()) {
+		t.Errorf("TransformIdent().Mat4() is not the identity. Got: %v", m)
+	}
+}
+
+func TestMat4Decompose(t *testing.T) {
+	t.Parallel()
+
+	rotation := QuatRotate(0.7, Vec3{1, 2, -1}.Normalize())
+	tests := []struct {
+		Description string
+		T           Vec3
+		S           Vec3
+	}{
+		{"Uniform scale", Vec3{1, 2, 3}, Vec3{2, 2, 2}},
+		{"Non-uniform scale", Vec3{-4, 0, 0.5}, Vec3{2, 0.5, 3}},
+		{"Mirrored", Vec3{0, 1, 0}, Vec3{-1, 2, 3}},
+	}
+
+	for _, c := range tests {
+		m := ComposeTRS(c.T, rotation, c.S)
+		tr, r, s, ok := m.Decompose()
+		if !ok {
+			t.Errorf("%s: Decompose failed", c.Description)
+			continue
+		}
+		if !tr.ApproxEqualThreshold(c.T, 1e-5) || !s.ApproxEqualThreshold(c.S, 1e-5) || !r.OrientationEqualThreshold(rotation, 1e-5) {
+			t.Errorf("%s: Decompose = %v, %v, %v, want %v, %v, %v", c.Description, tr, r, s, c.T, rotation, c.S)
+		}
+		if back := ComposeTRS(tr, r, s); !back.ApproxEqualThreshold(m, 1e-5) {
+			t.Errorf("%s: recomposed %v, want %v", c.Description, back, m)
+		}
+	}
+
+	// Mirroring along y is decomposed as a negative x scale and a rotation.
+	m := Scale3D(1, -1, 1)
+	tr, r, s, ok := m.Decompose()
+	if back := ComposeTRS(tr, r, s); !ok || s[0] >= 0 || !back.ApproxFuncEqual(m, absEqual(1e-6)) {
+		t.Errorf("Decompose of a mirror = %v, %v, %v, %v, recomposed as %v", tr, r, s, ok, back)
+	}
+
+	// Shear keeps the first axis and the volume.
+	shear := Mat4{1, 0, 0, 0, 1, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1}.Mul4(Scale3D(2, 3, 4))
+	_, r, s, ok = shear.Decompose()
+	if !ok || !r.OrientationEqualThreshold(QuatIdent(), 1e-6) || !s.ApproxEqual(Vec3{2, 3, 4}) {
+		t.Errorf("Decompose of a sheared matrix = %v, %v, %v, want the identity and %v", r, s, ok, Vec3{2, 3, 4})
+	}
+
+	singular := Scale3D(1, 0, 1)
+	projective := Perspective(1, 1, 0.1, 10)
+	for _, m := range []Mat4{singular, projective} {
+		if _, _, _, ok := m.Decompose(); ok {
+			t.Errorf("Decompose(%v) succeeded", m)
+		}
+	}
+}
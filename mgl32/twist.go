@@ -0,0 +1,133 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "math"
+
+// A Twist is an element of se(3), the Lie algebra of rigid motions: an
+// angular velocity and a linear velocity which, held for unit time, sweep out
+// a screw motion. Exp and Mat4ToTwist map between twists and rigid transforms,
+// the usual minimal parametrization of poses in robotics and SLAM.
+//
+// As a 6-vector (see VecN and TwistFromVecN) the angular part comes first.
+type Twist struct {
+	Angular Vec3
+	Linear  Vec3
+}
+
+// CrossMat3 returns the skew-symmetric matrix [v]x of v, so that
+// CrossMat3(v).Mul3x1(w) equals v.Cross(w).
+func CrossMat3(v Vec3) Mat3 {
+	return Mat3{
+		0, v[2], -v[1],
+		-v[2], 0, v[0],
+		v[1], -v[0], 0,
+	}
+}
+
+// so3Coeffs returns sin(t)/t, (1-cos(t))/t^2 and (t-sin(t))/t^3 for t^2 =
+// theta2, switching to their Taylor series near 0 where the closed forms
+// cancel catastrophically.
+func so3Coeffs(theta2 float64) (a, b, c float64) {
+	if theta2 < 1e-6 {
+		return 1 - theta2/6, 0.5 - theta2/24, 1.0/6 - theta2/120
+	}
+	theta := math.Sqrt(theta2)
+	sin, cos := math.Sincos(theta)
+	return sin / theta, (1 - cos) / theta2, (theta - sin) / (theta2 * theta)
+}
+
+// so3Mat3 returns I + a*K + b*K^2.
+func so3Mat3(k Mat3, a, b float64) Mat3 {
+	return Ident3().Add(k.Mul(float32(a))).Add(k.Mul3(k).Mul(float32(b)))
+}
+
+// Exp returns the rigid transform reached by following the twist for unit
+// time, the exponential map of SE(3). Its rotation is by |Angular| radians
+// about Angular, as with QuatFromRotationVector; for a zero Angular it is the
+// translation by Linear.
+func (t Twist) Exp() Mat4 {
+	k := CrossMat3(t.Angular)
+	a, b, c := so3Coeffs(float64(t.Angular.Dot(t.Angular)))
+
+	m := so3Mat3(k, a, b).Mat4()
+	m.SetCol(3, so3Mat3(k, b, c).Mul3x1(t.Linear).Vec4(1))
+	return m
+}
+
+// Mat4ToTwist returns the twist whose exponential is the rigid transform m,
+// the logarithm of SE(3) and the inverse of Exp. The angle of rotation is in
+// [0, Pi]. The upper left 3x3 of m must be a rotation.
+func Mat4ToTwist(m Mat4) Twist {
+	w := Mat4ToQuat(m).ToRotationVector()
+	return Twist{w, SO3LeftJacobianInv(w).Mul3x1(m.Col(3).Vec3())}
+}
+
+// TwistFromVecN returns the twist of the 6-vector v, angular part first.
+func TwistFromVecN(v *VecN) Twist {
+	return Twist{
+		Vec3{v.Get(0), v.Get(1), v.Get(2)},
+		Vec3{v.Get(3), v.Get(4), v.Get(5)},
+	}
+}
+
+// VecN stores the twist in dst as a 6-vector, angular part first,
+// reallocating as necessary.
+func (t Twist) VecN(dst *VecN) *VecN {
+	dst = dst.Resize(6)
+	for i := 0; i < 3; i++ {
+		dst.Set(i, t.Angular[i])
+		dst.Set(i+3, t.Linear[i])
+	}
+	return dst
+}
+
+// Add performs component-wise addition between two twists.
+func (t Twist) Add(t2 Twist) Twist {
+	return Twist{t.Angular.Add(t2.Angular), t.Linear.Add(t2.Linear)}
+}
+
+// Mul scales the twist by c, following it for c units of time instead of
+// one.
+func (t Twist) Mul(c float32) Twist {
+	return Twist{t.Angular.Mul(c), t.Linear.Mul(c)}
+}
+
+// Compose returns the twist of the motion t followed, in its own frame, by
+// t2: the logarithm of t.Exp().Mul4(t2.Exp()).
+func (t Twist) Compose(t2 Twist) Twist {
+	return Mat4ToTwist(t.Exp().Mul4(t2.Exp()))
+}
+
+// Transform returns the twist t expressed in the frame m maps to, the adjoint
+// action of m on t. Its exponential is m.Mul4(t.Exp()).Mul4(m.Inv()).
+func (t Twist) Transform(m Mat4) Twist {
+	r := m.Mat3()
+	w := r.Mul3x1(t.Angular)
+	return Twist{w, r.Mul3x1(t.Linear).Add(m.Col(3).Vec3().Cross(w))}
+}
+
+// Adjoint stores in dst the 6x6 adjoint matrix of the rigid transform m,
+//
+//	[ R     0 ]
+//	[ [p]xR R ]
+//
+// with R and p the rotation and translation of m, reallocating as necessary.
+// Multiplying it by a twist as a 6-vector is Twist.Transform; it also maps
+// covariances of twists between frames.
+func Adjoint(dst *MatMxN, m Mat4) *MatMxN {
+	dst = dst.Reshape(6, 6)
+	r := m.Mat3()
+	pr := CrossMat3(m.Col(3).Vec3()).Mul3(r)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			dst.Set(i, j, r.At(i, j))
+			dst.Set(i, j+3, 0)
+			dst.Set(i+3, j, pr.At(i, j))
+			dst.Set(i+3, j+3, r.At(i, j))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,141 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+var twistTests = []Twist{
+	{},
+	{Vec3{0, 0, 0}, Vec3{1, -2, 3}},
+	{Vec3{1e-5, -2e-5, 0}, Vec3{0.5, 0, 1}},
+	{Vec3{0.3, -0.2, 0.1}, Vec3{1, 2, 3}},
+	{Vec3{0, 0, math.Pi / 2}, Vec3{0, 0, 1}},
+	{Vec3{-2, 1, 1.5}, Vec3{-1, 0.5, 0}},
+	{Vec3{0, 3.1, 0}, Vec3{0.2, 0, -0.4}},
+}
+
+func absEqual(eps float32) func(a, b float32) bool {
+	return func(a, b float32) bool { return Abs(a-b) < eps }
+}
+
+func TestCrossMat3(t *testing.T) {
+	t.Parallel()
+
+	v, w := Vec3{1, -2, 3}, Vec3{0.5, 4, -1}
+	if got, want := CrossMat3(v).Mul3x1(w), v.Cross(w); !got.ApproxEqual(want) {
+		t.Errorf("CrossMat3(%v).Mul3x1(%v) = %v, want %v", v, w, got, want)
+	}
+}
+
+func TestTwistExp(t *testing.T) {
+	t.Parallel()
+
+	for _, tw := range twistTests {
+		m := tw.Exp()
+		rot := QuatFromRotationVector(tw.Angular).Mat4()
+		if !m.Mat3().ApproxFuncEqual(rot.Mat3(), absEqual(1e-5)) {
+			t.Errorf("%v.Exp() rotation = %v, want %v", tw, m.Mat3(), rot.Mat3())
+		}
+		if row := m.Row(3); row != (Vec4{0, 0, 0, 1}) {
+			t.Errorf("%v.Exp() bottom row = %v", tw, row)
+		}
+
+		// Following the twist in n steps gives the same motion.
+		step := tw.Mul(1.0 / 8).Exp()
+		acc := Ident4()
+		for i := 0; i < 8; i++ {
+			acc = acc.Mul4(step)
+		}
+		if !acc.ApproxFuncEqual(m, absEqual(1e-4)) {
+			t.Errorf("%v.Mul(1/8).Exp()^8 = %v, want %v", tw, acc, m)
+		}
+	}
+
+	// A quarter turn about z with a unit advance along it.
+	m := Twist{Vec3{0, 0, math.Pi / 2}, Vec3{0, 0, 1}}.Exp()
+	if want := Translate3D(0, 0, 1).Mul4(HomogRotate3DZ(math.Pi / 2)); !m.ApproxFuncEqual(want, absEqual(1e-6)) {
+		t.Errorf("screw Exp() = %v, want %v", m, want)
+	}
+
+	// The same turn about the axis through {1, 0, 0}.
+	m = Twist{Vec3{0, 0, math.Pi / 2}, Vec3{0, -math.Pi / 2, 0}}.Exp()
+	if got, want := m.Mul4x1(Vec4{1, 0, 0, 1}), (Vec4{1, 0, 0, 1}); !got.ApproxFuncEqual(want, absEqual(1e-6)) {
+		t.Errorf("rotation about an offset axis moves a point on the axis to %v", got)
+	}
+	if got, want := m.Mul4x1(Vec4{0, 0, 0, 1}), (Vec4{1, -1, 0, 1}); !got.ApproxFuncEqual(want, absEqual(1e-6)) {
+		t.Errorf("rotation about an offset axis moves the origin to %v, want %v", got, want)
+	}
+}
+
+func TestTwistLog(t *testing.T) {
+	t.Parallel()
+
+	approx := absEqual(1e-4)
+	for _, tw := range twistTests {
+		got := Mat4ToTwist(tw.Exp())
+		if !got.Angular.ApproxFuncEqual(tw.Angular, approx) || !got.Linear.ApproxFuncEqual(tw.Linear, approx) {
+			t.Errorf("Mat4ToTwist(%v.Exp()) = %v", tw, got)
+		}
+	}
+
+	m := HomogRotate3D(2, Vec3{1, 2, -1}.Normalize()).Mul4(Translate3D(3, -1, 0.5))
+	if got := Mat4ToTwist(m).Exp(); !got.ApproxFuncEqual(m, absEqual(1e-5)) {
+		t.Errorf("Mat4ToTwist(%v).Exp() = %v", m, got)
+	}
+}
+
+func TestTwistVecN(t *testing.T) {
+	t.Parallel()
+
+	tw := Twist{Vec3{1, 2, 3}, Vec3{4, 5, 6}}
+	v := tw.VecN(nil)
+	if want := NewVecNFromData([]float32{1, 2, 3, 4, 5, 6}); !v.ApproxEqual(want) {
+		t.Errorf("VecN() = %v, want %v", v.Raw(), want.Raw())
+	}
+	if got := TwistFromVecN(v); got != tw {
+		t.Errorf("TwistFromVecN(%v) = %v, want %v", v.Raw(), got, tw)
+	}
+}
+
+func TestTwistTransform(t *testing.T) {
+	t.Parallel()
+
+	m := HomogRotate3D(0.7, Vec3{0, 1, 1}.Normalize()).Mul4(Translate3D(1, 2, -3))
+	ad := Adjoint(nil, m)
+	for _, tw := range twistTests {
+		got := tw.Transform(m)
+		if want := m.Mul4(tw.Exp()).Mul4(m.Inv()); !got.Exp().ApproxFuncEqual(want, absEqual(1e-4)) {
+			t.Errorf("%v.Transform(m).Exp() = %v, want %v", tw, got.Exp(), want)
+		}
+		if v := TwistFromVecN(ad.MulNx1(nil, tw.VecN(nil))); !v.Angular.ApproxFuncEqual(got.Angular, absEqual(1e-5)) ||
+			!v.Linear.ApproxFuncEqual(got.Linear, absEqual(1e-5)) {
+			t.Errorf("Adjoint(m) * %v = %v, want %v", tw, v, got)
+		}
+	}
+}
+
+func TestTwistCompose(t *testing.T) {
+	t.Parallel()
+
+	approx := absEqual(1e-4)
+
+	// Screws about the same axis add up.
+	a := Twist{Vec3{0, 0, 0.5}, Vec3{0.1, 0, 1}}
+	b := Twist{Vec3{0, 0, 1}, Vec3{0.2, 0, 2}}
+	if got, want := a.Compose(b), a.Add(b); !got.Angular.ApproxFuncEqual(want.Angular, approx) || !got.Linear.ApproxFuncEqual(want.Linear, approx) {
+		t.Errorf("%v.Compose(%v) = %v, want %v", a, b, got, want)
+	}
+
+	for _, a := range twistTests {
+		for _, b := range twistTests {
+			if got, want := a.Compose(b).Exp(), a.Exp().Mul4(b.Exp()); !got.ApproxFuncEqual(want, absEqual(1e-4)) {
+				t.Errorf("%v.Compose(%v).Exp() = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
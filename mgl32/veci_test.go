@@ -0,0 +1,132 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVeci(t *testing.T) {
+	t.Parallel()
+
+	a, b := Vec2i{1, -2}, Vec2i{3, 4}
+	if got := a.Add(b); got != (Vec2i{4, 2}) {
+		t.Errorf("%v.Add(%v) = %v", a, b, got)
+	}
+	if got := a.Sub(b); got != (Vec2i{-2, -6}) {
+		t.Errorf("%v.Sub(%v) = %v", a, b, got)
+	}
+	if got := a.Vec2(); got != (Vec2{1, -2}) {
+		t.Errorf("%v.Vec2() = %v", a, got)
+	}
+
+	c, d := Vec3i{1, -2, 5}, Vec3i{3, 4, -5}
+	if got := c.Add(d); got != (Vec3i{4, 2, 0}) {
+		t.Errorf("%v.Add(%v) = %v", c, d, got)
+	}
+	if got := c.Sub(d); got != (Vec3i{-2, -6, 10}) {
+		t.Errorf("%v.Sub(%v) = %v", c, d, got)
+	}
+	if got := c.Vec3(); got != (Vec3{1, -2, 5}) {
+		t.Errorf("%v.Vec3() = %v", c, got)
+	}
+}
+
+func TestVeciArithmetic(t *testing.T) {
+	t.Parallel()
+
+	v, w := Vec4i{1, -2, 7, -9}, Vec4i{3, 4, -5, 2}
+	tests := []struct {
+		Description string
+		Got, Want   Vec4i
+	}{
+		{"Add", v.Add(w), Vec4i{4, 2, 2, -7}},
+		{"Sub", v.Sub(w), Vec4i{-2, -6, 12, -11}},
+		{"Mul", v.Mul(3), Vec4i{3, -6, 21, -27}},
+		{"MulElem", v.MulElem(w), Vec4i{3, -8, -35, -18}},
+		{"Div", v.Div(2), Vec4i{0, -1, 3, -4}},
+		{"FloorDiv", v.FloorDiv(2), Vec4i{0, -1, 3, -5}},
+		{"FloorDiv by a negative", v.FloorDiv(-2), Vec4i{-1, 1, -4, 4}},
+		{"Abs", v.Abs(), Vec4i{1, 2, 7, 9}},
+		{"Min", v.Min(w), Vec4i{1, -2, -5, -9}},
+		{"Max", v.Max(w), Vec4i{3, 4, 7, 2}},
+		{"Clamp", v.Clamp(Vec4i{0, 0, 0, 0}, Vec4i{5, 5, 5, 5}), Vec4i{1, 0, 5, 0}},
+	}
+
+	for _, c := range tests {
+		if c.Got != c.Want {
+			t.Errorf("%s = %v, want %v", c.Description, c.Got, c.Want)
+		}
+	}
+
+	if got := v.Dot(w); got != 3-8-35-18 {
+		t.Errorf("%v.Dot(%v) = %v", v, w, got)
+	}
+	if got := (Vec4i{1, 2, 3, 4}).Vec4(); got != (Vec4{1, 2, 3, 4}) {
+		t.Errorf("Vec4() = %v", got)
+	}
+	if got := (Vec3i{2, 3, 4}).FloorDiv(2); got != (Vec3i{1, 1, 2}) {
+		t.Errorf("Vec3i FloorDiv = %v", got)
+	}
+}
+
+func TestVeciFromFloat(t *testing.T) {
+	t.Parallel()
+
+	v := Vec3{2.5, -2.5, 1.2}
+	tests := []struct {
+		Mode RoundingMode
+		Want Vec3i
+	}{
+		{RoundNearestEven, Vec3i{2, -2, 1}},
+		{RoundHalfAwayFromZero, Vec3i{3, -3, 1}},
+		{RoundTowardZero, Vec3i{2, -2, 1}},
+		{RoundTowardNegative, Vec3i{2, -3, 1}},
+		{RoundTowardPositive, Vec3i{3, -2, 2}},
+	}
+
+	for _, c := range tests {
+		if got := Vec3iFromVec3(v, c.Mode); got != c.Want {
+			t.Errorf("Vec3iFromVec3(%v, %v) = %v, want %v", v, c.Mode, got, c.Want)
+		}
+	}
+
+	if got := Vec2iFromVec2(Vec2{1e20, NaN}, RoundNearestEven); got != (Vec2i{math.MaxInt32, 0}) {
+		t.Errorf("Vec2iFromVec2 of out of range values = %v", got)
+	}
+	if got := Vec4iFromVec4(Vec4{InfNeg, -0.5, 0.5, 3}, RoundTowardNegative); got != (Vec4i{math.MinInt32, -1, 0, 3}) {
+		t.Errorf("Vec4iFromVec4 = %v", got)
+	}
+}
+
+func TestVeciCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		A, B Vec3i
+		Less bool
+	}{
+		{Vec3i{1, 2, 3}, Vec3i{1, 2, 4}, true},
+		{Vec3i{1, 2, 3}, Vec3i{1, 2, 3}, false},
+		{Vec3i{2, 0, 0}, Vec3i{1, 9, 9}, false},
+		{Vec3i{-1, 9, 9}, Vec3i{0, 0, 0}, true},
+	}
+	for _, c := range tests {
+		if got := c.A.Less(c.B); got != c.Less {
+			t.Errorf("%v.Less(%v) = %v, want %v", c.A, c.B, got, c.Less)
+		}
+	}
+
+	size := Vec2i{4, 3}
+	for _, c := range []struct {
+		P  Vec2i
+		In bool
+	}{{Vec2i{0, 0}, true}, {Vec2i{3, 2}, true}, {Vec2i{4, 0}, false}, {Vec2i{0, -1}, false}} {
+		if got := c.P.InRange(Vec2i{}, size); got != c.In {
+			t.Errorf("%v.InRange(0, %v) = %v, want %v", c.P, size, got, c.In)
+		}
+	}
+}
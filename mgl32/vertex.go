@@ -0,0 +1,187 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "encoding/binary"
+
+// VertexFormat is the format the components of a vertex attribute are stored
+// in by InterleaveVertices. The names of the matching OpenGL types are given
+// for each; the normalized formats are meant for glVertexAttribPointer with
+// normalized set to true.
+type VertexFormat int
+
+// The VertexFormat constants.
+const (
+	// VertexFloat32 stores each component as a 32 bit float (GL_FLOAT).
+	VertexFloat32 VertexFormat = iota
+	// VertexHalf stores each component as a 16 bit float, converted with
+	// FloatToHalf (GL_HALF_FLOAT).
+	VertexHalf
+	// VertexSnorm8 stores each component as FloatToSnorm8 (GL_BYTE).
+	VertexSnorm8
+	// VertexUnorm8 stores each component as FloatToUnorm8 (GL_UNSIGNED_BYTE).
+	VertexUnorm8
+	// VertexSnorm16 stores each component as FloatToSnorm16 (GL_SHORT).
+	VertexSnorm16
+	// VertexUnorm16 stores each component as FloatToUnorm16
+	// (GL_UNSIGNED_SHORT).
+	VertexUnorm16
+	// VertexSnorm1010102 packs the components with PackSnorm3x10_1x2
+	// (GL_INT_2_10_10_10_REV). Missing components are 0.
+	VertexSnorm1010102
+	// VertexUnorm1010102 packs the components with PackUnorm3x10_1x2
+	// (GL_UNSIGNED_INT_2_10_10_10_REV). Missing components are 0.
+	VertexUnorm1010102
+)
+
+// VertexAttrib is an attribute to be packed by InterleaveVertices: the
+// values of exactly one of Vec2s, Vec3s and Vec4s, one per vertex, stored in
+// Format.
+type VertexAttrib struct {
+	Format VertexFormat
+	Vec2s  []Vec2
+	Vec3s  []Vec3
+	Vec4s  []Vec4
+}
+
+// Len returns the number of vertices of the attribute.
+func (a VertexAttrib) Len() int {
+	return len(a.Vec2s) + len(a.Vec3s) + len(a.Vec4s)
+}
+
+// Components returns the number of components of the attribute, 2, 3 or 4.
+// It panics if the attribute doesn't have exactly one of its slices set.
+func (a VertexAttrib) Components() int {
+	var n, set int
+	if a.Vec2s != nil {
+		n, set = 2, set+1
+	}
+	if a.Vec3s != nil {
+		n, set = 3, set+1
+	}
+	if a.Vec4s != nil {
+		n, set = 4, set+1
+	}
+	if set != 1 {
+		panic("Vertex attribute must have exactly one of Vec2s, Vec3s and Vec4s set")
+	}
+	return n
+}
+
+// Size returns the number of bytes the attribute takes in each vertex,
+// rounded up to a multiple of 4 so that every attribute of an interleaved
+// vertex is 4 byte aligned, as Vulkan and Metal require and OpenGL
+// implementations prefer.
+func (a VertexAttrib) Size() int {
+	var size int
+	switch a.Format {
+	case VertexFloat32:
+		size = 4 * a.Components()
+	case VertexHalf, VertexSnorm16, VertexUnorm16:
+		size = 2 * a.Components()
+	case VertexSnorm8, VertexUnorm8:
+		size = a.Components()
+	case VertexSnorm1010102, VertexUnorm1010102:
+		a.Components() // For the check
+		size = 4
+	default:
+		panic("Unsupported vertex format")
+	}
+	return (size + 3) &^ 3
+}
+
+// at returns the value of the attribute for vertex i, padded with zeros.
+func (a VertexAttrib) at(i int) Vec4 {
+	switch {
+	case a.Vec4s != nil:
+		return a.Vec4s[i]
+	case a.Vec3s != nil:
+		return a.Vec3s[i].Vec4(0)
+	default:
+		return a.Vec2s[i].Vec4(0, 0)
+	}
+}
+
+// VertexLayout returns the offsets in bytes of the attributes within an
+// interleaved vertex, in order, and the stride between vertices, as used by
+// InterleaveVertices.
+func VertexLayout(attribs ...VertexAttrib) (offsets []int, stride int) {
+	offsets = make([]int, len(attribs))
+	for i, a := range attribs {
+		offsets[i] = stride
+		stride += a.Size()
+	}
+	return offsets, stride
+}
+
+// InterleaveVertices converts the attributes to their formats and packs them
+// for each vertex in turn, in the order given, into a buffer ready for
+// glBufferData. It stores the buffer in dst, reallocating if dst is too small,
+// and returns it along with the layout of the vertices, as from VertexLayout,
+// for glVertexAttribPointer. Bytes padding the attributes to 4 byte alignment
+// are zero. Values are stored little endian, the byte order of every current
+// GPU, and normalized integers are rounded to the nearest, ties to even.
+//
+// All attributes must have the same number of vertices, or this panics.
+func InterleaveVertices(dst []byte, attribs ...VertexAttrib) (buf []byte, offsets []int, stride int) {
+	offsets, stride = VertexLayout(attribs...)
+	n := 0
+	for i, a := range attribs {
+		if i == 0 {
+			n = a.Len()
+		} else if a.Len() != n {
+			panic("Mismatched vertex attribute lengths")
+		}
+	}
+
+	if cap(dst) < n*stride {
+		dst = make([]byte, n*stride)
+	}
+	dst = dst[:n*stride]
+
+	for k, a := range attribs {
+		components, size := a.Components(), a.Size()
+		for i := 0; i < n; i++ {
+			start := i*stride + offsets[k]
+			out := dst[start : start+size]
+			for j := range out {
+				out[j] = 0
+			}
+			putVertexAttrib(out, a.Format, a.at(i), components)
+		}
+	}
+	return dst, offsets, stride
+}
+
+// putVertexAttrib stores the first components elements of v in out in the
+// given format.
+func putVertexAttrib(out []byte, format VertexFormat, v Vec4, components int) {
+	le := binary.LittleEndian
+	switch format {
+	case VertexSnorm1010102:
+		le.PutUint32(out, PackSnorm3x10_1x2(v, RoundNearestEven))
+		return
+	case VertexUnorm1010102:
+		le.PutUint32(out, PackUnorm3x10_1x2(v, RoundNearestEven))
+		return
+	}
+
+	for j := 0; j < components; j++ {
+		switch format {
+		case VertexFloat32:
+			le.PutUint32(out[4*j:], uint32(packFloat(float64(v[j]), 8, 23)))
+		case VertexHalf:
+			le.PutUint16(out[2*j:], FloatToHalf(v[j]))
+		case VertexSnorm8:
+			out[j] = byte(FloatToSnorm8(v[j], RoundNearestEven))
+		case VertexUnorm8:
+			out[j] = FloatToUnorm8(v[j], RoundNearestEven)
+		case VertexSnorm16:
+			le.PutUint16(out[2*j:], uint16(FloatToSnorm16(v[j], RoundNearestEven)))
+		case VertexUnorm16:
+			le.PutUint16(out[2*j:], FloatToUnorm16(v[j], RoundNearestEven))
+		}
+	}
+}
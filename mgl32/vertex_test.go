@@ -0,0 +1,128 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestVertexLayout(t *testing.T) {
+	t.Parallel()
+
+	attribs := []VertexAttrib{
+		{Format: VertexFloat32, Vec3s: []Vec3{}},
+		{Format: VertexSnorm1010102, Vec3s: []Vec3{}},
+		{Format: VertexHalf, Vec2s: []Vec2{}},
+		{Format: VertexUnorm8, Vec3s: []Vec3{}},
+		{Format: VertexSnorm16, Vec3s: []Vec3{}},
+	}
+	offsets, stride := VertexLayout(attribs...)
+	if want := []int{0, 12, 16, 20, 24}; !intsEqual(offsets, want) || stride != 32 {
+		t.Errorf("VertexLayout = %v, %v, want %v, 32", offsets, stride, want)
+	}
+}
+
+func intsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestInterleaveVertices(t *testing.T) {
+	t.Parallel()
+
+	positions := []Vec3{{1, 2, 3}, {-1, 0.5, 0}}
+	normals := []Vec3{{1, 0, 0}, {0, -1, 0}}
+	uvs := []Vec2{{0.5, 1}, {0, 0.25}}
+	colors := []Vec4{{1, 0, 0.5, 1}, {0, 1, 0, 0}}
+
+	buf, offsets, stride := InterleaveVertices(make([]byte, 3, 100),
+		VertexAttrib{Format: VertexFloat32, Vec3s: positions},
+		VertexAttrib{Format: VertexSnorm1010102, Vec3s: normals},
+		VertexAttrib{Format: VertexHalf, Vec2s: uvs},
+		VertexAttrib{Format: VertexUnorm8, Vec4s: colors},
+	)
+	if stride != 24 || !intsEqual(offsets, []int{0, 12, 16, 20}) || len(buf) != 48 || cap(buf) != 100 {
+		t.Fatalf("InterleaveVertices layout = %v, %v, buffer of length %d and cap %d", offsets, stride, len(buf), cap(buf))
+	}
+
+	le := binary.LittleEndian
+	for i := range positions {
+		v := buf[i*stride : (i+1)*stride]
+		for j := 0; j < 3; j++ {
+			if got := math.Float32frombits(le.Uint32(v[4*j:])); float64(got) != float64(positions[i][j]) {
+				t.Errorf("vertex %d: position %d = %v, want %v", i, j, got, positions[i][j])
+			}
+		}
+
+		if got, want := UnpackSnorm3x10_1x2(le.Uint32(v[12:])), normals[i].Vec4(0); got != want {
+			t.Errorf("vertex %d: normal = %v, want %v", i, got, want)
+		}
+
+		for j := 0; j < 2; j++ {
+			if got := HalfToFloat(le.Uint16(v[16+2*j:])); got != uvs[i][j] {
+				t.Errorf("vertex %d: uv %d = %v, want %v", i, j, got, uvs[i][j])
+			}
+		}
+
+		var want [4]byte
+		QuantizeUnorm8(want[:], colors[i][:], RoundNearestEven)
+		if !bytes.Equal(v[20:24], want[:]) {
+			t.Errorf("vertex %d: color = %v, want %v", i, v[20:24], want)
+		}
+	}
+}
+
+func TestInterleaveVerticesPadding(t *testing.T) {
+	t.Parallel()
+
+	dirty := bytes.Repeat([]byte{0xff}, 8)
+	buf, _, stride := InterleaveVertices(dirty,
+		VertexAttrib{Format: VertexUnorm8, Vec3s: []Vec3{{1, 0, 1}}},
+		VertexAttrib{Format: VertexUnorm1010102, Vec2s: []Vec2{{1, 0.5}}},
+	)
+	if stride != 8 {
+		t.Fatalf("stride = %d, want 8", stride)
+	}
+	if want := []byte{255, 0, 255, 0}; !bytes.Equal(buf[:4], want) {
+		t.Errorf("unorm8 Vec3 = %v, want %v with a zero padding byte", buf[:4], want)
+	}
+	if got, want := binary.LittleEndian.Uint32(buf[4:]), uint32(1023|512<<10); got != want {
+		t.Errorf("unorm 10-10-10-2 = %#x, want %#x", got, want)
+	}
+}
+
+func TestInterleaveVerticesPanics(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Description string
+		Attribs     []VertexAttrib
+	}{
+		{"Mismatched lengths", []VertexAttrib{{Vec3s: make([]Vec3, 2)}, {Vec2s: make([]Vec2, 3)}}},
+		{"No slice", []VertexAttrib{{}}},
+		{"Two slices", []VertexAttrib{{Vec3s: make([]Vec3, 1), Vec4s: make([]Vec4, 0)}}},
+	}
+
+	for _, c := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: InterleaveVertices didn't panic", c.Description)
+				}
+			}()
+			InterleaveVertices(nil, c.Attribs...)
+		}()
+	}
+}
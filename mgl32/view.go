@@ -0,0 +1,185 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// MatView is a read-only view of a matrix, such as a MatMxN or a block of
+// one, sharing its backing slice instead of copying it. Block algorithms can
+// take views of the submatrices they work on, and combine them with MulMxN or
+// CopyTo into MatMxN destinations. It implements Matrix, so FormatMatrix can
+// print it.
+//
+// A view only reads the matrix; it has no methods to change it. It still sees
+// changes made to the underlying matrix, and becomes stale if that matrix is
+// reshaped to a larger size, which may reallocate its backing slice. The zero
+// MatView is an empty 0x0 view.
+type MatView struct {
+	m, n int
+	// Element (row, col) is dat[row*rowInc+col*colInc].
+	rowInc, colInc int
+	dat            []float32
+}
+
+// VecView is a read-only view of a vector, such as a VecN or a row or column
+// of a MatView, sharing its backing slice. Like MatView, it sees changes
+// made to the underlying data. The zero VecView is empty.
+type VecView struct {
+	n, inc int
+	dat    []float32
+}
+
+// View returns a view of the whole matrix. If mat is nil, the view is empty.
+func (mat *MatMxN) View() MatView {
+	if mat == nil {
+		return MatView{}
+	}
+	return MatView{mat.m, mat.n, 1, mat.m, mat.dat}
+}
+
+// View returns a view of the whole vector. If vn is nil, the view is empty.
+func (vn *VecN) View() VecView {
+	if vn == nil {
+		return VecView{}
+	}
+	return VecView{len(vn.vec), 1, vn.vec}
+}
+
+// NumRows returns the number of rows of the view.
+func (v MatView) NumRows() int {
+	return v.m
+}
+
+// NumCols returns the number of columns of the view.
+func (v MatView) NumCols() int {
+	return v.n
+}
+
+// At returns the element at the given row and column of the view. It panics
+// if they are out of range.
+func (v MatView) At(row, col int) float32 {
+	if row < 0 || row >= v.m || col < 0 || col >= v.n {
+		panic("MatView index out of range")
+	}
+	return v.dat[row*v.rowInc+col*v.colInc]
+}
+
+// Slice returns a view of the rows by cols block of the view whose top left
+// element is at row r and column c. It panics if the block doesn't fit in
+// the view.
+func (v MatView) Slice(r, c, rows, cols int) MatView {
+	if r < 0 || c < 0 || rows < 0 || cols < 0 || r+rows > v.m || c+cols > v.n {
+		panic("MatView slice out of range")
+	}
+	if rows == 0 || cols == 0 {
+		return MatView{rows, cols, v.rowInc, v.colInc, nil}
+	}
+	start := r*v.rowInc + c*v.colInc
+	end := (r+rows-1)*v.rowInc + (c+cols-1)*v.colInc + 1
+	return MatView{rows, cols, v.rowInc, v.colInc, v.dat[start:end]}
+}
+
+// T returns the transpose of the view, a view of the same elements with rows
+// and columns swapped.
+func (v MatView) T() MatView {
+	return MatView{v.n, v.m, v.colInc, v.rowInc, v.dat}
+}
+
+// Row returns a view of the given row. It panics if the row is out of range.
+func (v MatView) Row(row int) VecView {
+	s := v.Slice(row, 0, 1, v.n)
+	return VecView{s.n, s.colInc, s.dat}
+}
+
+// Col returns a view of the given column. It panics if the column is out of
+// range.
+func (v MatView) Col(col int) VecView {
+	s := v.Slice(0, col, v.m, 1)
+	return VecView{s.m, s.rowInc, s.dat}
+}
+
+// CopyTo copies the viewed elements into dst, reshaped to the size of the
+// view, and returns it. If dst is nil, a new matrix is allocated. Dst must not
+// share its backing slice with the view.
+func (v MatView) CopyTo(dst *MatMxN) *MatMxN {
+	dst = dst.Reshape(v.m, v.n)
+	for c := 0; c < v.n; c++ {
+		col := dst.dat[c*v.m : (c+1)*v.m]
+		for r := range col {
+			col[r] = v.dat[r*v.rowInc+c*v.colInc]
+		}
+	}
+	return dst
+}
+
+// MulMxN stores in dst the matrix product of the view and mul, reshaping it
+// as necessary, and returns it. If the number of columns of v is not the
+// number of rows of mul, this returns nil. Dst must not share its backing
+// slice with either view.
+func (v MatView) MulMxN(dst *MatMxN, mul MatView) *MatMxN {
+	if v.n != mul.m {
+		return nil
+	}
+
+	dst = dst.Reshape(v.m, mul.n)
+	for c := 0; c < mul.n; c++ {
+		col := dst.dat[c*v.m : (c+1)*v.m]
+		for r := range col {
+			var sum float32
+			for i := 0; i < v.n; i++ {
+				sum += v.dat[r*v.rowInc+i*v.colInc] * mul.dat[i*mul.rowInc+c*mul.colInc]
+			}
+			col[r] = sum
+		}
+	}
+	return dst
+}
+
+// Size returns the number of elements of the view.
+func (v VecView) Size() int {
+	return v.n
+}
+
+// At returns element i of the view. It panics if i is out of range.
+func (v VecView) At(i int) float32 {
+	if i < 0 || i >= v.n {
+		panic("VecView index out of range")
+	}
+	return v.dat[i*v.inc]
+}
+
+// Slice returns a view of the n elements of the view starting at element i.
+// It panics if they don't fit in the view.
+func (v VecView) Slice(i, n int) VecView {
+	if i < 0 || n < 0 || i+n > v.n {
+		panic("VecView slice out of range")
+	}
+	if n == 0 {
+		return VecView{0, v.inc, nil}
+	}
+	return VecView{n, v.inc, v.dat[i*v.inc : (i+n-1)*v.inc+1]}
+}
+
+// Dot returns the dot product of two views, or NaN if they don't have the
+// same size, like VecN.Dot.
+func (v VecView) Dot(other VecView) float32 {
+	if v.n != other.n {
+		return NaN
+	}
+	var sum float32
+	for i := 0; i < v.n; i++ {
+		sum += v.dat[i*v.inc] * other.dat[i*other.inc]
+	}
+	return sum
+}
+
+// CopyTo copies the viewed elements into dst, resized to the size of the view,
+// and returns it. If dst is nil, a new vector is allocated. Dst must not share
+// its backing slice with the view.
+func (v VecView) CopyTo(dst *VecN) *VecN {
+	dst = dst.Resize(v.n)
+	for i := range dst.vec {
+		dst.vec[i] = v.dat[i*v.inc]
+	}
+	return dst
+}
@@ -0,0 +1,117 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import "testing"
+
+func TestMatView(t *testing.T) {
+	t.Parallel()
+
+	m := Mat3x4{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	v := NewMatrixFromData(m[:], 3, 4).View()
+
+	if v.NumRows() != 3 || v.NumCols() != 4 {
+		t.Fatalf("View of a 3x4 matrix is %dx%d", v.NumRows(), v.NumCols())
+	}
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 4; c++ {
+			if got, want := v.At(r, c), m.At(r, c); got != want {
+				t.Errorf("View At(%d, %d) = %v, want %v", r, c, got, want)
+			}
+			if got, want := v.T().At(c, r), m.At(r, c); got != want {
+				t.Errorf("Transposed view At(%d, %d) = %v, want %v", c, r, got, want)
+			}
+		}
+	}
+
+	block := v.Slice(1, 1, 2, 3)
+	if got, want := block.CopyTo(nil), NewMatrixFromData([]float32{5, 6, 8, 9, 11, 12}, 2, 3); !got.ApproxEqual(want) {
+		t.Errorf("Slice(1, 1, 2, 3) = %v, want %v", got, want)
+	}
+	if got, want := block.Row(1).CopyTo(nil), NewVecNFromData([]float32{6, 9, 12}); !got.ApproxEqual(want) {
+		t.Errorf("Row(1) of the block = %v, want %v", got, want)
+	}
+	if got, want := block.Col(2).CopyTo(nil), NewVecNFromData([]float32{11, 12}); !got.ApproxEqual(want) {
+		t.Errorf("Col(2) of the block = %v, want %v", got, want)
+	}
+	if got, want := block.T().Slice(1, 0, 2, 2).CopyTo(nil), NewMatrixFromData([]float32{8, 11, 9, 12}, 2, 2); !got.ApproxEqual(want) {
+		t.Errorf("Slice of the transposed block = %v, want %v", got, want)
+	}
+	if got, want := v.Row(2).Slice(1, 2).Dot(v.Col(1).Slice(0, 2)), float32(6*4+9*5); got != want {
+		t.Errorf("Dot of row and column slices = %v, want %v", got, want)
+	}
+
+	// Views see changes to the matrix.
+	mn := NewMatrixFromData(m[:], 3, 4)
+	view := mn.View().Slice(0, 2, 3, 2)
+	mn.Set(2, 3, 100)
+	if got := view.At(2, 1); got != 100 {
+		t.Errorf("View after Set = %v, want 100", got)
+	}
+
+	if e := (MatView{}); e.NumRows() != 0 || e.NumCols() != 0 || (*MatMxN)(nil).View().NumRows() != 0 {
+		t.Errorf("Zero MatView isn't empty")
+	}
+}
+
+func TestMatViewPanics(t *testing.T) {
+	t.Parallel()
+
+	v := NewMatrix(3, 3).View()
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"At", func() { v.At(3, 0) }},
+		{"Slice", func() { v.Slice(1, 1, 3, 1) }},
+		{"Row", func() { v.Row(-1) }},
+		{"VecView At", func() { v.Col(0).At(3) }},
+		{"VecView Slice", func() { v.Col(0).Slice(2, 2) }},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s out of range didn't panic", test.name)
+				}
+			}()
+			test.f()
+		}()
+	}
+}
+
+func TestMatViewSchurComplement(t *testing.T) {
+	t.Parallel()
+
+	// The determinant of a block matrix [[A, B], [C, D]] with an invertible A
+	// is det(A) times the determinant of the Schur complement D - C*A^-1*B.
+	m := Mat4{4, 1, 2, 0, 1, 3, 0, 1, 2, 0, 5, 1, 0, 1, 1, 6}
+	v := NewMatrixFromData(m[:], 4, 4).View()
+	a, b, c, d := v.Slice(0, 0, 2, 2), v.Slice(0, 2, 2, 2), v.Slice(2, 0, 2, 2), v.Slice(2, 2, 2, 2)
+
+	var aInv Mat2
+	copy(aInv[:], a.CopyTo(nil).Raw())
+	aInv = aInv.Inv()
+	aInvB := NewMatrixFromData(aInv[:], 2, 2).View().MulMxN(nil, b)
+	cAInvB := c.MulMxN(nil, aInvB.View())
+	schur := d.CopyTo(nil).Sub(nil, cAInvB)
+
+	var s Mat2
+	copy(s[:], schur.Raw())
+	if got, want := aInv.Inv().Det()*s.Det(), m.Det(); !FloatEqualThreshold(got, want, 1e-5) {
+		t.Errorf("det(A)*det(Schur complement) = %v, want det(M) = %v", got, want)
+	}
+
+	// Blockwise products match the full product.
+	full := NewMatrixFromData(m[:], 4, 4)
+	square := full.MulMxN(nil, full).View().Slice(0, 2, 2, 2).CopyTo(nil)
+	blockwise := a.MulMxN(nil, b).Add(nil, b.MulMxN(nil, d))
+	if !blockwise.ApproxEqualThreshold(square, 1e-6) {
+		t.Errorf("Blockwise product = %v, want %v", blockwise, square)
+	}
+	if v.MulMxN(nil, a) != nil {
+		t.Errorf("MulMxN of mismatched views didn't return nil")
+	}
+}
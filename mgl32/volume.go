@@ -0,0 +1,84 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// SignedTetraVolume returns the signed volume of the tetrahedron (a,b,c,d).
+// It is positive if d is on the side of the plane of (a,b,c) that the normal
+// (b-a)x(c-a) points to, i.e. (a,b,c) winds counterclockwise seen from d.
+func SignedTetraVolume(a, b, c, d Vec3) float32 {
+	return b.Sub(a).Cross(c.Sub(a)).Dot(d.Sub(a)) / 6
+}
+
+// MassProperties describes the volume and mass distribution of a solid of
+// uniform density 1. For a solid of density rho, multiply Volume and Inertia
+// by rho to get its mass and actual inertia tensor.
+type MassProperties struct {
+	Volume float32
+	// Centroid is the center of mass of the solid.
+	Centroid Vec3
+	// Inertia is the inertia tensor of the solid around its centroid, in the
+	// axes of the mesh.
+	Inertia Mat3
+}
+
+// MeshMassProperties integrates the volume, center of mass and inertia tensor
+// of the solid bounded by a closed triangle mesh. Each consecutive triple of
+// indices is a triangle of vertices; if indices is nil, each consecutive
+// triple of vertices is a triangle instead.
+//
+// The triangles must wind counterclockwise seen from outside of the solid,
+// as usual for back face culling; if they are all inverted, the volume (and
+// inertia) comes out negative. The mesh must be closed, but doesn't need to
+// be convex.
+//
+// The solid is decomposed into tetrahedra from an arbitrary reference point
+// to every triangle, whose signed contributions cancel out outside of it.
+// The second moments use the covariance of the canonical tetrahedron, see
+// Blow and Binstock, "How to find the inertia tensor (or other mass
+// properties) of a 3D solid body represented by a triangle mesh" (2004).
+func MeshMassProperties(vertices []Vec3, indices []uint32) MassProperties {
+	n := len(indices)
+	if indices == nil {
+		n = len(vertices)
+	}
+	vertex := func(i int) Vec3 {
+		if indices == nil {
+			return vertices[i]
+		}
+		return vertices[indices[i]]
+	}
+	if n < 3 {
+		return MassProperties{}
+	}
+
+	// Integrating relative to a point of the mesh, rather than the origin,
+	// limits the loss of precision for meshes far from the origin.
+	ref := vertex(0)
+	canonical := Mat3{2, 1, 1, 1, 2, 1, 1, 1, 2}.Mul(1. / 120)
+
+	var volume float32
+	var moment Vec3
+	var covariance Mat3
+	for i := 0; i+2 < n; i += 3 {
+		a, b, c := vertex(i).Sub(ref), vertex(i+1).Sub(ref), vertex(i+2).Sub(ref)
+		m := Mat3FromCols(a, b, c)
+		det := m.Det()
+
+		volume += det / 6
+		moment = moment.Add(a.Add(b).Add(c).Mul(det / 24))
+		covariance = covariance.Add(m.Mul3(canonical).Mul3(m.Transpose()).Mul(det))
+	}
+	if volume == 0 {
+		return MassProperties{}
+	}
+
+	// Move the covariance from the reference point to the centroid, and
+	// convert it to the inertia tensor.
+	centroid := moment.Mul(1 / volume)
+	covariance = covariance.Sub(centroid.OuterProd3(centroid).Mul(volume))
+	inertia := Ident3().Mul(covariance.Trace()).Sub(covariance)
+
+	return MassProperties{volume, centroid.Add(ref), inertia}
+}
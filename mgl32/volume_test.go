@@ -0,0 +1,84 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestSignedTetraVolume(t *testing.T) {
+	t.Parallel()
+
+	a, b, c, d := Vec3{0, 0, 0}, Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1}
+	if v := SignedTetraVolume(a, b, c, d); !FloatEqualThreshold(v, 1./6, 1e-6) {
+		t.Errorf("SignedTetraVolume = %v, expected 1/6", v)
+	}
+	if v := SignedTetraVolume(a, c, b, d); !FloatEqualThreshold(v, -1./6, 1e-6) {
+		t.Errorf("SignedTetraVolume with the triangle flipped = %v, expected -1/6", v)
+	}
+}
+
+// boxMesh returns a closed, outward facing mesh of the box.
+func boxMesh(box AABB) ([]Vec3, []uint32) {
+	var vertices []Vec3
+	for i := 0; i < 8; i++ {
+		v := box.Min
+		for k := 0; k < 3; k++ {
+			if i&(1<<uint(k)) != 0 {
+				v[k] = box.Max[k]
+			}
+		}
+		vertices = append(vertices, v)
+	}
+	indices := []uint32{
+		0, 2, 1, 1, 2, 3, // -z
+		4, 5, 6, 5, 7, 6, // +z
+		0, 1, 4, 1, 5, 4, // -y
+		2, 6, 3, 3, 6, 7, // +y
+		0, 4, 2, 2, 4, 6, // -x
+		1, 3, 5, 3, 7, 5, // +x
+	}
+	return vertices, indices
+}
+
+func TestMeshMassProperties(t *testing.T) {
+	t.Parallel()
+
+	// A 2x4x6 box away from the origin
+	box := AABB{Vec3{10, 20, 30}, Vec3{12, 24, 36}}
+	vertices, indices := boxMesh(box)
+
+	props := MeshMassProperties(vertices, indices)
+	if !FloatEqualThreshold(props.Volume, 48, 1e-4) {
+		t.Errorf("Volume = %v, expected 48", props.Volume)
+	}
+	if !props.Centroid.ApproxEqualThreshold(Vec3{11, 22, 33}, 1e-4) {
+		t.Errorf("Centroid = %v, expected [11 22 33]", props.Centroid)
+	}
+
+	// The inertia of a box of mass m around its center is
+	// m/12 * diag(h^2+d^2, w^2+d^2, w^2+h^2).
+	correct := Diag3(Vec3{16 + 36, 4 + 36, 4 + 16}.Mul(48. / 12))
+	if !props.Inertia.ApproxFuncEqual(correct, func(a, b float32) bool { return Abs(a-b) < 1e-2 }) {
+		t.Errorf("Inertia = %v, expected %v", props.Inertia, correct)
+	}
+
+	// The same mesh as a plain triangle list
+	var list []Vec3
+	for _, i := range indices {
+		list = append(list, vertices[i])
+	}
+	if p := MeshMassProperties(list, nil); !FloatEqualThreshold(p.Volume, props.Volume, 1e-4) || !p.Centroid.ApproxEqualThreshold(props.Centroid, 1e-4) {
+		t.Errorf("MeshMassProperties of a triangle list = %v, expected %v", p, props)
+	}
+
+	// Inverted winding gives a negative volume.
+	for i := 0; i < len(indices); i += 3 {
+		indices[i+1], indices[i+2] = indices[i+2], indices[i+1]
+	}
+	if p := MeshMassProperties(vertices, indices); !FloatEqualThreshold(p.Volume, -48, 1e-4) {
+		t.Errorf("Volume of an inverted mesh = %v, expected -48", p.Volume)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+// QuatYAML is the canonical struct form of a Quat for scene description
+// formats such as YAML, with the components named as they usually are in
+// such files:
+//
+//	rotation: {w: 1, x: 0, y: 0, z: 0}
+//
+// Building with the mgl_yaml tag makes Quat implement the Marshaler and
+// Unmarshaler interfaces of gopkg.in/yaml.v2 and gopkg.in/yaml.v3 with this
+// form, without the package depending on either.
+type QuatYAML struct {
+	W float32 `yaml:"w"`
+	X float32 `yaml:"x"`
+	Y float32 `yaml:"y"`
+	Z float32 `yaml:"z"`
+}
+
+// YAML returns the struct form of q.
+func (q Quat) YAML() QuatYAML {
+	return QuatYAML{q.W, q.V[0], q.V[1], q.V[2]}
+}
+
+// Quat returns the quaternion of the struct form.
+func (q QuatYAML) Quat() Quat {
+	return Quat{q.W, Vec3{q.X, q.Y, q.Z}}
+}
+
+// Mat4YAML is the canonical struct form of a Mat4 for scene description
+// formats such as YAML. It holds the rows of the matrix, so that the matrix
+// reads as it is written mathematically, rather than in the column major
+// order of its elements in memory:
+//
+//	transform:
+//	  rows: [[1, 0, 0, 5], [0, 1, 0, 0], [0, 0, 1, 0], [0, 0, 0, 1]]
+//
+// Building with the mgl_yaml tag makes Mat4 implement the Marshaler and
+// Unmarshaler interfaces of gopkg.in/yaml.v2 and gopkg.in/yaml.v3 with this
+// form, as for QuatYAML.
+type Mat4YAML struct {
+	Rows [4][4]float32 `yaml:"rows,flow"`
+}
+
+// YAML returns the struct form of m.
+func (m Mat4) YAML() Mat4YAML {
+	var y Mat4YAML
+	for i := range y.Rows {
+		y.Rows[i] = m.Row(i)
+	}
+	return y
+}
+
+// Mat4 returns the matrix of the struct form.
+func (m Mat4YAML) Mat4() Mat4 {
+	return Mat4FromRows(m.Rows[0], m.Rows[1], m.Rows[2], m.Rows[3])
+}
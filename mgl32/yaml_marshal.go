@@ -0,0 +1,43 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mgl_yaml
+// +build mgl_yaml
+
+package mgl32
+
+// These implement yaml.Marshaler of gopkg.in/yaml.v2 and gopkg.in/yaml.v3, and
+// yaml.Unmarshaler of yaml.v2, which yaml.v3 supports too. Neither needs to
+// import the yaml packages. Fields missing from a document are those of the
+// identity.
+
+// MarshalYAML marshals q as its QuatYAML form.
+func (q Quat) MarshalYAML() (interface{}, error) {
+	return q.YAML(), nil
+}
+
+// UnmarshalYAML unmarshals q from its QuatYAML form.
+func (q *Quat) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	y := QuatIdent().YAML()
+	if err := unmarshal(&y); err != nil {
+		return err
+	}
+	*q = y.Quat()
+	return nil
+}
+
+// MarshalYAML marshals m as its Mat4YAML form.
+func (m Mat4) MarshalYAML() (interface{}, error) {
+	return m.YAML(), nil
+}
+
+// UnmarshalYAML unmarshals m from its Mat4YAML form.
+func (m *Mat4) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	y := Ident4().YAML()
+	if err := unmarshal(&y); err != nil {
+		return err
+	}
+	*m = y.Mat4()
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mgl_yaml
+// +build mgl_yaml
+
+package mgl32
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestQuatMarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	q := QuatRotate(0.7, Vec3{1, 2, -1}.Normalize())
+	if out, err := q.MarshalYAML(); err != nil || out != q.YAML() {
+		t.Errorf("MarshalYAML() = %v, %v, want %v", out, err, q.YAML())
+	}
+
+	// A document giving only x and y, as decoded by the yaml packages.
+	var got Quat
+	err := got.UnmarshalYAML(func(v interface{}) error {
+		y := v.(*QuatYAML)
+		y.X, y.Y = 0.5, -0.5
+		return nil
+	})
+	if want := (Quat{1, Vec3{0.5, -0.5, 0}}); err != nil || got != want {
+		t.Errorf("UnmarshalYAML = %v, %v, want %v", got, err, want)
+	}
+
+	failure := errors.New("bad document")
+	got = QuatIdent()
+	if err := got.UnmarshalYAML(func(interface{}) error { return failure }); err != failure || got != QuatIdent() {
+		t.Errorf("UnmarshalYAML of a bad document = %v, %v", got, err)
+	}
+}
+
+func TestMat4MarshalYAML(t *testing.T) {
+	t.Parallel()
+
+	m := Translate3D(5, 6, 7)
+	if out, err := m.MarshalYAML(); err != nil || out != m.YAML() {
+		t.Errorf("MarshalYAML() = %v, %v, want %v", out, err, m.YAML())
+	}
+
+	var got Mat4
+	err := got.UnmarshalYAML(func(v interface{}) error {
+		v.(*Mat4YAML).Rows[0][3] = 5
+		return nil
+	})
+	if want := Translate3D(5, 0, 0); err != nil || got != want {
+		t.Errorf("UnmarshalYAML = %v, %v, want %v", got, err, want)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2014 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mgl32
+
+import (
+	"testing"
+)
+
+func TestQuatYAML(t *testing.T) {
+	t.Parallel()
+
+	q := QuatRotate(0.7, Vec3{1, 2, -1}.Normalize())
+	y := q.YAML()
+	if y.W != q.W || y.X != q.V[0] || y.Y != q.V[1] || y.Z != q.V[2] {
+		t.Errorf("YAML() = %+v, want the components of %v", y, q)
+	}
+	if back := y.Quat(); back != q {
+		t.Errorf("Quat() = %v, want %v", back, q)
+	}
+}
+
+func TestMat4YAML(t *testing.T) {
+	t.Parallel()
+
+	m := Translate3D(5, 6, 7).Mul4(HomogRotate3DZ(0.3))
+	y := m.YAML()
+	if want := [4]float32{1, 0, 0, 5}; Translate3D(5, 6, 7).YAML().Rows[0] != want {
+		t.Errorf("first row of a translation = %v, want %v", Translate3D(5, 6, 7).YAML().Rows[0], want)
+	}
+	if back := y.Mat4(); back != m {
+		t.Errorf("Mat4() = %v, want %v", back, m)
+	}
+}
@@ -85,6 +85,42 @@ func CylindricalToCartesian(rho, phi, z float64) Vec3 {
 	return Vec3{rho * float64(c), rho * float64(s), z}
 }
 
+// Vec2FromAngle returns the vector of length r at the angle theta, in
+// radians, counterclockwise from the positive x axis: the cartesian
+// coordinates of the polar coordinates (r, theta).
+func Vec2FromAngle(theta, r float64) Vec2 {
+	s, c := math.Sincos(float64(theta))
+	return Vec2{r * float64(c), r * float64(s)}
+}
+
+// Vec2ToAngle returns the angle theta, in the range [-Pi, Pi], and length r of
+// v, the inverse of Vec2FromAngle.
+func Vec2ToAngle(v Vec2) (theta, r float64) {
+	return v.Angle(), v.Len()
+}
+
+// Vec3FromYawPitch returns the unit direction a camera or weapon with the
+// given yaw and pitch in radians looks along, in the conventions of LookAt: y
+// is up and a yaw and pitch of 0 look along -z. Positive yaw turns left,
+// counterclockwise seen from above, and positive pitch looks up.
+func Vec3FromYawPitch(yaw, pitch float64) Vec3 {
+	sy, cy := math.Sincos(float64(yaw))
+	sp, cp := math.Sincos(float64(pitch))
+	return Vec3{float64(-sy * cp), float64(sp), float64(-cy * cp)}
+}
+
+// Vec3ToYawPitch returns the yaw, in the range [-Pi, Pi], and pitch, in the
+// range [-Pi/2, Pi/2], that look along v, the inverse of Vec3FromYawPitch. v
+// doesn't need to be normalized. Straight up or down the yaw is 0.
+func Vec3ToYawPitch(v Vec3) (yaw, pitch float64) {
+	x, y, z := float64(v[0]), float64(v[1]), float64(v[2])
+	if x != 0 || z != 0 {
+		yaw = float64(math.Atan2(0-x, -z)) // 0-x rather than -x looks behind at Pi, not -Pi
+	}
+	pitch = float64(math.Atan2(y, math.Hypot(x, z)))
+	return yaw, pitch
+}
+
 // DegToRad converts degrees to radians
 func DegToRad(angle float64) float64 {
 	return angle * float64(math.Pi) / 180
@@ -111,6 +111,68 @@ func TestSphereToCylinder(t *testing.T) {
 	}
 }
 
+func TestVec2FromAngle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Theta, R float64
+		V        Vec2
+	}{
+		{0, 1, Vec2{1, 0}},
+		{math.Pi / 2, 2, Vec2{0, 2}},
+		{-3 * math.Pi / 4, math.Sqrt2, Vec2{-1, -1}},
+		{math.Pi / 6, 4, Vec2{3.4641016, 2}},
+	}
+
+	for _, c := range tests {
+		if v := Vec2FromAngle(c.Theta, c.R); !v.ApproxFuncEqual(c.V, absEqual(1e-6)) {
+			t.Errorf("Vec2FromAngle(%v, %v) = %v, want %v", c.Theta, c.R, v, c.V)
+		}
+		if theta, r := Vec2ToAngle(c.V); !FloatEqualThreshold(theta, c.Theta, 1e-6) || !FloatEqualThreshold(r, c.R, 1e-6) {
+			t.Errorf("Vec2ToAngle(%v) = %v, %v, want %v, %v", c.V, theta, r, c.Theta, c.R)
+		}
+	}
+}
+
+func TestVec3FromYawPitch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Yaw, Pitch float64
+		V          Vec3
+	}{
+		{0, 0, Vec3{0, 0, -1}},
+		{math.Pi / 2, 0, Vec3{-1, 0, 0}},
+		{-math.Pi / 2, 0, Vec3{1, 0, 0}},
+		{math.Pi, 0, Vec3{0, 0, 1}},
+		{0, math.Pi / 4, Vec3{0, math.Sqrt2 / 2, -math.Sqrt2 / 2}},
+		{-math.Pi / 4, -math.Pi / 6, Vec3{0.6123724, -0.5, -0.6123724}},
+	}
+
+	for _, c := range tests {
+		v := Vec3FromYawPitch(c.Yaw, c.Pitch)
+		if !v.ApproxFuncEqual(c.V, absEqual(1e-6)) {
+			t.Errorf("Vec3FromYawPitch(%v, %v) = %v, want %v", c.Yaw, c.Pitch, v, c.V)
+		}
+		if yaw, pitch := Vec3ToYawPitch(c.V.Mul(3)); !FloatEqualThreshold(yaw, c.Yaw, 1e-6) || !FloatEqualThreshold(pitch, c.Pitch, 1e-6) {
+			t.Errorf("Vec3ToYawPitch(%v) = %v, %v, want %v, %v", c.V.Mul(3), yaw, pitch, c.Yaw, c.Pitch)
+		}
+
+		// A camera looking along the direction sees it straight ahead.
+		eye := Vec3{1, 2, 3}
+		view := LookAtV(eye, eye.Add(v), Vec3{0, 1, 0})
+		if c.Pitch == 0 {
+			if ahead := view.Mul4x1(eye.Add(v).Vec4(1)).Vec3(); !ahead.ApproxFuncEqual(Vec3{0, 0, -1}, absEqual(1e-5)) {
+				t.Errorf("LookAtV along Vec3FromYawPitch(%v, %v) sees it at %v", c.Yaw, c.Pitch, ahead)
+			}
+		}
+	}
+
+	if yaw, pitch := Vec3ToYawPitch(Vec3{0, 2, 0}); yaw != 0 || !FloatEqual(pitch, math.Pi/2) {
+		t.Errorf("Vec3ToYawPitch straight up = %v, %v, want 0, Pi/2", yaw, pitch)
+	}
+}
+
 func TestDegToRad(t *testing.T) {
 	tests := []struct {
 		Deg, Rad float64
@@ -8,6 +8,7 @@ package mgl64
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -289,6 +290,61 @@ func TestTransposeSquare(t *testing.T) {
 	}
 }
 
+func TestRowMajorArray(t *testing.T) {
+	t.Parallel()
+
+	m := Mat2x3FromRows(
+		Vec3{1, 2, 3},
+		Vec3{4, 5, 6},
+	)
+
+	data := m.RowMajorArray()
+	correct := [6]float64{1, 2, 3, 4, 5, 6}
+	if data != correct {
+		t.Errorf("RowMajorArray not correct. Got: %v, expected: %v", data, correct)
+	}
+
+	if back := Mat2x3FromRowMajor(data); back != m {
+		t.Errorf("Mat2x3FromRowMajor did not invert RowMajorArray. Got: %v, expected: %v", back, m)
+	}
+
+	m4 := Mat4{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	if m4.RowMajorArray() != [16]float64(m4.Transpose()) {
+		t.Errorf("RowMajorArray of a Mat4 is not the data of its transpose. Got: %v", m4.RowMajorArray())
+	}
+}
+
+func TestTransposedMul(t *testing.T) {
+	t.Parallel()
+
+	m1 := Mat3{1, 2, 3, 4, 5, 6, 7, 8, 10}
+	m2 := Mat3{-2, 1, 0, 3, 7, 1, 4, -5, 2}
+
+	if got, want := m1.TransposeMul3(m2), m1.Transpose().Mul3(m2); !want.ApproxEqualThreshold(got, 1e-4) {
+		t.Errorf("TransposeMul3 not correct. Got: %v, expected: %v", got, want)
+	}
+
+	if got, want := m1.MulTranspose3(m2), m1.Mul3(m2.Transpose()); !want.ApproxEqualThreshold(got, 1e-4) {
+		t.Errorf("MulTranspose3 not correct. Got: %v, expected: %v", got, want)
+	}
+}
+
+func TestVecMulMat(t *testing.T) {
+	t.Parallel()
+
+	m := Mat3x2{1, 2, 3, 4, 5, 6}
+	v := Vec3{1, 2, 3}
+	if got, want := v.MulMat3x2(m), m.Transpose().Mul3x1(v); !got.ApproxEqualThreshold(want, 1e-4) {
+		t.Errorf("Vec3.MulMat3x2 not correct. Got: %v, expected: %v", got, want)
+	}
+
+	// A row vector library stores the transpose of Translate3D.
+	rowMajor := Translate3D(1, 2, 3).Transpose()
+	if got, want := (Vec4{1, -1, 2, 1}).MulMat4(rowMajor), (Vec4{2, 1, 5, 1}); !got.ApproxEqualThreshold(want, 1e-4) {
+		t.Errorf("Vec4.MulMat4 not correct. Got: %v, expected: %v", got, want)
+	}
+}
+
 func TestAtSet(t *testing.T) {
 	t.Parallel()
 
@@ -315,6 +371,52 @@ func TestAtSet(t *testing.T) {
 	}
 }
 
+func TestRowOps(t *testing.T) {
+	t.Parallel()
+
+	m := Mat3x2FromRows(Vec2{1, 2}, Vec2{3, 4}, Vec2{5, 6})
+	m.SwapRows(0, 2)
+	if want := Mat3x2FromRows(Vec2{5, 6}, Vec2{3, 4}, Vec2{1, 2}); m != want {
+		t.Errorf("SwapRows(0, 2) = %v, want %v", m, want)
+	}
+	m.ScaleRow(1, -2)
+	if want := Mat3x2FromRows(Vec2{5, 6}, Vec2{-6, -8}, Vec2{1, 2}); m != want {
+		t.Errorf("ScaleRow(1, -2) = %v, want %v", m, want)
+	}
+	m.AddScaledRow(0, 2, -5)
+	if want := Mat3x2FromRows(Vec2{0, -4}, Vec2{-6, -8}, Vec2{1, 2}); m != want {
+		t.Errorf("AddScaledRow(0, 2, -5) = %v, want %v", m, want)
+	}
+
+	// Gauss-Jordan elimination with partial pivoting on [a | I] yields the
+	// inverse of a.
+	a := Mat4{2, 1, 0, 4, 0, 3, 1, 1, 5, 0, 2, 1, 1, 1, 1, 0}
+	inv, work := Ident4(), a
+	for col := 0; col < 4; col++ {
+		pivot := col
+		for row := col + 1; row < 4; row++ {
+			if Abs(work.At(row, col)) > Abs(work.At(pivot, col)) {
+				pivot = row
+			}
+		}
+		work.SwapRows(col, pivot)
+		inv.SwapRows(col, pivot)
+		c := 1 / work.At(col, col)
+		work.ScaleRow(col, c)
+		inv.ScaleRow(col, c)
+		for row := 0; row < 4; row++ {
+			if row != col {
+				c := -work.At(row, col)
+				work.AddScaledRow(row, col, c)
+				inv.AddScaledRow(row, col, c)
+			}
+		}
+	}
+	if want := a.Inv(); !inv.ApproxEqualThreshold(want, 1e-5) {
+		t.Errorf("elimination inverse = %v, want %v", inv, want)
+	}
+}
+
 func TestDiagTrace(t *testing.T) {
 	t.Parallel()
 
@@ -327,6 +429,31 @@ func TestDiagTrace(t *testing.T) {
 	}
 }
 
+func TestMatNorms(t *testing.T) {
+	t.Parallel()
+
+	// Column-major: the columns are {1, -3}, {4, 5} and {-6, 8}.
+	m := Mat2x3{1, -3, 4, 5, -6, 8}
+	if n := m.NormFrobenius(); !FloatEqualThreshold(n, float64(math.Sqrt(151)), 1e-6) {
+		t.Errorf("Mat2x3 Frobenius norm = %v, want sqrt(151)", n)
+	}
+	if n := m.Norm1(); !FloatEqual(n, 14) {
+		t.Errorf("Mat2x3 1-norm = %v, want 14", n)
+	}
+	if n := m.NormInf(); !FloatEqual(n, 16) {
+		t.Errorf("Mat2x3 infinity norm = %v, want 16", n)
+	}
+
+	// The 1-norm of a matrix is the infinity norm of its transpose.
+	m4 := Mat4{1, -2, 3, 0.5, 7, 0, -1, 2, -3, 4, 4, -4, 0, 1, 0, 9}
+	if n1, nInf := m4.Norm1(), m4.Transpose().NormInf(); !FloatEqual(n1, nInf) || !FloatEqual(n1, 15) {
+		t.Errorf("Mat4 1-norm = %v, infinity norm of transpose = %v, want 15", n1, nInf)
+	}
+	if n := Ident3().NormFrobenius(); !FloatEqualThreshold(n, float64(math.Sqrt(3)), 1e-6) {
+		t.Errorf("Ident3 Frobenius norm = %v, want sqrt(3)", n)
+	}
+}
+
 func TestMatAbs(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,8 @@ package mgl64
 
 import (
 	"math"
+
+	"github.com/go-gl/mathgl/mgl64/floats64"
 )
 
 // MatMxN is an arbitrary mxn matrix backed by a slice of floats.
@@ -24,6 +26,13 @@ import (
 // MatMN will always check if the receiver is nil on any method. Meaning MathMN(nil).Add(dst,m2)
 // should always work. Except for the Reshape function, the semantics of this is to "propogate" nils
 // forward, so if an invalid operation occurs in a long chain of matrix operations, the overall result will be nil.
+//
+// Operations producing a matrix or vector store it in a caller provided
+// destination, which they reshape as needed and return. They allocate only
+// when the destination is nil or its backing slice is too small, so reusing
+// destinations of sufficient capacity makes them allocation free. The
+// exceptions are MulMxN and MulNx1 with a destination that is also one of
+// their operands, which need a temporary copy of it.
 type MatMxN struct {
 	m, n int
 	dat  []float64
@@ -31,11 +40,7 @@ type MatMxN struct {
 
 // NewMatrix creates a matrix backed by a new slice of size m*n
 func NewMatrix(m, n int) (mat *MatMxN) {
-	if shouldPool {
-		return &MatMxN{m: m, n: n, dat: grabFromPool(m * n)}
-	} else {
-		return &MatMxN{m: m, n: n, dat: make([]float64, m*n)}
-	}
+	return &MatMxN{m: m, n: n, dat: newSlice(m * n)}
 }
 
 // NewMatrixFromData returns a matrix with data specified by the data in src
@@ -50,12 +55,7 @@ func NewMatrix(m, n int) (mat *MatMxN) {
 //
 // If m*n > cap(src), this function will panic.
 func NewMatrixFromData(src []float64, m, n int) *MatMxN {
-	var internal []float64
-	if shouldPool {
-		internal = grabFromPool(m * n)
-	} else {
-		internal = make([]float64, m*n)
-	}
+	internal := newSlice(m * n)
 	copy(internal, src[:m*n])
 
 	return &MatMxN{m: m, n: n, dat: internal}
@@ -166,14 +166,16 @@ func (mat *MatMxN) Reshape(m, n int) *MatMxN {
 	if shouldPool && mat.dat != nil {
 		returnToPool(mat.dat)
 	}
-	(*mat) = (*NewMatrix(m, n))
+	mat.m, mat.n, mat.dat = m, n, newSlice(m*n)
 
 	return mat
 }
 
-// InferMatrix infers an MxN matrix from a constant matrix from this package.
-// For instance, a Mat2x3 inferred with this function will work just like
+// InferMatrix infers an MxN matrix from a constant matrix from this package
+// and stores it in mat, which is reshaped as with Reshape and returned. For
+// instance, a Mat2x3 inferred with this function will work just like
 // NewMatrixFromData(m[:],2,3) where m is the Mat2x3. This uses a type switch.
+// If mat is nil, a new matrix is returned.
 //
 // I personally recommend using NewMatrixFromData, because it avoids a
 // potentially costly type switch. However, this is also more robust and less
@@ -183,28 +185,35 @@ func (mat *MatMxN) Reshape(m, n int) *MatMxN {
 func (mat *MatMxN) InferMatrix(m interface{}) (*MatMxN, error) {
 	switch raw := m.(type) {
 	case Mat2:
-		return NewMatrixFromData(raw[:], 2, 2), nil
+		return mat.setFrom(raw[:], 2, 2), nil
 	case Mat2x3:
-		return NewMatrixFromData(raw[:], 2, 3), nil
+		return mat.setFrom(raw[:], 2, 3), nil
 	case Mat2x4:
-		return NewMatrixFromData(raw[:], 2, 4), nil
+		return mat.setFrom(raw[:], 2, 4), nil
 	case Mat3:
-		return NewMatrixFromData(raw[:], 3, 3), nil
+		return mat.setFrom(raw[:], 3, 3), nil
 	case Mat3x2:
-		return NewMatrixFromData(raw[:], 3, 2), nil
+		return mat.setFrom(raw[:], 3, 2), nil
 	case Mat3x4:
-		return NewMatrixFromData(raw[:], 3, 4), nil
+		return mat.setFrom(raw[:], 3, 4), nil
 	case Mat4:
-		return NewMatrixFromData(raw[:], 4, 4), nil
+		return mat.setFrom(raw[:], 4, 4), nil
 	case Mat4x2:
-		return NewMatrixFromData(raw[:], 4, 2), nil
+		return mat.setFrom(raw[:], 4, 2), nil
 	case Mat4x3:
-		return NewMatrixFromData(raw[:], 4, 3), nil
+		return mat.setFrom(raw[:], 4, 3), nil
 	default:
 		return nil, InferMatrixError{}
 	}
 }
 
+// setFrom reshapes mat to m by n and copies the column major data into it.
+func (mat *MatMxN) setFrom(data []float64, m, n int) *MatMxN {
+	mat = mat.Reshape(m, n)
+	copy(mat.dat, data)
+	return mat
+}
+
 // Trace returns the trace of a square matrix (sum of all diagonal elements). If
 // the matrix is nil, or not square, the result will be NaN.
 func (mat *MatMxN) Trace() float64 {
@@ -220,48 +229,121 @@ func (mat *MatMxN) Trace() float64 {
 	return out
 }
 
+// NormFrobenius returns the Frobenius norm of the matrix, the square root of
+// the sum of the squares of all elements. If the matrix is nil, the result
+// will be NaN.
+func (mat *MatMxN) NormFrobenius() float64 {
+	if mat == nil {
+		return float64(math.NaN())
+	}
+
+	return float64(math.Sqrt(float64(floats64.Dot(mat.dat, mat.dat))))
+}
+
+// Norm1 returns the 1-norm of the matrix, the largest sum of the absolute
+// values of the elements of a column. If the matrix is nil, the result will
+// be NaN.
+func (mat *MatMxN) Norm1() float64 {
+	if mat == nil {
+		return float64(math.NaN())
+	}
+
+	var norm float64
+	for j := 0; j < mat.n; j++ {
+		var sum float64
+		for i := 0; i < mat.m; i++ {
+			sum += Abs(mat.At(i, j))
+		}
+		if sum > norm {
+			norm = sum
+		}
+	}
+
+	return norm
+}
+
+// NormInf returns the infinity norm of the matrix, the largest sum of the
+// absolute values of the elements of a row. If the matrix is nil, the result
+// will be NaN.
+func (mat *MatMxN) NormInf() float64 {
+	if mat == nil {
+		return float64(math.NaN())
+	}
+
+	var norm float64
+	for i := 0; i < mat.m; i++ {
+		var sum float64
+		for j := 0; j < mat.n; j++ {
+			sum += Abs(mat.At(i, j))
+		}
+		if sum > norm {
+			norm = sum
+		}
+	}
+
+	return norm
+}
+
 // Transpose takes the transpose of mat and puts it in dst.
 //
-// If dst is not of the correct dimensions, it will be Reshaped,
-// if dst and mat are the same, a temporary matrix of the correct size will
-// be allocated; these resources will be released via the memory pool.
-//
-// This should be improved in the future.
+// If dst is not of the correct dimensions, it will be Reshaped. Dst may be mat
+// itself, in which case the matrix is transposed in place without a
+// temporary; for non-square matrices this follows the cycles of the
+// permutation of the elements, which is slower than transposing into a
+// separate matrix.
 func (mat *MatMxN) Transpose(dst *MatMxN) (t *MatMxN) {
 	if mat == nil {
 		return nil
 	}
 
 	if dst == mat {
-		dst = NewMatrix(mat.n, mat.m)
-
-		// Copy data to correct matrix,
-		// delete temporary buffer,
-		// and set the return value to the
-		// correct one
-		defer func() {
-			copy(mat.dat, dst.dat)
-
-			mat.m, mat.n = mat.n, mat.m
-
-			dst.destroy()
-			t = mat
-		}()
-
+		mat.transposeInPlace()
 		return mat
-	} else {
-		dst = dst.Reshape(mat.n, mat.m)
 	}
 
-	for r := 0; r < mat.m; r++ {
-		for c := 0; c < mat.n; c++ {
-			dst.dat[r*dst.m+c] = mat.dat[c*mat.m+r]
+	dst = dst.Reshape(mat.n, mat.m)
+	for c := 0; c < mat.n; c++ {
+		for r, v := range mat.dat[c*mat.m : (c+1)*mat.m] {
+			dst.dat[r*dst.m+c] = v
 		}
 	}
 
 	return dst
 }
 
+func (mat *MatMxN) transposeInPlace() {
+	m, n := mat.m, mat.n
+	mat.m, mat.n = n, m
+	if m == n {
+		for c := 0; c < n; c++ {
+			for r := c + 1; r < n; r++ {
+				mat.dat[c*n+r], mat.dat[r*n+c] = mat.dat[r*n+c], mat.dat[c*n+r]
+			}
+		}
+		return
+	}
+
+	// Element k = c*m+r moves to r*n+c, which is k*n mod (m*n-1) for every
+	// element but the last, which like the first stays in place. Each cycle of
+	// this permutation is rotated once, starting from its smallest index.
+	size := m*n - 1
+	for start := 1; start < size; start++ {
+		next := start * n % size
+		for next > start {
+			next = next * n % size
+		}
+		if next < start {
+			continue
+		}
+
+		v := mat.dat[start]
+		for k := start * n % size; k != start; k = k * n % size {
+			mat.dat[k], v = v, mat.dat[k]
+		}
+		mat.dat[start] = v
+	}
+}
+
 // Raw returns the raw slice backing this matrix
 func (mat *MatMxN) Raw() []float64 {
 	if mat == nil {
@@ -303,6 +385,74 @@ func (mat *MatMxN) Set(row, col int, val float64) {
 	mat.dat[col*mat.m+row] = val
 }
 
+// SetRow sets the given row of the matrix to v and returns mat. If mat or v
+// is nil, or v doesn't have as many elements as mat has columns, this is a
+// no-op and returns nil.
+func (mat *MatMxN) SetRow(row int, v *VecN) *MatMxN {
+	if mat == nil || v == nil || len(v.vec) != mat.n {
+		return nil
+	}
+
+	for j, x := range v.vec {
+		mat.dat[j*mat.m+row] = x
+	}
+
+	return mat
+}
+
+// SetCol sets the given column of the matrix to v and returns mat. If mat or
+// v is nil, or v doesn't have as many elements as mat has rows, this is a
+// no-op and returns nil.
+func (mat *MatMxN) SetCol(col int, v *VecN) *MatMxN {
+	if mat == nil || v == nil || len(v.vec) != mat.m {
+		return nil
+	}
+
+	copy(mat.dat[col*mat.m:(col+1)*mat.m], v.vec)
+
+	return mat
+}
+
+// SwapRows exchanges rows i and j of the matrix in place and returns mat.
+func (mat *MatMxN) SwapRows(i, j int) *MatMxN {
+	if mat == nil {
+		return nil
+	}
+
+	for k := 0; k < len(mat.dat); k += mat.m {
+		mat.dat[k+i], mat.dat[k+j] = mat.dat[k+j], mat.dat[k+i]
+	}
+
+	return mat
+}
+
+// ScaleRow multiplies row i of the matrix by c in place and returns mat.
+func (mat *MatMxN) ScaleRow(i int, c float64) *MatMxN {
+	if mat == nil {
+		return nil
+	}
+
+	for k := i; k < len(mat.dat); k += mat.m {
+		mat.dat[k] *= c
+	}
+
+	return mat
+}
+
+// AddScaledRow adds c times row j to row i of the matrix in place, the
+// elementary row operation of Gaussian elimination, and returns mat.
+func (mat *MatMxN) AddScaledRow(i, j int, c float64) *MatMxN {
+	if mat == nil {
+		return nil
+	}
+
+	for k := 0; k < len(mat.dat); k += mat.m {
+		mat.dat[k+i] += c * mat.dat[k+j]
+	}
+
+	return mat
+}
+
 // Add is the arithemtic + operator defined on a MatMxN.
 func (mat *MatMxN) Add(dst *MatMxN, addend *MatMxN) *MatMxN {
 	if mat == nil || addend == nil || mat.m != addend.m || mat.n != addend.n {
@@ -313,13 +463,29 @@ func (mat *MatMxN) Add(dst *MatMxN, addend *MatMxN) *MatMxN {
 
 	// No need to care about rows and columns
 	// since it's element-wise anyway
-	for i, el := range mat.dat {
-		dst.dat[i] = el + addend.dat[i]
-	}
+	floats64.Add(dst.dat, mat.dat, addend.dat)
 
 	return dst
 }
 
+// AddOuter performs the rank-1 update mat += alpha * u * v^T in place and
+// returns mat, without building the outer product. This is how covariance
+// matrices are accumulated and how Householder reflections are applied.
+//
+// If mat, u or v are nil, or u doesn't have as many elements as mat has rows
+// or v as many as mat has columns, this returns nil.
+func (mat *MatMxN) AddOuter(alpha float64, u, v *VecN) *MatMxN {
+	if mat == nil || u == nil || v == nil || len(u.vec) != mat.m || len(v.vec) != mat.n {
+		return nil
+	}
+
+	for j, vj := range v.vec {
+		floats64.AXPY(alpha*vj, u.vec, mat.dat[j*mat.m:(j+1)*mat.m])
+	}
+
+	return mat
+}
+
 // Sub is the arithemtic - operator defined on a MatMxN.
 func (mat *MatMxN) Sub(dst *MatMxN, subtrahend *MatMxN) *MatMxN {
 	if mat == nil || subtrahend == nil || mat.m != subtrahend.m || mat.n != subtrahend.n {
@@ -330,9 +496,7 @@ func (mat *MatMxN) Sub(dst *MatMxN, subtrahend *MatMxN) *MatMxN {
 
 	// No need to care about rows and columns
 	// since it's element-wise anyway
-	for i, el := range mat.dat {
-		dst.dat[i] = el - subtrahend.dat[i]
-	}
+	floats64.Sub(dst.dat, mat.dat, subtrahend.dat)
 
 	return dst
 }
@@ -341,10 +505,13 @@ func (mat *MatMxN) Sub(dst *MatMxN, subtrahend *MatMxN) *MatMxN {
 // storing the result in dst. This returns dst, or nil if the operation is not
 // able to be performed.
 //
-// If mat == dst, or mul == dst a temporary matrix will be used.
+// If mat == dst, or mul == dst a temporary matrix will be used, the only case
+// in which this allocates when dst has enough capacity.
 //
-// This uses the naive algorithm (though on smaller matrices, this can actually
-// be faster; about len(mat)+len(mul) < ~100)
+// This uses the naive O(n^3) algorithm, computing several columns of the
+// result at a time, and for a mat bigger than 128x128 splits it into tiles
+// that fit in cache. Strassen's algorithm isn't worth its loss of accuracy
+// at the sizes MatMxN is meant for.
 func (mat *MatMxN) MulMxN(dst *MatMxN, mul *MatMxN) *MatMxN {
 	if mat == nil || mul == nil || mat.n != mul.m {
 		return nil
@@ -368,21 +535,74 @@ func (mat *MatMxN) MulMxN(dst *MatMxN, mul *MatMxN) *MatMxN {
 		defer mat.destroy()
 	}
 
+	// Each column of dst is accumulated as a sum of columns of mat scaled by
+	// the elements of a column of mul, so that the inner loop runs over
+	// contiguous memory and its bounds checks are hoisted out of it.
 	dst = dst.Reshape(mat.m, mul.n)
-	for r1 := 0; r1 < mat.m; r1++ {
-		for c2 := 0; c2 < mul.n; c2++ {
+	for i := range dst.dat {
+		dst.dat[i] = 0
+	}
+	if len(mat.dat) <= mulBlockSize*mulBlockSize {
+		mulBlock(dst, mat, mul, 0, mat.m, 0, mat.n)
+		return dst
+	}
 
-			dst.dat[c2*mat.m+r1] = 0
-			for i := 0; i < mat.n; i++ {
-				dst.dat[c2*mat.m+r1] += mat.dat[i*mat.m+r1] * mul.dat[c2*mul.m+i]
+	// Past the cache size, every column of dst would stream all of mat from
+	// memory, so mat is split into tiles small enough to stay in cache while
+	// they are used for all the columns of dst.
+	for r0 := 0; r0 < mat.m; r0 += mulBlockSize {
+		r1 := r0 + mulBlockSize
+		if r1 > mat.m {
+			r1 = mat.m
+		}
+		for i0 := 0; i0 < mat.n; i0 += mulBlockSize {
+			i1 := i0 + mulBlockSize
+			if i1 > mat.n {
+				i1 = mat.n
 			}
-
+			mulBlock(dst, mat, mul, r0, r1, i0, i1)
 		}
 	}
 
 	return dst
 }
 
+// mulBlockSize is the size of the square tiles of the left operand used by
+// MulMxN on large matrices; a tile takes 64KiB, to fit in the L2 cache.
+const mulBlockSize = 128
+
+// mulBlock adds to rows [r0, r1) of dst the product of the tile of mat made
+// of rows [r0, r1) and columns [i0, i1) with rows [i0, i1) of mul. Columns of
+// dst are computed four at a time, so every element of the tile loaded from
+// memory is used four times.
+func mulBlock(dst, mat, mul *MatMxN, r0, r1, i0, i1 int) {
+	c2 := 0
+	for ; c2+4 <= mul.n; c2 += 4 {
+		d0 := dst.dat[c2*dst.m+r0 : c2*dst.m+r1]
+		d1 := dst.dat[(c2+1)*dst.m+r0 : (c2+1)*dst.m+r1]
+		d2 := dst.dat[(c2+2)*dst.m+r0 : (c2+2)*dst.m+r1]
+		d3 := dst.dat[(c2+3)*dst.m+r0 : (c2+3)*dst.m+r1]
+		for i := i0; i < i1; i++ {
+			b0, b1, b2, b3 := mul.dat[c2*mul.m+i], mul.dat[(c2+1)*mul.m+i], mul.dat[(c2+2)*mul.m+i], mul.dat[(c2+3)*mul.m+i]
+			a := mat.dat[i*mat.m+r0 : i*mat.m+r1]
+			d0, d1, d2, d3 := d0[:len(a)], d1[:len(a)], d2[:len(a)], d3[:len(a)]
+			for r, x := range a {
+				d0[r] += b0 * x
+				d1[r] += b1 * x
+				d2[r] += b2 * x
+				d3[r] += b3 * x
+			}
+		}
+	}
+	for ; c2 < mul.n; c2++ {
+		col := dst.dat[c2*dst.m+r0 : c2*dst.m+r1]
+		for i, c := range mul.dat[c2*mul.m+i0 : c2*mul.m+i1] {
+			start := (i0+i)*mat.m + r0
+			floats64.AXPY(c, mat.dat[start:start+r1-r0], col)
+		}
+	}
+}
+
 // Mul performs a scalar multiplication between mat and some constant c,
 // storing the result in dst. Mat and dst can be equal. If dst is not the
 // correct size, a Reshape will occur.
@@ -393,9 +613,7 @@ func (mat *MatMxN) Mul(dst *MatMxN, c float64) *MatMxN {
 
 	dst = dst.Reshape(mat.m, mat.n)
 
-	for i, el := range mat.dat {
-		dst.dat[i] = el * c
-	}
+	floats64.Scale(dst.dat, mat.dat, c)
 
 	return dst
 }
@@ -405,7 +623,7 @@ func (mat *MatMxN) Mul(dst *MatMxN, c float64) *MatMxN {
 // this also returns nil.
 //
 // Dst will be resized if it's not big enough. If dst == v; a temporary vector
-// will be allocated and returned via the realloc callback when complete.
+// will be allocated and returned to the memory pool when complete.
 func (mat *MatMxN) MulNx1(dst, v *VecN) *VecN {
 	if mat == nil || v == nil || mat.n != len(v.vec) {
 		return nil
@@ -418,13 +636,11 @@ func (mat *MatMxN) MulNx1(dst, v *VecN) *VecN {
 	}
 
 	dst = dst.Resize(mat.m)
-
-	for r := 0; r < mat.m; r++ {
+	for r := range dst.vec {
 		dst.vec[r] = 0
-
-		for c := 0; c < mat.n; c++ {
-			dst.vec[r] += mat.At(r, c) * v.vec[c]
-		}
+	}
+	for c, vc := range v.vec {
+		floats64.AXPY(vc, mat.dat[c*mat.m:(c+1)*mat.m], dst.vec)
 	}
 
 	return dst
@@ -55,6 +55,15 @@ func genPoolNew(i int) func() interface{} {
 	}
 }
 
+// newSlice returns a slice of length size for the backing data of a VecN or
+// MatMxN, from the memory pool unless pooling is disabled.
+func newSlice(size int) []float64 {
+	if shouldPool {
+		return grabFromPool(size)
+	}
+	return make([]float64, size)
+}
+
 // Grabs a slice from the memory pool, such that its cap
 // is 2^p where p is Ceil(log_2(size)). It will be downsliced
 // such that the len is size.
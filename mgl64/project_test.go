@@ -245,3 +245,58 @@ func TestFrustum(t *testing.T) {
 		}
 	}
 }
+
+func TestPerspectiveFromIntrinsics(t *testing.T) {
+	t.Parallel()
+
+	fx, fy, cx, cy := float64(800), float64(750), float64(315.5), float64(245.5)
+	width, height := 640, 480
+	proj := PerspectiveFromIntrinsics(fx, fy, cx, cy, width, height, 0.1, 100)
+
+	// A world point seen by an OpenCV camera with some extrinsics must land
+	// on the pixel given by the camera matrix.
+	rotation := Rotate3DY(0.3).Mul3(Rotate3DX(-0.2))
+	translation := Vec3{0.5, -0.2, 3}
+	view := ViewFromExtrinsics(rotation, translation)
+
+	for _, p := range []Vec3{{0, 0, 0}, {1, -0.5, 2}, {-0.7, 0.4, -1}} {
+		c := rotation.Mul3x1(p).Add(translation)
+		u, v := fx*c[0]/c[2]+cx, fy*c[1]/c[2]+cy
+
+		// Project puts the center of pixel (0,0) at (0.5, 0.5), with y up.
+		win := Project(p, view, proj, 0, 0, width, height)
+		gotU, gotV := win[0]-0.5, float64(height)-win[1]-0.5
+		if Abs(gotU-u) > 1e-2 || Abs(gotV-v) > 1e-2 {
+			t.Errorf("Point %v projected to pixel (%v, %v), expected (%v, %v)", p, gotU, gotV, u, v)
+		}
+		if win[2] < 0 || win[2] > 1 {
+			t.Errorf("Point %v in front of the camera has depth %v outside of [0,1]", p, win[2])
+		}
+	}
+}
+
+func TestPerspectiveFilmback(t *testing.T) {
+	t.Parallel()
+
+	// A 36x24mm film back with a 50mm lens and no shift is a regular
+	// symmetric perspective.
+	fovy := FocalLengthToFOV(50, 24)
+	if correct := 2 * float64(math.Atan(12./50)); !FloatEqualThreshold(fovy, correct, 1e-6) {
+		t.Errorf("FocalLengthToFOV(50, 24) = %v, expected %v", fovy, correct)
+	}
+	proj := PerspectiveFilmback(36, 24, 50, 0, 0, 0.1, 100)
+	if correct := Perspective(fovy, 36./24, 0.1, 100); !proj.ApproxEqualThreshold(correct, 1e-4) {
+		t.Errorf("PerspectiveFilmback without shift = %v, expected %v", proj, correct)
+	}
+
+	// Shifting the lens up by half the film height puts the point that used
+	// to be at the top of the image at its center.
+	shifted := PerspectiveFilmback(36, 24, 50, 0, 0.5, 0.1, 100)
+	p := Vec3{0, 12, -50}
+	if win := Project(p, Ident4(), shifted, 0, 0, 360, 240); !win.Vec2().ApproxEqualThreshold(Vec2{180, 120}, 1e-4) {
+		t.Errorf("Shifted projection put %v at %v, expected the center of the viewport", p, win)
+	}
+	if win := Project(p, Ident4(), proj, 0, 0, 360, 240); !win.Vec2().ApproxEqualThreshold(Vec2{180, 240}, 1e-4) {
+		t.Errorf("Unshifted projection put %v at %v, expected the top of the viewport", p, win)
+	}
+}
@@ -33,6 +33,27 @@ func TestQuatMulIdentity(t *testing.T) {
 	}
 }
 
+func TestQuatComponentOrder(t *testing.T) {
+	t.Parallel()
+
+	q := QuatFromXYZW(1, 2, 3, 4)
+	if correct := (Quat{4, Vec3{1, 2, 3}}); q != correct {
+		t.Errorf("QuatFromXYZW not correct. Got: %v, expected: %v", q, correct)
+	}
+
+	if got := QuatFromWXYZ(4, 1, 2, 3); got != q {
+		t.Errorf("QuatFromWXYZ not correct. Got: %v, expected: %v", got, q)
+	}
+
+	if got, correct := q.XYZW(), [4]float64{1, 2, 3, 4}; got != correct {
+		t.Errorf("XYZW not correct. Got: %v, expected: %v", got, correct)
+	}
+
+	if got, correct := q.WXYZ(), [4]float64{4, 1, 2, 3}; got != correct {
+		t.Errorf("WXYZ not correct. Got: %v, expected: %v", got, correct)
+	}
+}
+
 func TestQuatRotateOnAxis(t *testing.T) {
 	t.Parallel()
 
@@ -667,6 +688,9 @@ func TestQuatNormalize(t *testing.T) {
 	}
 
 	for _, c := range tests {
+		if c.Rotation == (Quat{}) && Singular == SingularPanic {
+			continue // Built with mgl_debug
+		}
 		if r := c.Rotation.Normalize(); !r.ApproxEqualThreshold(c.Expected, 1e-4) {
 			t.Errorf("Quat(%v).Normalize() != %v (got %v)", c.Rotation, c.Expected, r)
 		}
@@ -708,12 +732,134 @@ func TestQuatSlerp(t *testing.T) {
 	}
 
 	for _, c := range tests {
+		if c.A == (Quat{}) && Singular == SingularPanic {
+			continue // Built with mgl_debug
+		}
 		if r := QuatSlerp(c.A, c.B, c.Scalar); !r.ApproxEqualThreshold(c.Expected, 1e-2) {
 			t.Errorf("QuatSlerp(%v, %v, %v) != %v (got %v)", c.A, c.B, c.Scalar, c.Expected, r)
 		}
 	}
 }
 
+// rotationBetween returns the angle of the rotation from q1 to q2, measured
+// through their distance rather than their dot product, which loses precision
+// for close rotations.
+func rotationBetween(q1, q2 Quat) float64 {
+	q1, q2 = q1.Normalize(), q2.Normalize()
+	if q1.Dot(q2) < 0 {
+		q2 = q2.Scale(-1)
+	}
+	return 4 * math.Asin(float64(q1.Sub(q2).Len())/2)
+}
+
+func TestQuatSlerpLongShort(t *testing.T) {
+	t.Parallel()
+
+	q1, q2 := QuatIdent(), QuatRotate(math.Pi/2, Vec3{0, 0, 1})
+	tests := []struct {
+		Amount float64
+		Long   Quat
+		Short  Quat
+	}{
+		{0, q1, q1},
+		{0.5, QuatRotate(-3*math.Pi/4, Vec3{0, 0, 1}), QuatRotate(math.Pi/4, Vec3{0, 0, 1})},
+		{1.0 / 3, QuatRotate(-math.Pi/2, Vec3{0, 0, 1}), QuatRotate(math.Pi/6, Vec3{0, 0, 1})},
+		{1, q2, q2},
+	}
+
+	for _, c := range tests {
+		if r := QuatSlerpLong(q1, q2, c.Amount); rotationBetween(r, c.Long) > 1e-5 {
+			t.Errorf("QuatSlerpLong(%v, %v, %v) = %v, want %v", q1, q2, c.Amount, r, c.Long)
+		}
+		if r := QuatSlerpShort(q1, q2, c.Amount); rotationBetween(r, c.Short) > 1e-5 {
+			t.Errorf("QuatSlerpShort(%v, %v, %v) = %v, want %v", q1, q2, c.Amount, r, c.Short)
+		}
+	}
+
+	// The long way between the same orientations is a full turn.
+	q := QuatRotate(0.7, Vec3{1, 2, -1}.Normalize())
+	for _, amount := range []float64{0.25, 0.5, 0.75} {
+		want := 2 * math.Pi * float64(amount)
+		if want > math.Pi {
+			want = 2*math.Pi - want
+		}
+		if r := QuatSlerpLong(q, q.Scale(-1), amount); math.Abs(rotationBetween(r, q)-want) > 1e-5 {
+			t.Errorf("QuatSlerpLong(q, q, %v) turned by %v, want %v", amount, rotationBetween(r, q), want)
+		}
+	}
+}
+
+func TestQuatNlerpFast(t *testing.T) {
+	t.Parallel()
+
+	q1 := QuatRotate(0.3, Vec3{0, 1, 0})
+	axis := Vec3{1, -2, 0.5}.Normalize()
+	var worst float64
+	for angle := float64(0); angle < 2*math.Pi; angle += 0.05 {
+		q2 := QuatRotate(angle, axis).Mul(q1)
+		for amount := float64(0); amount <= 1; amount += 0.0625 {
+			want := QuatSlerp(q1, q2, amount)
+			got := QuatNlerpFast(q1, q2, amount)
+			if err := rotationBetween(got, want); err > worst {
+				worst = err
+			}
+		}
+	}
+	if worst > 1e-3 {
+		t.Errorf("QuatNlerpFast is up to %v radians from QuatSlerp", worst)
+	}
+
+	if r := QuatNlerpFast(q1, q1.Scale(-1), 0.5); rotationBetween(r, q1) > 1e-6 {
+		t.Errorf("QuatNlerpFast between opposite signs of a rotation = %v, want %v", r, q1)
+	}
+}
+
+func TestQuatLogExp(t *testing.T) {
+	t.Parallel()
+
+	axis := Vec3{1, 2, -1}.Normalize()
+	tests := []struct {
+		Q   Quat
+		Log Vec3
+	}{
+		{QuatIdent(), Vec3{}},
+		{QuatRotate(0.8, axis), axis.Mul(0.4)},
+		{QuatRotate(math.Pi, Vec3{0, 0, 1}), Vec3{0, 0, math.Pi / 2}},
+		{QuatRotate(0.8, axis).Scale(3), axis.Mul(0.4)},
+	}
+
+	for _, c := range tests {
+		if got := c.Q.Log(); !got.ApproxEqualThreshold(c.Log, 1e-6) {
+			t.Errorf("%v.Log() = %v, want %v", c.Q, got, c.Log)
+		}
+		if got := QuatExp(c.Log); rotationBetween(got, c.Q) > 1e-5 || !FloatEqualThreshold(got.Len(), 1, 1e-6) {
+			t.Errorf("QuatExp(%v) = %v, want %v", c.Log, got, c.Q.Normalize())
+		}
+	}
+
+	if got := QuatExp(Vec3{}); got != QuatIdent() {
+		t.Errorf("QuatExp(0) = %v, want the identity", got)
+	}
+}
+
+func TestQuatPow(t *testing.T) {
+	t.Parallel()
+
+	axis := Vec3{1, 2, -1}.Normalize()
+	q := QuatRotate(1.2, axis)
+	for _, p := range []float64{0, 0.5, 1, 2, -1} {
+		if got, want := q.Pow(p), QuatRotate(1.2*p, axis); rotationBetween(got, want) > 1e-5 {
+			t.Errorf("Pow(%v) = %v, want %v", p, got, want)
+		}
+	}
+	if got, want := q.Pow(0.3), QuatSlerp(QuatIdent(), q, 0.3); rotationBetween(got, want) > 1e-5 {
+		t.Errorf("Pow(0.3) = %v, but QuatSlerp gives %v", got, want)
+	}
+	if got := q.Pow(-1); !got.ApproxEqualThreshold(q.Inverse(), 1e-6) {
+		t.Errorf("Pow(-1) = %v, want the inverse %v", got, q.Inverse())
+	}
+}
+
 func TestQuatDot(t *testing.T) {
 	tests := []struct {
 		A, B     Quat
@@ -6,6 +6,8 @@
 
 //#go:generate go run codegen.go -template vector.tmpl -output vector.go
 //#go:generate go run codegen.go -template matrix.tmpl -output matrix.go
+//#go:generate go run codegen.go -template seq.tmpl -output seq.go
+//#go:generate go run codegen.go -template veci.tmpl -output veci.go
 //#go:generate go run codegen.go -mgl64
 
 package mgl64
@@ -7,6 +7,7 @@
 package mgl64
 
 import (
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -146,6 +147,22 @@ func TestVecOuterProd(t *testing.T) {
 	}
 }
 
+func TestVecSquareOuterProd(t *testing.T) {
+	v1, v2 := Vec3{1, 2, 3}, Vec3{-1, 0, 4}
+	correct := Mat3FromRows(
+		Vec3{-1, 0, 4},
+		Vec3{-2, 0, 8},
+		Vec3{-3, 0, 12},
+	)
+
+	if result := v1.OuterProd(v2); result != correct {
+		t.Errorf("Vec3.OuterProd = %v, expected %v", result, correct)
+	}
+	if result := (Vec4{1, 2, 3, 4}).OuterProd(Vec4{1, 0, 0, 1}); result != (Vec4{1, 2, 3, 4}).OuterProd4(Vec4{1, 0, 0, 1}) {
+		t.Errorf("Vec4.OuterProd = %v, differs from OuterProd4", result)
+	}
+}
+
 func TestVecCrossProduct(t *testing.T) {
 	v1 := Vec3{1, 2, 3}
 	v2 := Vec3{10, 11, 12}
@@ -158,6 +175,62 @@ func TestVecCrossProduct(t *testing.T) {
 	}
 }
 
+func Test2DVecCross(t *testing.T) {
+	tests := []struct {
+		v1, v2   Vec2
+		expected float64
+	}{
+		{Vec2{1, 0}, Vec2{0, 1}, 1},
+		{Vec2{0, 1}, Vec2{1, 0}, -1},
+		{Vec2{1, 2}, Vec2{10, 11}, -9},
+		{Vec2{2, 4}, Vec2{1, 2}, 0},
+	}
+
+	for _, c := range tests {
+		if r := c.v1.Cross(c.v2); !FloatEqual(r, c.expected) {
+			t.Errorf("%v.Cross(%v) = %v, want %v", c.v1, c.v2, r, c.expected)
+		}
+		// Matches the z element of the 3D cross product.
+		if r := c.v1.Vec3(0).Cross(c.v2.Vec3(0)).Z(); !FloatEqual(r, c.expected) {
+			t.Errorf("%v.Vec3(0).Cross(%v.Vec3(0)).Z() = %v, want %v", c.v1, c.v2, r, c.expected)
+		}
+	}
+}
+
+func Test2DVecPerpRotatedAngle(t *testing.T) {
+	tests := []struct {
+		v       Vec2
+		angle   float64
+		rotated Vec2
+	}{
+		{Vec2{1, 0}, math.Pi / 2, Vec2{0, 1}},
+		{Vec2{1, 0}, math.Pi, Vec2{-1, 0}},
+		{Vec2{3, 4}, -math.Pi / 2, Vec2{4, -3}},
+		{Vec2{1, 1}, math.Pi / 4, Vec2{0, float64(math.Sqrt2)}},
+		{Vec2{2, -1}, 0, Vec2{2, -1}},
+	}
+
+	approx := func(a, b float64) bool { return Abs(a-b) < 1e-6 }
+	for _, c := range tests {
+		if r := c.v.Rotated(c.angle); !r.ApproxFuncEqual(c.rotated, approx) {
+			t.Errorf("%v.Rotated(%v) = %v, want %v", c.v, c.angle, r, c.rotated)
+		}
+		if p, want := c.v.Perp(), c.v.Rotated(math.Pi/2); !p.ApproxFuncEqual(want, approx) {
+			t.Errorf("%v.Perp() = %v, want %v", c.v, p, want)
+		}
+		if a := c.v.Angle(); !(Vec2{1, 0}).Rotated(a).ApproxFuncEqual(c.v.Normalize(), approx) {
+			t.Errorf("%v.Angle() = %v, which isn't its direction", c.v, a)
+		}
+	}
+
+	if a := (Vec2{-1, 0}).Angle(); !FloatEqual(a, math.Pi) {
+		t.Errorf("Vec2{-1, 0}.Angle() = %v, want Pi", a)
+	}
+	if a := (Vec2{0, -2}).Angle(); !FloatEqual(a, -math.Pi/2) {
+		t.Errorf("Vec2{0, -2}.Angle() = %v, want -Pi/2", a)
+	}
+}
+
 func TestVecDotProduct(t *testing.T) {
 	mustEqual := func(result float64, expected float64, name string) {
 		if !FloatEqual(result, expected) {
@@ -369,3 +442,102 @@ func BenchmarkVecCross(b *testing.B) {
 		v1.Cross(v2)
 	}
 }
+
+func TestVecReflect(t *testing.T) {
+	t.Parallel()
+
+	n := Vec3{0, 1, 0}
+	if got := (Vec3{1, -1, 0.5}).Reflect(n); got != (Vec3{1, 1, 0.5}) {
+		t.Errorf("Reflect = %v, want %v", got, Vec3{1, 1, 0.5})
+	}
+	if got := (Vec3{1, -1, 0.5}).Reflect(n.Mul(-1)); got != (Vec3{1, 1, 0.5}) {
+		t.Errorf("Reflect off a flipped normal = %v, want %v", got, Vec3{1, 1, 0.5})
+	}
+	if got := (Vec2{3, 4}).Reflect(Vec2{-1, 0}); got != (Vec2{-3, 4}) {
+		t.Errorf("Vec2 Reflect = %v, want %v", got, Vec2{-3, 4})
+	}
+}
+
+func TestVecRefract(t *testing.T) {
+	t.Parallel()
+
+	n := Vec3{0, 0, 1}
+	in := Vec3{1, 0, -1}.Normalize() // 45 degrees of incidence
+	tests := []struct {
+		Description string
+		Eta         float64
+		OK          bool
+	}{
+		{"Air to glass", 1 / 1.5, true},
+		{"Same medium", 1, true},
+		{"Glass to air", 1.5, false},
+		{"Water to air", 1.33, true},
+	}
+
+	for _, c := range tests {
+		out, ok := in.Refract(n, c.Eta)
+		if ok != c.OK {
+			t.Errorf("%s: Refract ok = %v, want %v", c.Description, ok, c.OK)
+			continue
+		}
+		if !ok {
+			if out != (Vec3{}) {
+				t.Errorf("%s: Refract with total internal reflection = %v, want zero", c.Description, out)
+			}
+			continue
+		}
+		// Snell's law, with the ray continuing through the surface.
+		sinIn, sinOut := in.Cross(n).Len(), out.Cross(n).Len()
+		if !FloatEqualThreshold(sinOut, c.Eta*sinIn, 1e-5) || out.Dot(n) >= 0 || !FloatEqualThreshold(out.Len(), 1, 1e-5) {
+			t.Errorf("%s: Refract = %v, breaking Snell's law", c.Description, out)
+		}
+		if out[1] != 0 || out[0] <= 0 {
+			t.Errorf("%s: Refract = %v, out of the plane of incidence", c.Description, out)
+		}
+	}
+
+	if out, ok := in.Refract(n, 1); !ok || !out.ApproxEqualThreshold(in, 1e-6) {
+		t.Errorf("Refract in the same medium = %v, %v, want %v", out, ok, in)
+	}
+	if out, ok := (Vec2{0, -1}).Refract(Vec2{0, 1}, 0.75); !ok || !out.ApproxEqualThreshold(Vec2{0, -1}, 1e-6) {
+		t.Errorf("Vec2 Refract at normal incidence = %v, %v, want %v", out, ok, Vec2{0, -1})
+	}
+}
+
+func TestVecComponentWise(t *testing.T) {
+	t.Parallel()
+
+	v := Vec3{-1.25, 0.5, 2.75}
+	tests := []struct {
+		Description string
+		Got, Want   Vec3
+	}{
+		{"Abs", v.Abs(), Vec3{1.25, 0.5, 2.75}},
+		{"Floor", v.Floor(), Vec3{-2, 0, 2}},
+		{"Ceil", v.Ceil(), Vec3{-1, 1, 3}},
+		{"Fract", v.Fract(), Vec3{0.75, 0.5, 0.75}},
+		{"Mod", v.Mod(Vec3{1, 0.5, -2}), Vec3{0.75, 0, -1.25}},
+		{"Min", v.Min(Vec3{0, 0, 3}), Vec3{-1.25, 0, 2.75}},
+		{"Max", v.Max(Vec3{0, 0, 3}), Vec3{0, 0.5, 3}},
+		{"Clamp", v.Clamp(Vec3{-1, -1, -1}, Vec3{1, 1, 1}), Vec3{-1, 0.5, 1}},
+		{"Mix", v.Mix(Vec3{0.75, 1.5, -1.25}, 0.25), Vec3{-0.75, 0.75, 1.75}},
+		{"Step", v.Step(Vec3{0, 0.5, 3}), Vec3{0, 1, 0}},
+		{"Smoothstep", v.Smoothstep(Vec3{-2, 0, 2}, Vec3{-1, 1, 2.5}), Vec3{0.84375, 0.5, 1}},
+	}
+
+	for _, c := range tests {
+		if c.Got != c.Want {
+			t.Errorf("%s = %v, want %v", c.Description, c.Got, c.Want)
+		}
+	}
+
+	if got := (Vec2{-3.5, 4}).Mod(Vec2{3, 3}); got != (Vec2{2.5, 1}) {
+		t.Errorf("Vec2 Mod = %v, want %v", got, Vec2{2.5, 1})
+	}
+	if got := (Vec4{0, 1, 2, 3}).Mix(Vec4{4, 5, 6, 7}, 1); got != (Vec4{4, 5, 6, 7}) {
+		t.Errorf("Vec4 Mix at 1 = %v, want %v", got, Vec4{4, 5, 6, 7})
+	}
+	if got := (Vec4{-0.5, 0.5, 1, 1.5}).Smoothstep(Vec4{}, Vec4{1, 1, 1, 1}); got != (Vec4{0, 0.5, 1, 1}) {
+		t.Errorf("Vec4 Smoothstep = %v", got)
+	}
+}
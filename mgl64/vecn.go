@@ -8,6 +8,8 @@ package mgl64
 
 import (
 	"math"
+
+	"github.com/go-gl/mathgl/mgl64/floats64"
 )
 
 // VecN represents a vector of N elements backed by a slice.
@@ -31,12 +33,7 @@ func NewVecNFromData(initial []float64) *VecN {
 	if initial == nil {
 		return &VecN{}
 	}
-	var internal []float64
-	if shouldPool {
-		internal = grabFromPool(len(initial))
-	} else {
-		internal = make([]float64, len(initial))
-	}
+	internal := newSlice(len(initial))
 	copy(internal, initial)
 	return &VecN{vec: internal}
 }
@@ -44,11 +41,7 @@ func NewVecNFromData(initial []float64) *VecN {
 // NewVecN creates a new vector with a backing slice of
 // 2^p where p = Ceil(log_2(n))
 func NewVecN(n int) *VecN {
-	if shouldPool {
-		return &VecN{vec: grabFromPool(n)}
-	} else {
-		return &VecN{vec: make([]float64, n)}
-	}
+	return &VecN{vec: newSlice(n)}
 }
 
 // Raw returns the raw slice backing the VecN
@@ -105,7 +98,7 @@ func (vn *VecN) Resize(n int) *VecN {
 	if shouldPool && vn.vec != nil {
 		returnToPool(vn.vec)
 	}
-	*vn = (*NewVecN(n))
+	vn.vec = newSlice(n)
 
 	return vn
 }
@@ -151,9 +144,7 @@ func (vn *VecN) Add(dst *VecN, subtrahend *VecN) *VecN {
 	size := intMin(len(vn.vec), len(subtrahend.vec))
 	dst = dst.Resize(size)
 
-	for i := 0; i < size; i++ {
-		dst.vec[i] = vn.vec[i] + subtrahend.vec[i]
-	}
+	floats64.Add(dst.vec, vn.vec[:size], subtrahend.vec[:size])
 
 	return dst
 }
@@ -172,9 +163,7 @@ func (vn *VecN) Sub(dst *VecN, addend *VecN) *VecN {
 	size := intMin(len(vn.vec), len(addend.vec))
 	dst = dst.Resize(size)
 
-	for i := 0; i < size; i++ {
-		dst.vec[i] = vn.vec[i] - addend.vec[i]
-	}
+	floats64.Sub(dst.vec, vn.vec[:size], addend.vec[:size])
 
 	return dst
 }
@@ -214,12 +203,7 @@ func (vn *VecN) Dot(other *VecN) float64 {
 		return float64(math.NaN())
 	}
 
-	var result float64 = 0.0
-	for i, el := range vn.vec {
-		result += el * other.vec[i]
-	}
-
-	return result
+	return floats64.Dot(vn.vec, other.vec)
 }
 
 // Len computes the vector length (also called the Norm) of the
@@ -238,6 +222,33 @@ func (vn *VecN) Len() float64 {
 	return float64(math.Sqrt(float64(vn.Dot(vn))))
 }
 
+// NormP returns the p-norm of the vector, (sum |v[i]|^p)^(1/p). NormP(2) is
+// Len, NormP(1) the sum of the absolute values of the elements and
+// NormP(InfPos) the largest absolute value. The vector is scaled by its largest
+// absolute value first, so large p neither overflows nor underflows.
+//
+// If vn is nil or p is not positive, this returns NaN.
+func (vn *VecN) NormP(p float64) float64 {
+	if vn == nil || !(p > 0) {
+		return float64(math.NaN())
+	}
+
+	var largest float64
+	for _, v := range vn.vec {
+		largest = math.Max(largest, math.Abs(float64(v)))
+	}
+	if largest == 0 || math.IsInf(float64(p), 1) || math.IsInf(largest, 1) {
+		return float64(largest)
+	}
+
+	var sum float64
+	for _, v := range vn.vec {
+		sum += math.Pow(math.Abs(float64(v))/largest, float64(p))
+	}
+
+	return float64(largest * math.Pow(sum, 1/float64(p)))
+}
+
 // LenSqr returns the vector's square length. This is equivalent to the sum of the squares of all elements.
 func (vn *VecN) LenSqr() float64 {
 	if vn == nil {
@@ -256,13 +267,18 @@ func (vn *VecN) LenSqr() float64 {
 //
 // The destination can be vn itself and nothing will go wrong.
 //
-// This is equivalent to vn.Mul(dst, 1/vn.Len())
+// This is equivalent to vn.Mul(dst, 1/vn.Len()), unless the Singular policy
+// says otherwise for a zero-length vector.
 func (vn *VecN) Normalize(dst *VecN) *VecN {
 	if vn == nil {
 		return nil
 	}
 
-	return vn.Mul(dst, 1/vn.Len())
+	l := vn.Len()
+	if l == 0 {
+		singular("VecN.Normalize", vn.vec)
+	}
+	return vn.Mul(dst, 1/l)
 }
 
 // Mul multiplies the vector by some scalar value and stores the result in dst,
@@ -275,9 +291,7 @@ func (vn *VecN) Mul(dst *VecN, c float64) *VecN {
 	}
 	dst = dst.Resize(len(vn.vec))
 
-	for i, el := range vn.vec {
-		dst.vec[i] = el * c
-	}
+	floats64.Scale(dst.vec, vn.vec, c)
 
 	return dst
 }
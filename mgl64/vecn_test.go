@@ -145,3 +145,33 @@ func TestVecNLenSqr(t *testing.T) {
 		}
 	}
 }
+
+func TestVecNNormP(t *testing.T) {
+	v := NewVecNFromData([]float64{3, -4, 0, 12})
+
+	tests := []struct {
+		p, norm float64
+	}{
+		{1, 19},
+		{2, 13},
+		{3, float64(math.Cbrt(27 + 64 + 1728))},
+		{InfPos, 12},
+		{200, 12},
+	}
+
+	for _, c := range tests {
+		if n := v.NormP(c.p); !FloatEqualThreshold(n, c.norm, 1e-4) {
+			t.Errorf("NormP(%v) = %v, want %v", c.p, n, c.norm)
+		}
+	}
+
+	if n := NewVecNFromData([]float64{1e30, 1e30}).NormP(4); !FloatEqualThreshold(n, 1e30*float64(math.Pow(2, 0.25)), 1e-4) {
+		t.Errorf("NormP of large elements = %v, want %v", n, 1e30*math.Pow(2, 0.25))
+	}
+	if n := v.NormP(0); !math.IsNaN(float64(n)) {
+		t.Errorf("NormP(0) = %v, want NaN", n)
+	}
+	if n := NewVecN(3).NormP(2); n != 0 {
+		t.Errorf("NormP of the zero vector = %v, want 0", n)
+	}
+}
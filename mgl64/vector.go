@@ -10,6 +10,7 @@
 package mgl64
 
 import (
+	"encoding/binary"
 	"math"
 )
 
@@ -88,6 +89,34 @@ func (v1 Vec3) Cross(v2 Vec3) Vec3 {
 	return Vec3{v1[1]*v2[2] - v1[2]*v2[1], v1[2]*v2[0] - v1[0]*v2[2], v1[0]*v2[1] - v1[1]*v2[0]}
 }
 
+// Cross is the 2D analogue of the cross product: the z element of the cross
+// product of v1 and v2 extended to 3D with z = 0, i.e. v1[0]*v2[1] - v1[1]*v2[0].
+// It is |v1||v2|sin(theta), where theta is the angle from v1 to v2 counterclockwise,
+// so it is positive if v2 lies counterclockwise of v1 and negative if clockwise.
+func (v1 Vec2) Cross(v2 Vec2) float64 {
+	return v1[0]*v2[1] - v1[1]*v2[0]
+}
+
+// Perp returns the vector rotated 90 degrees counterclockwise, {-v[1], v[0]}.
+// This is the perpendicular for which v.Cross(v.Perp()) is positive.
+func (v Vec2) Perp() Vec2 {
+	return Vec2{-v[1], v[0]}
+}
+
+// Rotated returns the vector rotated counterclockwise by angle radians about
+// the origin.
+func (v Vec2) Rotated(angle float64) Vec2 {
+	sin, cos := math.Sincos(float64(angle))
+	s, c := float64(sin), float64(cos)
+	return Vec2{c*v[0] - s*v[1], s*v[0] + c*v[1]}
+}
+
+// Angle returns the angle of the vector in radians, measured counterclockwise
+// from the positive x axis, in the range [-Pi, Pi]. This is math.Atan2(v[1], v[0]).
+func (v Vec2) Angle() float64 {
+	return float64(math.Atan2(float64(v[1]), float64(v[0])))
+}
+
 // Quat reinterprets this vector as a quaternion, with the individual elements
 // staying the same.
 func (v Vec4) Quat() Quat {
@@ -153,11 +182,141 @@ func (v1 Vec2) LenSqr() float64 {
 // while maintaining its directionality.
 //
 // (Can be seen here: http://play.golang.org/p/Aaj7SnbqIp )
+//
+// The Singular policy can make this function panic on a zero-length vector instead.
 func (v1 Vec2) Normalize() Vec2 {
-	l := 1.0 / v1.Len()
+	l := v1.Len()
+	if l == 0 {
+		singular("Vec2.Normalize", v1)
+	}
+	l = 1.0 / l
 	return Vec2{v1[0] * l, v1[1] * l}
 }
 
+// TryNormalize is like Normalize, but returns ErrZeroLength for a zero-length
+// vector regardless of the Singular policy.
+func (v1 Vec2) TryNormalize() (Vec2, error) {
+	if v1.Len() == 0 {
+		return Vec2{}, ErrZeroLength
+	}
+	return v1.Normalize(), nil
+}
+
+// Reflect returns the direction v1 reflected off a surface with the normal n,
+// which must be normalized, like GLSL's reflect: v1 - 2 * n.Dot(v1) * n. The
+// direction of reflection doesn't change if n faces the other way.
+func (v1 Vec2) Reflect(n Vec2) Vec2 {
+	return v1.Sub(n.Mul(2 * n.Dot(v1)))
+}
+
+// Refract returns the direction of v1 refracted through a surface with the
+// normal n, where eta is the ratio of the indices of refraction on the side of
+// v1 to that of the other side, like GLSL's refract. v1 and n must be
+// normalized, and n must face toward v1's side, against v1. The second return
+// value is false, and the vector zero, if there is total internal reflection
+// instead; then the light goes the way of Reflect.
+func (v1 Vec2) Refract(n Vec2, eta float64) (Vec2, bool) {
+	d := n.Dot(v1)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec2{}, false
+	}
+	return v1.Mul(eta).Sub(n.Mul(eta*d + float64(math.Sqrt(float64(k))))), true
+}
+
+// Abs returns the element-wise absolute value of the vector.
+func (v1 Vec2) Abs() Vec2 {
+	return Vec2{Abs(v1[0]), Abs(v1[1])}
+}
+
+// Floor returns the vector with each element rounded down to an integer.
+func (v1 Vec2) Floor() Vec2 {
+	return Vec2{float64(math.Floor(float64(v1[0]))), float64(math.Floor(float64(v1[1])))}
+}
+
+// Ceil returns the vector with each element rounded up to an integer.
+func (v1 Vec2) Ceil() Vec2 {
+	return Vec2{float64(math.Ceil(float64(v1[0]))), float64(math.Ceil(float64(v1[1])))}
+}
+
+// Fract returns the fractional part of each element, v1 - v1.Floor(), in
+// [0, 1) as in GLSL: the fractional part of -0.25 is 0.75.
+func (v1 Vec2) Fract() Vec2 {
+	return v1.Sub(v1.Floor())
+}
+
+// Mod returns the element-wise modulus of v1 by v2 as GLSL's mod defines it,
+// v1 - v2 * floor(v1 / v2), which has the sign of v2 rather than of v1 like
+// math.Mod, so that coordinates repeat across the origin.
+func (v1 Vec2) Mod(v2 Vec2) Vec2 {
+	var v Vec2
+	for i := range v1 {
+		v[i] = v1[i] - v2[i]*float64(math.Floor(float64(v1[i]/v2[i])))
+	}
+	return v
+}
+
+// Min returns the element-wise minimum of two vectors.
+func (v1 Vec2) Min(v2 Vec2) Vec2 {
+	for i := range v1 {
+		if v2[i] < v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of two vectors.
+func (v1 Vec2) Max(v2 Vec2) Vec2 {
+	for i := range v1 {
+		if v2[i] > v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Clamp clamps each element of the vector to the range given by the elements
+// of low and high, as the scalar Clamp does.
+func (v1 Vec2) Clamp(low, high Vec2) Vec2 {
+	for i := range v1 {
+		v1[i] = Clamp(v1[i], low[i], high[i])
+	}
+	return v1
+}
+
+// Mix linearly interpolates between v1 and v2 by a, like GLSL's mix:
+// v1*(1-a) + v2*a, which is v1 for a = 0 and v2 for a = 1.
+func (v1 Vec2) Mix(v2 Vec2, a float64) Vec2 {
+	return Vec2{v1[0]*(1-a) + v2[0]*a, v1[1]*(1-a) + v2[1]*a}
+}
+
+// Step returns, for each element, 0 if it is less than the element of edge and
+// 1 otherwise, like GLSL's step(edge, v1).
+func (v1 Vec2) Step(edge Vec2) Vec2 {
+	var v Vec2
+	for i := range v1 {
+		if v1[i] >= edge[i] {
+			v[i] = 1
+		}
+	}
+	return v
+}
+
+// Smoothstep performs Hermite interpolation of each element between 0 and 1
+// as it goes from the element of edge0 to that of edge1, like GLSL's
+// smoothstep(edge0, edge1, v1): with t clamped from (v1 - edge0) / (edge1 -
+// edge0) to [0, 1], the result is t*t*(3 - 2*t). Each element of edge0 must be
+// less than that of edge1.
+func (v1 Vec2) Smoothstep(edge0, edge1 Vec2) Vec2 {
+	var v Vec2
+	for i := range v1 {
+		t := Clamp((v1[i]-edge0[i])/(edge1[i]-edge0[i]), 0, 1)
+		v[i] = t * t * (3 - 2*t)
+	}
+	return v
+}
+
 // ApproxEqual takes in a vector and does an element-wise approximate float
 // comparison as if FloatEqual had been used
 func (v1 Vec2) ApproxEqual(v2 Vec2) bool {
@@ -207,6 +366,21 @@ func (v Vec2) Y() float64 {
 	return v[1]
 }
 
+// PutBytes stores the elements of v in b in the given byte order, as
+// described in bytes.go. b must have room for all 2 elements or this
+// panics.
+func (v Vec2) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, v[:])
+}
+
+// Vec2FromBytes reads a vector stored by PutBytes from b in the given
+// byte order. b must hold all 2 elements or this panics.
+func Vec2FromBytes(b []byte, order binary.ByteOrder) Vec2 {
+	var v Vec2
+	getScalars(v[:], b, order)
+	return v
+}
+
 // OuterProd2 does the vector outer product
 // of two vectors. The outer product produces an
 // 2x2 matrix. E.G. a Vec2 * Vec2 = Mat2.
@@ -252,6 +426,12 @@ func (v1 Vec2) OuterProd4(v2 Vec4) Mat2x4 {
 	return Mat2x4{v1[0] * v2[0], v1[1] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[0] * v2[3], v1[1] * v2[3]}
 }
 
+// OuterProd is the outer product v1 * v2^T of two vectors of the same size,
+// the same as OuterProd2.
+func (v1 Vec2) OuterProd(v2 Vec2) Mat2 {
+	return v1.OuterProd2(v2)
+}
+
 // Add performs element-wise addition between two vectors. It is equivalent to iterating
 // over every element of v1 and adding the corresponding element of v2 to it.
 func (v1 Vec3) Add(v2 Vec3) Vec3 {
@@ -311,11 +491,141 @@ func (v1 Vec3) LenSqr() float64 {
 // while maintaining its directionality.
 //
 // (Can be seen here: http://play.golang.org/p/Aaj7SnbqIp )
+//
+// The Singular policy can make this function panic on a zero-length vector instead.
 func (v1 Vec3) Normalize() Vec3 {
-	l := 1.0 / v1.Len()
+	l := v1.Len()
+	if l == 0 {
+		singular("Vec3.Normalize", v1)
+	}
+	l = 1.0 / l
 	return Vec3{v1[0] * l, v1[1] * l, v1[2] * l}
 }
 
+// TryNormalize is like Normalize, but returns ErrZeroLength for a zero-length
+// vector regardless of the Singular policy.
+func (v1 Vec3) TryNormalize() (Vec3, error) {
+	if v1.Len() == 0 {
+		return Vec3{}, ErrZeroLength
+	}
+	return v1.Normalize(), nil
+}
+
+// Reflect returns the direction v1 reflected off a surface with the normal n,
+// which must be normalized, like GLSL's reflect: v1 - 2 * n.Dot(v1) * n. The
+// direction of reflection doesn't change if n faces the other way.
+func (v1 Vec3) Reflect(n Vec3) Vec3 {
+	return v1.Sub(n.Mul(2 * n.Dot(v1)))
+}
+
+// Refract returns the direction of v1 refracted through a surface with the
+// normal n, where eta is the ratio of the indices of refraction on the side of
+// v1 to that of the other side, like GLSL's refract. v1 and n must be
+// normalized, and n must face toward v1's side, against v1. The second return
+// value is false, and the vector zero, if there is total internal reflection
+// instead; then the light goes the way of Reflect.
+func (v1 Vec3) Refract(n Vec3, eta float64) (Vec3, bool) {
+	d := n.Dot(v1)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec3{}, false
+	}
+	return v1.Mul(eta).Sub(n.Mul(eta*d + float64(math.Sqrt(float64(k))))), true
+}
+
+// Abs returns the element-wise absolute value of the vector.
+func (v1 Vec3) Abs() Vec3 {
+	return Vec3{Abs(v1[0]), Abs(v1[1]), Abs(v1[2])}
+}
+
+// Floor returns the vector with each element rounded down to an integer.
+func (v1 Vec3) Floor() Vec3 {
+	return Vec3{float64(math.Floor(float64(v1[0]))), float64(math.Floor(float64(v1[1]))), float64(math.Floor(float64(v1[2])))}
+}
+
+// Ceil returns the vector with each element rounded up to an integer.
+func (v1 Vec3) Ceil() Vec3 {
+	return Vec3{float64(math.Ceil(float64(v1[0]))), float64(math.Ceil(float64(v1[1]))), float64(math.Ceil(float64(v1[2])))}
+}
+
+// Fract returns the fractional part of each element, v1 - v1.Floor(), in
+// [0, 1) as in GLSL: the fractional part of -0.25 is 0.75.
+func (v1 Vec3) Fract() Vec3 {
+	return v1.Sub(v1.Floor())
+}
+
+// Mod returns the element-wise modulus of v1 by v2 as GLSL's mod defines it,
+// v1 - v2 * floor(v1 / v2), which has the sign of v2 rather than of v1 like
+// math.Mod, so that coordinates repeat across the origin.
+func (v1 Vec3) Mod(v2 Vec3) Vec3 {
+	var v Vec3
+	for i := range v1 {
+		v[i] = v1[i] - v2[i]*float64(math.Floor(float64(v1[i]/v2[i])))
+	}
+	return v
+}
+
+// Min returns the element-wise minimum of two vectors.
+func (v1 Vec3) Min(v2 Vec3) Vec3 {
+	for i := range v1 {
+		if v2[i] < v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of two vectors.
+func (v1 Vec3) Max(v2 Vec3) Vec3 {
+	for i := range v1 {
+		if v2[i] > v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Clamp clamps each element of the vector to the range given by the elements
+// of low and high, as the scalar Clamp does.
+func (v1 Vec3) Clamp(low, high Vec3) Vec3 {
+	for i := range v1 {
+		v1[i] = Clamp(v1[i], low[i], high[i])
+	}
+	return v1
+}
+
+// Mix linearly interpolates between v1 and v2 by a, like GLSL's mix:
+// v1*(1-a) + v2*a, which is v1 for a = 0 and v2 for a = 1.
+func (v1 Vec3) Mix(v2 Vec3, a float64) Vec3 {
+	return Vec3{v1[0]*(1-a) + v2[0]*a, v1[1]*(1-a) + v2[1]*a, v1[2]*(1-a) + v2[2]*a}
+}
+
+// Step returns, for each element, 0 if it is less than the element of edge and
+// 1 otherwise, like GLSL's step(edge, v1).
+func (v1 Vec3) Step(edge Vec3) Vec3 {
+	var v Vec3
+	for i := range v1 {
+		if v1[i] >= edge[i] {
+			v[i] = 1
+		}
+	}
+	return v
+}
+
+// Smoothstep performs Hermite interpolation of each element between 0 and 1
+// as it goes from the element of edge0 to that of edge1, like GLSL's
+// smoothstep(edge0, edge1, v1): with t clamped from (v1 - edge0) / (edge1 -
+// edge0) to [0, 1], the result is t*t*(3 - 2*t). Each element of edge0 must be
+// less than that of edge1.
+func (v1 Vec3) Smoothstep(edge0, edge1 Vec3) Vec3 {
+	var v Vec3
+	for i := range v1 {
+		t := Clamp((v1[i]-edge0[i])/(edge1[i]-edge0[i]), 0, 1)
+		v[i] = t * t * (3 - 2*t)
+	}
+	return v
+}
+
 // ApproxEqual takes in a vector and does an element-wise approximate float
 // comparison as if FloatEqual had been used
 func (v1 Vec3) ApproxEqual(v2 Vec3) bool {
@@ -373,6 +683,21 @@ func (v Vec3) Z() float64 {
 	return v[2]
 }
 
+// PutBytes stores the elements of v in b in the given byte order, as
+// described in bytes.go. b must have room for all 3 elements or this
+// panics.
+func (v Vec3) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, v[:])
+}
+
+// Vec3FromBytes reads a vector stored by PutBytes from b in the given
+// byte order. b must hold all 3 elements or this panics.
+func Vec3FromBytes(b []byte, order binary.ByteOrder) Vec3 {
+	var v Vec3
+	getScalars(v[:], b, order)
+	return v
+}
+
 // OuterProd2 does the vector outer product
 // of two vectors. The outer product produces an
 // 3x2 matrix. E.G. a Vec3 * Vec2 = Mat3x2.
@@ -418,6 +743,12 @@ func (v1 Vec3) OuterProd4(v2 Vec4) Mat3x4 {
 	return Mat3x4{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[2] * v2[2], v1[0] * v2[3], v1[1] * v2[3], v1[2] * v2[3]}
 }
 
+// OuterProd is the outer product v1 * v2^T of two vectors of the same size,
+// the same as OuterProd3.
+func (v1 Vec3) OuterProd(v2 Vec3) Mat3 {
+	return v1.OuterProd3(v2)
+}
+
 // Add performs element-wise addition between two vectors. It is equivalent to iterating
 // over every element of v1 and adding the corresponding element of v2 to it.
 func (v1 Vec4) Add(v2 Vec4) Vec4 {
@@ -477,11 +808,141 @@ func (v1 Vec4) LenSqr() float64 {
 // while maintaining its directionality.
 //
 // (Can be seen here: http://play.golang.org/p/Aaj7SnbqIp )
+//
+// The Singular policy can make this function panic on a zero-length vector instead.
 func (v1 Vec4) Normalize() Vec4 {
-	l := 1.0 / v1.Len()
+	l := v1.Len()
+	if l == 0 {
+		singular("Vec4.Normalize", v1)
+	}
+	l = 1.0 / l
 	return Vec4{v1[0] * l, v1[1] * l, v1[2] * l, v1[3] * l}
 }
 
+// TryNormalize is like Normalize, but returns ErrZeroLength for a zero-length
+// vector regardless of the Singular policy.
+func (v1 Vec4) TryNormalize() (Vec4, error) {
+	if v1.Len() == 0 {
+		return Vec4{}, ErrZeroLength
+	}
+	return v1.Normalize(), nil
+}
+
+// Reflect returns the direction v1 reflected off a surface with the normal n,
+// which must be normalized, like GLSL's reflect: v1 - 2 * n.Dot(v1) * n. The
+// direction of reflection doesn't change if n faces the other way.
+func (v1 Vec4) Reflect(n Vec4) Vec4 {
+	return v1.Sub(n.Mul(2 * n.Dot(v1)))
+}
+
+// Refract returns the direction of v1 refracted through a surface with the
+// normal n, where eta is the ratio of the indices of refraction on the side of
+// v1 to that of the other side, like GLSL's refract. v1 and n must be
+// normalized, and n must face toward v1's side, against v1. The second return
+// value is false, and the vector zero, if there is total internal reflection
+// instead; then the light goes the way of Reflect.
+func (v1 Vec4) Refract(n Vec4, eta float64) (Vec4, bool) {
+	d := n.Dot(v1)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vec4{}, false
+	}
+	return v1.Mul(eta).Sub(n.Mul(eta*d + float64(math.Sqrt(float64(k))))), true
+}
+
+// Abs returns the element-wise absolute value of the vector.
+func (v1 Vec4) Abs() Vec4 {
+	return Vec4{Abs(v1[0]), Abs(v1[1]), Abs(v1[2]), Abs(v1[3])}
+}
+
+// Floor returns the vector with each element rounded down to an integer.
+func (v1 Vec4) Floor() Vec4 {
+	return Vec4{float64(math.Floor(float64(v1[0]))), float64(math.Floor(float64(v1[1]))), float64(math.Floor(float64(v1[2]))), float64(math.Floor(float64(v1[3])))}
+}
+
+// Ceil returns the vector with each element rounded up to an integer.
+func (v1 Vec4) Ceil() Vec4 {
+	return Vec4{float64(math.Ceil(float64(v1[0]))), float64(math.Ceil(float64(v1[1]))), float64(math.Ceil(float64(v1[2]))), float64(math.Ceil(float64(v1[3])))}
+}
+
+// Fract returns the fractional part of each element, v1 - v1.Floor(), in
+// [0, 1) as in GLSL: the fractional part of -0.25 is 0.75.
+func (v1 Vec4) Fract() Vec4 {
+	return v1.Sub(v1.Floor())
+}
+
+// Mod returns the element-wise modulus of v1 by v2 as GLSL's mod defines it,
+// v1 - v2 * floor(v1 / v2), which has the sign of v2 rather than of v1 like
+// math.Mod, so that coordinates repeat across the origin.
+func (v1 Vec4) Mod(v2 Vec4) Vec4 {
+	var v Vec4
+	for i := range v1 {
+		v[i] = v1[i] - v2[i]*float64(math.Floor(float64(v1[i]/v2[i])))
+	}
+	return v
+}
+
+// Min returns the element-wise minimum of two vectors.
+func (v1 Vec4) Min(v2 Vec4) Vec4 {
+	for i := range v1 {
+		if v2[i] < v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Max returns the element-wise maximum of two vectors.
+func (v1 Vec4) Max(v2 Vec4) Vec4 {
+	for i := range v1 {
+		if v2[i] > v1[i] {
+			v1[i] = v2[i]
+		}
+	}
+	return v1
+}
+
+// Clamp clamps each element of the vector to the range given by the elements
+// of low and high, as the scalar Clamp does.
+func (v1 Vec4) Clamp(low, high Vec4) Vec4 {
+	for i := range v1 {
+		v1[i] = Clamp(v1[i], low[i], high[i])
+	}
+	return v1
+}
+
+// Mix linearly interpolates between v1 and v2 by a, like GLSL's mix:
+// v1*(1-a) + v2*a, which is v1 for a = 0 and v2 for a = 1.
+func (v1 Vec4) Mix(v2 Vec4, a float64) Vec4 {
+	return Vec4{v1[0]*(1-a) + v2[0]*a, v1[1]*(1-a) + v2[1]*a, v1[2]*(1-a) + v2[2]*a, v1[3]*(1-a) + v2[3]*a}
+}
+
+// Step returns, for each element, 0 if it is less than the element of edge and
+// 1 otherwise, like GLSL's step(edge, v1).
+func (v1 Vec4) Step(edge Vec4) Vec4 {
+	var v Vec4
+	for i := range v1 {
+		if v1[i] >= edge[i] {
+			v[i] = 1
+		}
+	}
+	return v
+}
+
+// Smoothstep performs Hermite interpolation of each element between 0 and 1
+// as it goes from the element of edge0 to that of edge1, like GLSL's
+// smoothstep(edge0, edge1, v1): with t clamped from (v1 - edge0) / (edge1 -
+// edge0) to [0, 1], the result is t*t*(3 - 2*t). Each element of edge0 must be
+// less than that of edge1.
+func (v1 Vec4) Smoothstep(edge0, edge1 Vec4) Vec4 {
+	var v Vec4
+	for i := range v1 {
+		t := Clamp((v1[i]-edge0[i])/(edge1[i]-edge0[i]), 0, 1)
+		v[i] = t * t * (3 - 2*t)
+	}
+	return v
+}
+
 // ApproxEqual takes in a vector and does an element-wise approximate float
 // comparison as if FloatEqual had been used
 func (v1 Vec4) ApproxEqual(v2 Vec4) bool {
@@ -547,6 +1008,21 @@ func (v Vec4) W() float64 {
 	return v[3]
 }
 
+// PutBytes stores the elements of v in b in the given byte order, as
+// described in bytes.go. b must have room for all 4 elements or this
+// panics.
+func (v Vec4) PutBytes(b []byte, order binary.ByteOrder) {
+	putScalars(b, order, v[:])
+}
+
+// Vec4FromBytes reads a vector stored by PutBytes from b in the given
+// byte order. b must hold all 4 elements or this panics.
+func Vec4FromBytes(b []byte, order binary.ByteOrder) Vec4 {
+	var v Vec4
+	getScalars(v[:], b, order)
+	return v
+}
+
 // OuterProd2 does the vector outer product
 // of two vectors. The outer product produces an
 // 4x2 matrix. E.G. a Vec4 * Vec2 = Mat4x2.
@@ -591,3 +1067,9 @@ func (v1 Vec4) OuterProd3(v2 Vec3) Mat4x3 {
 func (v1 Vec4) OuterProd4(v2 Vec4) Mat4 {
 	return Mat4{v1[0] * v2[0], v1[1] * v2[0], v1[2] * v2[0], v1[3] * v2[0], v1[0] * v2[1], v1[1] * v2[1], v1[2] * v2[1], v1[3] * v2[1], v1[0] * v2[2], v1[1] * v2[2], v1[2] * v2[2], v1[3] * v2[2], v1[0] * v2[3], v1[1] * v2[3], v1[2] * v2[3], v1[3] * v2[3]}
 }
+
+// OuterProd is the outer product v1 * v2^T of two vectors of the same size,
+// the same as OuterProd4.
+func (v1 Vec4) OuterProd(v2 Vec4) Mat4 {
+	return v1.OuterProd4(v2)
+}